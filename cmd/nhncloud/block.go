@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/storage/block"
+)
+
+func blockCommand() *command {
+	return group("block", "Block storage.",
+		group("volumes", "Volumes.",
+			&command{
+				name: "list", summary: "List volumes.",
+				columns: []string{"id", "name", "status", "size", "volume_type", "availability_zone"},
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.block()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.ListVolumes(ctx)
+					if err != nil {
+						return nil, err
+					}
+					return out.Volumes, nil
+				},
+			},
+			&command{
+				name: "describe", summary: "Show a volume.", args: "<volume-id>", nargs: 1,
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.block()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.GetVolume(ctx, args[0])
+					if err != nil {
+						return nil, err
+					}
+					return out.Volume, nil
+				},
+			},
+			&command{
+				name: "delete", summary: "Delete a volume.", args: "<volume-id>", nargs: 1, mutating: true,
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					id := args[0]
+					if e.opts.wait {
+						// WaitForVolume has no deleted state to wait for.
+						return nil, errors.New("--wait is not supported by volume delete")
+					}
+					if e.dryRun("delete volume %s", id) {
+						return nil, nil
+					}
+					c, err := e.block()
+					if err != nil {
+						return nil, err
+					}
+					return nil, c.DeleteVolume(ctx, id)
+				},
+			},
+		),
+	)
+}
+
+func (e *env) block() (*block.Client, error) {
+	c, err := e.client("block-storage")
+	if err != nil {
+		return nil, err
+	}
+	return c.BlockStorage(), nil
+}
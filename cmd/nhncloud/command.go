@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+)
+
+// command is a node of the command tree. Leaves have run; groups have
+// subcommands.
+type command struct {
+	name    string
+	summary string
+	// args names the positional arguments for usage, e.g. "<instance-id>".
+	args string
+	// nargs is the number of positional arguments run expects, or -1 for
+	// any number.
+	nargs int
+	// columns are the fields shown by table output of a list.
+	columns []string
+	// mutating commands honor --dry-run and --wait.
+	mutating bool
+	// flags registers command-specific flags.
+	flags func(fs *flag.FlagSet)
+	run   func(ctx context.Context, e *env, args []string) (interface{}, error)
+
+	subcommands []*command
+}
+
+func group(name, summary string, subcommands ...*command) *command {
+	return &command{name: name, summary: summary, subcommands: subcommands}
+}
+
+func (c *command) find(name string) *command {
+	for _, sub := range c.subcommands {
+		if sub.name == name {
+			return sub
+		}
+	}
+	return nil
+}
+
+// options are the global flags accepted by every command.
+type options struct {
+	profile string
+	region  string
+	output  string
+	query   string
+	wait    bool
+	timeout time.Duration
+	dryRun  bool
+	debug   bool
+}
+
+func (o *options) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.profile, "profile", "", "profile in ~/.nhncloud/credentials (default $NHN_CLOUD_PROFILE or \"default\")")
+	fs.StringVar(&o.region, "region", "", "region, e.g. kr1 (overrides the profile)")
+	fs.StringVar(&o.output, "output", "table", "output format: table, json or yaml")
+	fs.StringVar(&o.output, "o", "table", "shorthand for --output")
+	fs.StringVar(&o.query, "query", "", "filter and reshape the result, e.g. \"[?status=='ACTIVE'].name\"")
+	fs.BoolVar(&o.wait, "wait", false, "wait until the resource settles after a change")
+	fs.DurationVar(&o.timeout, "wait-timeout", 30*time.Minute, "maximum time --wait waits")
+	fs.BoolVar(&o.dryRun, "dry-run", false, "print the change instead of making it")
+	fs.BoolVar(&o.debug, "debug", false, "log HTTP requests")
+}
+
+// env is what a command runs with.
+type env struct {
+	opts    options
+	stdin   io.Reader
+	stdout  io.Writer
+	stderr  io.Writer
+	profile *nhncloud.Profile
+	clients map[string]*nhncloud.Client
+}
+
+// client returns a Client for service, using the profile's tenant override
+// for it when there is one.
+func (e *env) client(service string) (*nhncloud.Client, error) {
+	if c, ok := e.clients[service]; ok {
+		return c, nil
+	}
+	if e.profile == nil {
+		p, err := nhncloud.LoadProfile(e.opts.profile)
+		if err != nil {
+			return nil, err
+		}
+		if e.opts.region != "" {
+			p.Region = e.opts.region
+		}
+		e.profile = p
+	}
+	cfg := e.profile.ConfigFor(service)
+	cfg.Debug = e.opts.debug
+	c, err := nhncloud.New(cfg)
+	if errors.Is(err, nhncloud.ErrCredentialsRequired) {
+		return nil, fmt.Errorf("profile %q: %w: set access_key_id and secret_access_key", e.profile.Name, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if e.clients == nil {
+		e.clients = make(map[string]*nhncloud.Client)
+	}
+	e.clients[service] = c
+	return c, nil
+}
+
+// dryRun reports whether the command should only describe its change, and
+// prints the description if so.
+func (e *env) dryRun(format string, args ...interface{}) bool {
+	if !e.opts.dryRun {
+		return false
+	}
+	fmt.Fprintf(e.stdout, "dry-run: would "+format+"\n", args...)
+	return true
+}
+
+// confirm asks on stderr whether to go ahead and reports whether stdin
+// answered yes. No answer, as when stdin is not a terminal, is no.
+func (e *env) confirm(format string, args ...interface{}) (bool, error) {
+	fmt.Fprintf(e.stderr, format+" [y/N] ", args...)
+	if e.stdin == nil {
+		return false, nil
+	}
+	line, err := bufio.NewReader(e.stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
+
+// waitContext bounds a --wait by --wait-timeout.
+func (e *env) waitContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, e.opts.timeout)
+}
+
+// parseArgs parses flags and positional arguments in any order.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		if args[0] == "--" {
+			return append(positional, args[1:]...), nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// usage writes help for c, reached through path.
+func usage(w io.Writer, path []string, c *command) {
+	full := joinPath(path)
+	if c.run != nil {
+		fmt.Fprintf(w, "Usage: %s [flags] %s\n\n%s\n", full, c.args, c.summary)
+		fs := flag.NewFlagSet(full, flag.ContinueOnError)
+		if c.flags != nil {
+			c.flags(fs)
+		}
+		var o options
+		o.register(fs)
+		fs.SetOutput(w)
+		fmt.Fprintln(w, "\nFlags:")
+		fs.PrintDefaults()
+		return
+	}
+	fmt.Fprintf(w, "Usage: %s <command>\n", full)
+	if c.summary != "" {
+		fmt.Fprintf(w, "\n%s\n", c.summary)
+	}
+	fmt.Fprintln(w, "\nCommands:")
+	subs := append([]*command(nil), c.subcommands...)
+	sort.Slice(subs, func(i, j int) bool { return subs[i].name < subs[j].name })
+	for _, sub := range subs {
+		fmt.Fprintf(w, "  %-18s %s\n", sub.name, sub.summary)
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/compute"
+)
+
+func computeCommand() *command {
+	return group("compute", "Instances, flavors, images and key pairs.",
+		group("servers", "Instances.",
+			&command{
+				name: "list", summary: "List instances.",
+				columns: []string{"id", "name", "status", "flavor.id", "key_name", "created"},
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.compute()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.ListServers(ctx)
+					if err != nil {
+						return nil, err
+					}
+					return out.Servers, nil
+				},
+			},
+			&command{
+				name: "describe", summary: "Show an instance.", args: "<server-id>", nargs: 1,
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.compute()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.GetServer(ctx, args[0])
+					if err != nil {
+						return nil, err
+					}
+					return out.Server, nil
+				},
+			},
+			serverAction("start", "Start an instance.", compute.ServerStatusActive,
+				func(ctx context.Context, c *compute.Client, id string) error { return c.StartServer(ctx, id) }),
+			serverAction("stop", "Stop an instance.", compute.ServerStatusShutoff,
+				func(ctx context.Context, c *compute.Client, id string) error { return c.StopServer(ctx, id) }),
+			rebootCommand(),
+			serverAction("delete", "Delete an instance.", compute.ServerStatusDeleted,
+				func(ctx context.Context, c *compute.Client, id string) error { return c.DeleteServer(ctx, id) }),
+		),
+		group("flavors", "Instance types.",
+			&command{
+				name: "list", summary: "List instance types.",
+				columns: []string{"id", "name", "vcpus", "ram", "disk"},
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.compute()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.ListFlavors(ctx)
+					if err != nil {
+						return nil, err
+					}
+					return out.Flavors, nil
+				},
+			},
+		),
+		group("images", "Images usable by instances.",
+			&command{
+				name: "list", summary: "List images.",
+				columns: []string{"id", "name", "status", "minDisk", "created"},
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.compute()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.ListImages(ctx)
+					if err != nil {
+						return nil, err
+					}
+					return out.Images, nil
+				},
+			},
+		),
+		group("keypairs", "Key pairs.",
+			&command{
+				name: "list", summary: "List key pairs.",
+				columns: []string{"name", "fingerprint"},
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.compute()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.ListKeyPairs(ctx)
+					if err != nil {
+						return nil, err
+					}
+					keys := make([]compute.KeyPair, len(out.KeyPairs))
+					for i, kp := range out.KeyPairs {
+						keys[i] = kp.KeyPair
+					}
+					return keys, nil
+				},
+			},
+		),
+	)
+}
+
+func (e *env) compute() (*compute.Client, error) {
+	c, err := e.client("compute")
+	if err != nil {
+		return nil, err
+	}
+	return c.Compute(), nil
+}
+
+// serverAction is a command that changes an instance and, with --wait, waits
+// for it to reach settled.
+func serverAction(name, summary string, settled compute.ServerStatus, do func(context.Context, *compute.Client, string) error) *command {
+	return &command{
+		name: name, summary: summary, args: "<server-id>", nargs: 1, mutating: true,
+		run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+			return runServerAction(ctx, e, name, args[0], settled, do)
+		},
+	}
+}
+
+func rebootCommand() *command {
+	var hard bool
+	return &command{
+		name: "reboot", summary: "Reboot an instance.", args: "<server-id>", nargs: 1, mutating: true,
+		flags: func(fs *flag.FlagSet) {
+			fs.BoolVar(&hard, "hard", false, "power-cycle instead of a graceful reboot")
+		},
+		run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+			return runServerAction(ctx, e, "reboot", args[0], compute.ServerStatusActive,
+				func(ctx context.Context, c *compute.Client, id string) error { return c.RebootServer(ctx, id, hard) })
+		},
+	}
+}
+
+func runServerAction(ctx context.Context, e *env, action, id string, settled compute.ServerStatus, do func(context.Context, *compute.Client, string) error) (interface{}, error) {
+	if e.dryRun("%s instance %s", action, id) {
+		return nil, nil
+	}
+	c, err := e.compute()
+	if err != nil {
+		return nil, err
+	}
+	if err := do(ctx, c, id); err != nil {
+		return nil, err
+	}
+	if !e.opts.wait {
+		return nil, nil
+	}
+	ctx, cancel := e.waitContext(ctx)
+	defer cancel()
+	out, err := c.WaitForServer(ctx, id, settled, 0)
+	if err != nil || out == nil {
+		return nil, err
+	}
+	return out.Server, nil
+}
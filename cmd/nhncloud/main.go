@@ -0,0 +1,123 @@
+// Command nhncloud is a command-line client for NHN Cloud built on the SDK.
+//
+//	nhncloud compute servers list
+//	nhncloud rds mysql instances describe <instance-id> -o yaml
+//	nhncloud compute servers stop <server-id> --wait
+//	nhncloud obs cp ./backup.tar obs://backups/2024/backup.tar
+//
+// Credentials come from the shared config file (~/.nhncloud/credentials) and
+// NHN_CLOUD_* environment variables; see docs/CONFIGURATION.md. Flags may be
+// given before or after positional arguments.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+func root() *command {
+	return group("nhncloud", "Manage NHN Cloud resources.",
+		computeCommand(),
+		rdsCommand(),
+		nksCommand(),
+		networkCommand(),
+		blockCommand(),
+		obsCommand(),
+	)
+}
+
+// run executes the command line args and returns the exit code.
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	path := []string{"nhncloud"}
+	c := root()
+	// Global flags may come before the command; they are handed to it.
+	var global []string
+	for c.run == nil {
+		if len(args) > 0 && strings.HasPrefix(args[0], "-") && len(args[0]) > 1 && args[0] != "-h" && args[0] != "--help" {
+			n := 1
+			if name := strings.TrimLeft(args[0], "-"); !strings.Contains(name, "=") && !boolFlags[name] && len(args) > 1 {
+				n = 2
+			}
+			global = append(global, args[:n]...)
+			args = args[n:]
+			continue
+		}
+		if len(args) == 0 {
+			usage(stderr, path, c)
+			return 2
+		}
+		if args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+			usage(stdout, path, c)
+			return 0
+		}
+		sub := c.find(args[0])
+		if sub == nil {
+			fmt.Fprintf(stderr, "%s: unknown command %q\n\n", joinPath(path), args[0])
+			usage(stderr, path, c)
+			return 2
+		}
+		path = append(path, args[0])
+		args = args[1:]
+		c = sub
+	}
+
+	e := &env{stdin: stdin, stdout: stdout, stderr: stderr}
+	fs := flag.NewFlagSet(joinPath(path), flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	if c.flags != nil {
+		c.flags(fs)
+	}
+	e.opts.register(fs)
+	positional, err := parseArgs(fs, append(global, args...))
+	if errors.Is(err, flag.ErrHelp) {
+		usage(stdout, path, c)
+		return 0
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", joinPath(path), err)
+		return 2
+	}
+	if c.nargs >= 0 && len(positional) != c.nargs {
+		fmt.Fprintf(stderr, "%s: expected %d argument(s), got %d\n\n", joinPath(path), c.nargs, len(positional))
+		usage(stderr, path, c)
+		return 2
+	}
+	if (e.opts.wait || e.opts.dryRun) && !c.mutating {
+		fmt.Fprintf(stderr, "%s: --wait and --dry-run only apply to commands that change resources\n", joinPath(path))
+		return 2
+	}
+
+	result, err := c.run(ctx, e, positional)
+	if err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+	if result == nil {
+		return 0
+	}
+	if err := write(stdout, result, c.columns, e.opts.output, e.opts.query); err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// boolFlags are the global flags that take no value.
+var boolFlags = map[string]bool{"wait": true, "dry-run": true, "debug": true}
+
+func joinPath(path []string) string {
+	return strings.Join(path, " ")
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/loadbalancer"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/teardown"
+)
+
+func networkCommand() *command {
+	return group("network", "VPCs, security groups, floating IPs and load balancers.",
+		group("vpcs", "VPCs.",
+			&command{
+				name: "list", summary: "List VPCs.",
+				columns: []string{"id", "name", "cidrv4", "state", "created_time"},
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.network()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.VPC().ListVPCs(ctx)
+					if err != nil {
+						return nil, err
+					}
+					return out.VPCs, nil
+				},
+			},
+			&command{
+				name: "describe", summary: "Show a VPC.", args: "<vpc-id>", nargs: 1,
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.network()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.VPC().GetVPC(ctx, args[0])
+					if err != nil {
+						return nil, err
+					}
+					return out.VPC, nil
+				},
+			},
+			vpcTeardownCommand(),
+		),
+		group("security-groups", "Security groups.",
+			&command{
+				name: "list", summary: "List security groups.",
+				columns: []string{"id", "name", "description"},
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.network()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.SecurityGroup().ListSecurityGroups(ctx)
+					if err != nil {
+						return nil, err
+					}
+					return out.SecurityGroups, nil
+				},
+			},
+			&command{
+				name: "describe", summary: "Show a security group and its rules.", args: "<security-group-id>", nargs: 1,
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.network()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.SecurityGroup().GetSecurityGroup(ctx, args[0])
+					if err != nil {
+						return nil, err
+					}
+					return out.SecurityGroup, nil
+				},
+			},
+		),
+		group("floating-ips", "Floating IPs.",
+			&command{
+				name: "list", summary: "List floating IPs.",
+				columns: []string{"id", "floating_ip_address", "fixed_ip_address", "status", "port_id"},
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.network()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.FloatingIP().ListFloatingIPs(ctx)
+					if err != nil {
+						return nil, err
+					}
+					return out.FloatingIPs, nil
+				},
+			},
+		),
+		group("load-balancers", "Load balancers.",
+			&command{
+				name: "list", summary: "List load balancers.",
+				columns: []string{"id", "name", "vip_address", "provisioning_status", "operating_status"},
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.network()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.LoadBalancer().ListLoadBalancers(ctx)
+					if err != nil {
+						return nil, err
+					}
+					return out.LoadBalancers, nil
+				},
+			},
+			&command{
+				name: "describe", summary: "Show a load balancer.", args: "<load-balancer-id>", nargs: 1,
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.network()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.LoadBalancer().GetLoadBalancer(ctx, args[0])
+					if err != nil {
+						return nil, err
+					}
+					return out.LoadBalancer, nil
+				},
+			},
+			&command{
+				name: "delete", summary: "Delete a load balancer.", args: "<load-balancer-id>", nargs: 1, mutating: true,
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					id := args[0]
+					if e.dryRun("delete load balancer %s", id) {
+						return nil, nil
+					}
+					c, err := e.network()
+					if err != nil {
+						return nil, err
+					}
+					lb := c.LoadBalancer()
+					if err := lb.DeleteLoadBalancer(ctx, id); err != nil {
+						return nil, err
+					}
+					if !e.opts.wait {
+						return nil, nil
+					}
+					ctx, cancel := e.waitContext(ctx)
+					defer cancel()
+					_, err = lb.WaitForLoadBalancer(ctx, id, loadbalancer.ProvisioningStatusDeleted, 0)
+					return nil, err
+				},
+			},
+		),
+	)
+}
+
+// vpcTeardownCommand deletes a VPC and everything in it. It always prints
+// the plan first; with --dry-run that is all it does, and otherwise it asks
+// before running it unless given --yes.
+func vpcTeardownCommand() *command {
+	var release, yes bool
+	return &command{
+		name: "teardown", summary: "Delete a VPC and everything attached to it.", args: "<vpc-id>", nargs: 1, mutating: true,
+		flags: func(fs *flag.FlagSet) {
+			fs.BoolVar(&release, "release-floating-ips", false, "delete floating IPs instead of disassociating them")
+			fs.BoolVar(&yes, "yes", false, "run the plan without asking")
+		},
+		run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+			c, err := e.network()
+			if err != nil {
+				return nil, err
+			}
+			plan, err := teardown.PlanVPC(ctx, c, args[0], teardown.Options{ReleaseFloatingIPs: release})
+			if err != nil {
+				return nil, err
+			}
+			if err := plan.WriteText(e.stdout); err != nil {
+				return nil, err
+			}
+			if e.dryRun("run the %d steps above", len(plan.Steps)) {
+				return nil, nil
+			}
+			if !yes {
+				ok, err := e.confirm("Run the %d steps above?", len(plan.Steps))
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					return nil, errors.New("teardown not confirmed; answer y or pass --yes")
+				}
+			}
+			ctx, cancel := e.waitContext(ctx)
+			defer cancel()
+			_, err = plan.Execute(ctx, c, teardown.ExecuteOptions{
+				OnStep: func(r teardown.Result) { fmt.Fprintln(e.stdout, r) },
+			})
+			return nil, err
+		},
+	}
+}
+
+func (e *env) network() (*nhncloud.Client, error) {
+	return e.client("network")
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/container/nks"
+)
+
+func nksCommand() *command {
+	return group("nks", "Kubernetes clusters.",
+		group("clusters", "Clusters.",
+			&command{
+				name: "list", summary: "List clusters.",
+				columns: []string{"uuid", "name", "status", "health_status", "coe_version", "node_count"},
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.nks()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.ListClusters(ctx)
+					if err != nil {
+						return nil, err
+					}
+					return out.Clusters, nil
+				},
+			},
+			&command{
+				name: "describe", summary: "Show a cluster.", args: "<cluster-id>", nargs: 1,
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.nks()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.GetCluster(ctx, args[0])
+					if err != nil {
+						return nil, err
+					}
+					return out.Cluster, nil
+				},
+			},
+			&command{
+				name: "delete", summary: "Delete a cluster.", args: "<cluster-id>", nargs: 1, mutating: true,
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					id := args[0]
+					if e.dryRun("delete cluster %s", id) {
+						return nil, nil
+					}
+					c, err := e.nks()
+					if err != nil {
+						return nil, err
+					}
+					if err := c.DeleteCluster(ctx, id); err != nil {
+						return nil, err
+					}
+					if !e.opts.wait {
+						return nil, nil
+					}
+					ctx, cancel := e.waitContext(ctx)
+					defer cancel()
+					_, err = c.WaitForCluster(ctx, id, nks.StatusDeleteComplete, 0)
+					return nil, err
+				},
+			},
+		),
+		group("nodegroups", "Node groups.",
+			&command{
+				name: "list", summary: "List the node groups of a cluster.", args: "<cluster-id>", nargs: 1,
+				columns: []string{"uuid", "name", "status", "node_count", "flavor_id"},
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.nks()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.ListNodeGroups(ctx, args[0])
+					if err != nil {
+						return nil, err
+					}
+					return out.NodeGroups, nil
+				},
+			},
+			&command{
+				name: "describe", summary: "Show a node group.", args: "<cluster-id> <nodegroup-id>", nargs: 2,
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					c, err := e.nks()
+					if err != nil {
+						return nil, err
+					}
+					out, err := c.GetNodeGroup(ctx, args[0], args[1])
+					if err != nil {
+						return nil, err
+					}
+					return out.NodeGroup, nil
+				},
+			},
+		),
+	)
+}
+
+func (e *env) nks() (*nks.Client, error) {
+	c, err := e.client("nks")
+	if err != nil {
+		return nil, err
+	}
+	return c.NKS(), nil
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/storage/object"
+)
+
+// obsScheme prefixes object storage locations on the command line:
+// obs://container/key.
+const obsScheme = "obs://"
+
+// obsPageSize is the page size ls lists objects with.
+const obsPageSize = 1000
+
+func obsCommand() *command {
+	return group("obs", "Object storage.",
+		&command{
+			name: "ls", summary: "List containers, or the objects under obs://container[/prefix].", args: "[obs://container[/prefix]]", nargs: -1,
+			columns: []string{"name", "bytes", "content_type", "last_modified"},
+			run:     obsList,
+		},
+		&command{
+			name: "cp", summary: "Copy a file to, from or within object storage. \"-\" is stdin or stdout.", args: "<source> <destination>", nargs: 2, mutating: true,
+			run: obsCopy,
+		},
+		&command{
+			name: "rm", summary: "Delete an object.", args: "obs://container/key", nargs: 1, mutating: true,
+			run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+				container, key, err := parseObsURL(args[0])
+				if err != nil {
+					return nil, err
+				}
+				if key == "" {
+					return nil, fmt.Errorf("%s: no object key", args[0])
+				}
+				if e.dryRun("delete %s", args[0]) {
+					return nil, nil
+				}
+				c, err := e.obs()
+				if err != nil {
+					return nil, err
+				}
+				return nil, c.DeleteObject(ctx, container, key)
+			},
+		},
+	)
+}
+
+func (e *env) obs() (*object.Client, error) {
+	c, err := e.client("object-storage")
+	if err != nil {
+		return nil, err
+	}
+	return c.ObjectStorage(), nil
+}
+
+// parseObsURL splits obs://container/key.
+func parseObsURL(s string) (container, key string, err error) {
+	rest, ok := strings.CutPrefix(s, obsScheme)
+	if !ok || rest == "" {
+		return "", "", fmt.Errorf("%q is not an %scontainer/key location", s, obsScheme)
+	}
+	container, key, _ = strings.Cut(rest, "/")
+	return container, key, nil
+}
+
+func obsList(ctx context.Context, e *env, args []string) (interface{}, error) {
+	if len(args) > 1 {
+		return nil, fmt.Errorf("expected at most one location, got %d", len(args))
+	}
+	c, err := e.obs()
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		out, err := c.ListContainers(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		return out.Containers, nil
+	}
+
+	container, prefix, err := parseObsURL(args[0])
+	if err != nil {
+		return nil, err
+	}
+	var objects []object.Object
+	in := &object.ListObjectsInput{Prefix: prefix, Delimiter: "/", Limit: obsPageSize}
+	for {
+		out, err := c.ListObjects(ctx, container, in)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range out.CommonPrefixes {
+			objects = append(objects, object.Object{Subdir: p, Name: p})
+		}
+		objects = append(objects, out.Objects...)
+		n := len(out.Objects) + len(out.CommonPrefixes)
+		if n < obsPageSize {
+			return objects, nil
+		}
+		in.Marker = obsMarker(out)
+	}
+}
+
+// obsMarker returns the name the next page of a listing starts after: the
+// last entry of the page, which is its last object unless a subdirectory
+// sorts after it. The prefixes are listed first in objects, so its last
+// element is not necessarily the page's last entry.
+func obsMarker(out *object.ListObjectsOutput) string {
+	var marker string
+	if n := len(out.Objects); n > 0 {
+		marker = out.Objects[n-1].Name
+	}
+	if n := len(out.CommonPrefixes); n > 0 && out.CommonPrefixes[n-1] > marker {
+		marker = out.CommonPrefixes[n-1]
+	}
+	return marker
+}
+
+func obsCopy(ctx context.Context, e *env, args []string) (interface{}, error) {
+	src, dst := args[0], args[1]
+	srcRemote, dstRemote := strings.HasPrefix(src, obsScheme), strings.HasPrefix(dst, obsScheme)
+	switch {
+	case !srcRemote && !dstRemote:
+		return nil, fmt.Errorf("one of %q and %q must be an %s location", src, dst, obsScheme)
+	case srcRemote && dstRemote:
+		return obsCopyRemote(ctx, e, src, dst)
+	case dstRemote:
+		return obsUpload(ctx, e, src, dst)
+	default:
+		return obsDownload(ctx, e, src, dst)
+	}
+}
+
+func obsCopyRemote(ctx context.Context, e *env, src, dst string) (interface{}, error) {
+	sc, sk, err := parseObsURL(src)
+	if err != nil {
+		return nil, err
+	}
+	dc, dk, err := parseObsURL(dst)
+	if err != nil {
+		return nil, err
+	}
+	if sk == "" {
+		return nil, fmt.Errorf("%s: no object key", src)
+	}
+	if dk == "" || strings.HasSuffix(dk, "/") {
+		dk += path.Base(sk)
+	}
+	if e.dryRun("copy %s to %s%s/%s", src, obsScheme, dc, dk) {
+		return nil, nil
+	}
+	c, err := e.obs()
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.CopyObject(ctx, &object.CopyObjectInput{
+		SourceContainer:       sc,
+		SourceObjectName:      sk,
+		DestinationContainer:  dc,
+		DestinationObjectName: dk,
+	})
+}
+
+func obsUpload(ctx context.Context, e *env, src, dst string) (interface{}, error) {
+	container, key, err := parseObsURL(dst)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" || strings.HasSuffix(key, "/") {
+		if src == "-" {
+			return nil, fmt.Errorf("%s: an object key is required when copying from stdin", dst)
+		}
+		key += filepath.Base(src)
+	}
+	if e.dryRun("upload %s to %s%s/%s", src, obsScheme, container, key) {
+		return nil, nil
+	}
+
+	body := e.stdin
+	if src != "-" {
+		f, err := os.Open(src)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		body = f
+	}
+	c, err := e.obs()
+	if err != nil {
+		return nil, err
+	}
+	out, err := c.PutObject(ctx, &object.PutObjectInput{
+		Container:   container,
+		ObjectName:  key,
+		Body:        body,
+		ContentType: mime.TypeByExtension(path.Ext(key)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"object": obsScheme + container + "/" + key, "etag": out.ETag}, nil
+}
+
+func obsDownload(ctx context.Context, e *env, src, dst string) (interface{}, error) {
+	container, key, err := parseObsURL(src)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" {
+		return nil, fmt.Errorf("%s: no object key", src)
+	}
+	if dst != "-" {
+		if fi, err := os.Stat(dst); (err == nil && fi.IsDir()) || strings.HasSuffix(dst, string(os.PathSeparator)) {
+			dst = filepath.Join(dst, path.Base(key))
+		}
+	}
+	if e.dryRun("download %s to %s", src, dst) {
+		return nil, nil
+	}
+
+	c, err := e.obs()
+	if err != nil {
+		return nil, err
+	}
+	out, err := c.GetObject(ctx, container, key)
+	if err != nil {
+		return nil, err
+	}
+	defer out.Body.Close()
+
+	if dst == "-" {
+		_, err = io.Copy(e.stdout, out.Body)
+		return nil, err
+	}
+	// Download next to dst and rename, so a failed or interrupted download
+	// leaves any existing file untouched. CreateTemp makes the file private,
+	// so it is widened to 0644 first.
+	tmp, err := os.CreateTemp(filepath.Dir(dst), filepath.Base(dst)+".*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, out.Body); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Chmod(0o644); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+	return nil, os.Rename(tmp.Name(), dst)
+}
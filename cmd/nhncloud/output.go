@@ -0,0 +1,156 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/internal/query"
+	"github.com/haung921209/nhn-cloud-sdk-go/internal/yaml"
+)
+
+// write renders result in format after applying the --query expression.
+// columns pick the table columns of a list; without them, or after a query,
+// the columns are the union of the elements' keys.
+func write(w io.Writer, result interface{}, columns []string, format, expr string) error {
+	v, err := plain(result)
+	if err != nil {
+		return err
+	}
+	if expr != "" {
+		q, err := query.Parse(expr)
+		if err != nil {
+			return err
+		}
+		v = q.Eval(v)
+		columns = nil
+	}
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case "yaml":
+		return yaml.Write(w, v)
+	case "table", "":
+		return writeTable(w, v, columns)
+	}
+	return fmt.Errorf("unknown output format %q (want table, json or yaml)", format)
+}
+
+// plain converts v to the values encoding/json decodes into, so that output
+// and queries see the API's field names.
+func plain(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var out interface{}
+	if err := dec.Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func writeTable(w io.Writer, v interface{}, columns []string) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	switch v := v.(type) {
+	case []interface{}:
+		if !allMaps(v) {
+			for _, elem := range v {
+				fmt.Fprintln(tw, cell(elem))
+			}
+			break
+		}
+		if len(columns) == 0 {
+			columns = keys(v)
+		}
+		header := make([]string, len(columns))
+		for i, c := range columns {
+			header[i] = strings.ToUpper(c)
+		}
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+		for _, elem := range v {
+			m := elem.(map[string]interface{})
+			row := make([]string, len(columns))
+			for i, c := range columns {
+				row[i] = cell(query.Lookup(m, c))
+			}
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+	case map[string]interface{}:
+		// A single resource: one field per line.
+		for _, k := range sortedKeys(v) {
+			fmt.Fprintf(tw, "%s\t%s\n", k, cell(v[k]))
+		}
+	default:
+		fmt.Fprintln(tw, cell(v))
+	}
+	return tw.Flush()
+}
+
+func allMaps(list []interface{}) bool {
+	for _, elem := range list {
+		if _, ok := elem.(map[string]interface{}); !ok {
+			return false
+		}
+	}
+	return len(list) > 0
+}
+
+// keys returns the keys of the maps in list, scalar fields first.
+func keys(list []interface{}) []string {
+	seen := map[string]bool{}
+	var scalar, nested []string
+	for _, elem := range list {
+		for k, v := range elem.(map[string]interface{}) {
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			switch v.(type) {
+			case map[string]interface{}, []interface{}:
+				nested = append(nested, k)
+			default:
+				scalar = append(scalar, k)
+			}
+		}
+	}
+	sort.Strings(scalar)
+	sort.Strings(nested)
+	return append(scalar, nested...)
+}
+
+func cell(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "-"
+	case string:
+		if v == "" {
+			return "-"
+		}
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return ks
+}
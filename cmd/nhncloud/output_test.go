@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/storage/object"
+)
+
+var servers = []map[string]interface{}{
+	{"id": "s1", "name": "web-1", "status": "ACTIVE", "flavor": map[string]string{"id": "m2.c4m8"}},
+	{"id": "s2", "name": "web-2", "status": "SHUTOFF", "flavor": map[string]string{"id": "m2.c4m8"}},
+	{"id": "s3", "name": "db-1", "status": "ACTIVE", "flavor": map[string]string{"id": "r2.c8m64"}},
+}
+
+func TestQuery(t *testing.T) {
+	for _, tt := range []struct {
+		expr string
+		want string
+	}{
+		{"[?status=='ACTIVE'].name", `["web-1","db-1"]`},
+		{"[?status!='ACTIVE'].id", `["s2"]`},
+		{"[].flavor.id", `["m2.c4m8","m2.c4m8","r2.c8m64"]`},
+		{"[-1].name", `"db-1"`},
+		{"[?flavor.id=='r2.c8m64']", `[{"flavor":{"id":"r2.c8m64"},"id":"s3","name":"db-1","status":"ACTIVE"}]`},
+		{"[5].name", "null"},
+	} {
+		var buf bytes.Buffer
+		if err := write(&buf, servers, nil, "json", tt.expr); err != nil {
+			t.Fatalf("%s: %v", tt.expr, err)
+		}
+		got := strings.Join(strings.Fields(buf.String()), "")
+		if got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestTableAndYAML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := write(&buf, servers[:2], []string{"id", "status", "flavor.id"}, "table", ""); err != nil {
+		t.Fatal(err)
+	}
+	want := "ID  STATUS   FLAVOR.ID\ns1  ACTIVE   m2.c4m8\ns2  SHUTOFF  m2.c4m8\n"
+	if buf.String() != want {
+		t.Errorf("table:\n%s\nwant:\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	v := map[string]interface{}{"name": "web-1", "port": 3306, "tags": []string{"a", "true"}, "meta": map[string]string{}}
+	if err := write(&buf, v, nil, "yaml", ""); err != nil {
+		t.Fatal(err)
+	}
+	want = "meta: {}\nname: web-1\nport: 3306\ntags:\n  - a\n  - \"true\"\n"
+	if buf.String() != want {
+		t.Errorf("yaml:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestRunDryRun(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), []string{"compute", "servers", "stop", "--dry-run", "s1"}, nil, &stdout, &stderr)
+	if code != 0 || stdout.String() != "dry-run: would stop instance s1\n" {
+		t.Errorf("code %d, stdout %q, stderr %q", code, stdout.String(), stderr.String())
+	}
+
+	stdout.Reset()
+	if code := run(context.Background(), []string{"compute", "servers", "describe"}, nil, &stdout, &stderr); code != 2 {
+		t.Errorf("missing argument: code %d, want 2", code)
+	}
+}
+
+func TestRunUsage(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := run(context.Background(), nil, nil, &stdout, &stderr); code != 2 || stdout.Len() != 0 || !strings.Contains(stderr.String(), "compute") {
+		t.Errorf("no args: code %d, stdout %q, stderr %q", code, stdout.String(), stderr.String())
+	}
+
+	stderr.Reset()
+	if code := run(context.Background(), []string{"--help"}, nil, &stdout, &stderr); code != 0 || stderr.Len() != 0 || !strings.Contains(stdout.String(), "compute") {
+		t.Errorf("--help: code %d, stdout %q, stderr %q", code, stdout.String(), stderr.String())
+	}
+}
+
+func TestObsMarker(t *testing.T) {
+	for _, tt := range []struct {
+		out  object.ListObjectsOutput
+		want string
+	}{
+		{object.ListObjectsOutput{Objects: []object.Object{{Name: "a"}, {Name: "b"}}, CommonPrefixes: []string{"0/"}}, "b"},
+		{object.ListObjectsOutput{Objects: []object.Object{{Name: "a"}}, CommonPrefixes: []string{"0/", "z/"}}, "z/"},
+		{object.ListObjectsOutput{CommonPrefixes: []string{"x/", "y/"}}, "y/"},
+	} {
+		if got := obsMarker(&tt.out); got != tt.want {
+			t.Errorf("obsMarker(%+v) = %q, want %q", tt.out, got, tt.want)
+		}
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	for answer, want := range map[string]bool{"y\n": true, "YES\n": true, "n\n": false, "\n": false, "": false} {
+		var stderr bytes.Buffer
+		e := &env{stdin: strings.NewReader(answer), stderr: &stderr}
+		if got, err := e.confirm("Run the %d steps above?", 3); err != nil || got != want {
+			t.Errorf("confirm(%q) = %v, %v; want %v", answer, got, err, want)
+		}
+		if stderr.String() != "Run the 3 steps above? [y/N] " {
+			t.Errorf("prompt = %q", stderr.String())
+		}
+	}
+}
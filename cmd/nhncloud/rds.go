@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/database"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/mysql"
+)
+
+// rdsJobs are the instance operations every RDS engine client shares. The
+// engine packages alias the request and response types, so mysql's names
+// stand for all three. database.Engine covers the rest of the commands.
+type rdsJobs interface {
+	StartInstance(ctx context.Context, instanceID string) (*mysql.JobIDResponse, error)
+	StopInstance(ctx context.Context, instanceID string) (*mysql.JobIDResponse, error)
+	RestartInstance(ctx context.Context, instanceID string, req *mysql.RestartInstanceRequest) (*mysql.JobIDResponse, error)
+	DeleteInstance(ctx context.Context, instanceID string) (*mysql.JobIDResponse, error)
+}
+
+// rdsEngine is one engine's client, seen through both interfaces.
+type rdsEngine struct {
+	database.Engine
+	jobs rdsJobs
+}
+
+var rdsEngines = map[string]struct {
+	service string
+	engine  func(c *nhncloud.Client) rdsEngine
+}{
+	"mysql": {"rds-mysql", func(c *nhncloud.Client) rdsEngine {
+		db := c.MySQL()
+		return rdsEngine{database.NewMySQL(db), db}
+	}},
+	"mariadb": {"rds-mariadb", func(c *nhncloud.Client) rdsEngine {
+		db := c.MariaDB()
+		return rdsEngine{database.NewMariaDB(db), db}
+	}},
+	"postgresql": {"rds-postgresql", func(c *nhncloud.Client) rdsEngine {
+		db := c.PostgreSQL()
+		return rdsEngine{database.NewPostgreSQL(db), db}
+	}},
+}
+
+func rdsCommand() *command {
+	return group("rds", "Relational databases.",
+		rdsEngineCommand("mysql", "RDS for MySQL."),
+		rdsEngineCommand("mariadb", "RDS for MariaDB."),
+		rdsEngineCommand("postgresql", "RDS for PostgreSQL."),
+	)
+}
+
+func rdsEngineCommand(name, summary string) *command {
+	engine := func(e *env) (rdsEngine, error) {
+		def := rdsEngines[name]
+		c, err := e.client(def.service)
+		if err != nil {
+			return rdsEngine{}, err
+		}
+		return def.engine(c), nil
+	}
+	return group(name, summary,
+		group("instances", "DB instances.",
+			&command{
+				name: "list", summary: "List DB instances.",
+				columns: []string{"id", "name", "status", "version", "port", "storageSizeGb"},
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					en, err := engine(e)
+					if err != nil {
+						return nil, err
+					}
+					return en.ListInstances(ctx)
+				},
+			},
+			&command{
+				name: "describe", summary: "Show a DB instance.", args: "<instance-id>", nargs: 1,
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					en, err := engine(e)
+					if err != nil {
+						return nil, err
+					}
+					return en.GetInstance(ctx, args[0])
+				},
+			},
+			rdsAction(engine, "start", "Start a DB instance.", database.StatusAvailable,
+				func(ctx context.Context, j rdsJobs, id string) (*mysql.JobIDResponse, error) {
+					return j.StartInstance(ctx, id)
+				}),
+			rdsAction(engine, "stop", "Stop a DB instance.", database.StatusStopped,
+				func(ctx context.Context, j rdsJobs, id string) (*mysql.JobIDResponse, error) {
+					return j.StopInstance(ctx, id)
+				}),
+			rdsRestartCommand(engine),
+			rdsAction(engine, "delete", "Delete a DB instance.", database.StatusDeleted,
+				func(ctx context.Context, j rdsJobs, id string) (*mysql.JobIDResponse, error) {
+					return j.DeleteInstance(ctx, id)
+				}),
+		),
+		group("backups", "Backups.",
+			&command{
+				name: "list", summary: "List the backups of a DB instance.", args: "<instance-id>", nargs: 1,
+				columns: []string{"id", "name", "status", "type", "sizeBytes", "createdAt"},
+				run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+					en, err := engine(e)
+					if err != nil {
+						return nil, err
+					}
+					return en.ListBackups(ctx, args[0])
+				},
+			},
+		),
+	)
+}
+
+func rdsAction(engine func(*env) (rdsEngine, error), name, summary string, settled database.Status,
+	do func(context.Context, rdsJobs, string) (*mysql.JobIDResponse, error)) *command {
+	return &command{
+		name: name, summary: summary, args: "<instance-id>", nargs: 1, mutating: true,
+		run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+			return runRDSAction(ctx, e, engine, name, args[0], settled, do)
+		},
+	}
+}
+
+func rdsRestartCommand(engine func(*env) (rdsEngine, error)) *command {
+	var req mysql.RestartInstanceRequest
+	return &command{
+		name: "restart", summary: "Restart a DB instance.", args: "<instance-id>", nargs: 1, mutating: true,
+		flags: func(fs *flag.FlagSet) {
+			fs.BoolVar(&req.UseOnlineFailover, "online-failover", false, "fail over to the standby first (HA instances)")
+			fs.BoolVar(&req.ExecuteBackup, "backup", false, "take a backup before restarting")
+		},
+		run: func(ctx context.Context, e *env, args []string) (interface{}, error) {
+			return runRDSAction(ctx, e, engine, "restart", args[0], database.StatusAvailable,
+				func(ctx context.Context, j rdsJobs, id string) (*mysql.JobIDResponse, error) {
+					return j.RestartInstance(ctx, id, &req)
+				})
+		},
+	}
+}
+
+func runRDSAction(ctx context.Context, e *env, engine func(*env) (rdsEngine, error), action, id string, settled database.Status,
+	do func(context.Context, rdsJobs, string) (*mysql.JobIDResponse, error)) (interface{}, error) {
+	if e.dryRun("%s DB instance %s", action, id) {
+		return nil, nil
+	}
+	en, err := engine(e)
+	if err != nil {
+		return nil, err
+	}
+	var before *database.Instance
+	if e.opts.wait {
+		if before, err = en.GetInstance(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	job, err := do(ctx, en.jobs, id)
+	if err != nil {
+		return nil, err
+	}
+	if !e.opts.wait {
+		return job, nil
+	}
+	ctx, cancel := e.waitContext(ctx)
+	defer cancel()
+	// A restart ends in the status it started from, and the instance keeps
+	// reporting it until the operation begins.
+	if before.Status == settled {
+		if err := rdsWaitForChange(ctx, en, id, before); err != nil {
+			return nil, err
+		}
+	}
+	in, err := database.WaitForInstance(ctx, en, id, settled, 0)
+	if err != nil || in == nil {
+		return nil, err
+	}
+	return in, nil
+}
+
+// rdsPollInterval is how often rdsWaitForChange polls.
+const rdsPollInterval = 5 * time.Second
+
+// rdsWaitForChange polls until the instance no longer looks as it did
+// before an action: an operation is in progress, its status changed or it
+// was updated since. A gone instance counts as changed.
+func rdsWaitForChange(ctx context.Context, en rdsEngine, id string, before *database.Instance) error {
+	for {
+		in, err := en.GetInstance(ctx, id)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if in.Status.IsTransitioning() || in.Status != before.Status || !in.UpdatedAt.Equal(before.UpdatedAt) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for db instance %s to start the operation: %w", id, ctx.Err())
+		case <-time.After(rdsPollInterval):
+		}
+	}
+}
@@ -7,6 +7,8 @@
 // The captured JSON files are consumed by Phase A.1.2 of the multi-engine
 // clone plan to resolve UUIDs (flavor / subnet / parameter group / db
 // version) used by the rds-mariadb-p99 scenario.
+// They can also be replayed against the SDK's types with
+// NHN_SDK_REPLAY_DIR=$PWD/tests/captured-responses go test ./nhncloud/internal/replay.
 //
 // Credentials are loaded from environment variables first; if any of the
 // three required values are missing, we fall back to ~/.nhncloud/credentials
@@ -6,6 +6,8 @@
 //
 // The captured JSON files are consumed by Phase B.6 of the audit plan to
 // cross-check the static spec-vs-SDK regression report against ground truth.
+// They can also be replayed against the SDK's types with
+// NHN_SDK_REPLAY_DIR=$PWD/tests/captured-responses go test ./nhncloud/internal/replay.
 //
 // Credentials are loaded from environment variables first; if any of the
 // three required values are missing, we fall back to ~/.nhncloud/credentials
@@ -11,23 +11,18 @@ import (
 	"log"
 	"os"
 
-	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/database/mysql"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/mysql"
 )
 
 func main() {
-	// Create client configuration
-	cfg := mysql.Config{
-		Region:    os.Getenv("NHN_CLOUD_REGION"), // e.g., "kr1"
-		AppKey:    os.Getenv("NHN_CLOUD_MYSQL_APPKEY"),
-		AccessKey: os.Getenv("NHN_CLOUD_ACCESS_KEY"),
-		SecretKey: os.Getenv("NHN_CLOUD_SECRET_KEY"),
-	}
-
 	// Initialize MySQL client
-	client, err := mysql.NewClient(cfg)
-	if err != nil {
-		log.Fatalf("Failed to create client: %v", err)
-	}
+	client := mysql.NewClient(
+		os.Getenv("NHN_CLOUD_REGION"), // e.g., "kr1"
+		os.Getenv("NHN_CLOUD_MYSQL_APPKEY"),
+		credentials.NewStatic(os.Getenv("NHN_CLOUD_ACCESS_KEY"), os.Getenv("NHN_CLOUD_SECRET_KEY")),
+		false,
+	)
 
 	// List all instances
 	result, err := client.ListInstances(context.Background())
@@ -44,9 +39,7 @@ func main() {
 		fmt.Printf("    Status: %s\n", inst.DBInstanceStatus)
 		fmt.Printf("    Version: %s\n", inst.DBVersion)
 		fmt.Printf("    Port: %d\n", inst.DBPort)
-		if inst.Storage != nil {
-			fmt.Printf("    Storage: %s (%dGB)\n", inst.Storage.StorageType, inst.Storage.StorageSize)
-		}
+		fmt.Printf("    Storage: %s (%dGB)\n", inst.StorageType, inst.StorageSize)
 		// Ref: docs/api-specs/database/rds-mysql-v4.0.md — DatabaseInstance uses createdYmdt (not createdAt)
 		fmt.Printf("    Created: %s\n", inst.CreatedYmdt)
 		fmt.Println()
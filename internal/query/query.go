@@ -0,0 +1,232 @@
+// Package query evaluates the CLI's --query expressions against plain JSON
+// values.
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A Query selects from a value decoded from JSON with UseNumber. It is a small subset of JMESPath:
+//
+//	name                  field
+//	server.addresses      nested field
+//	[0], [-1]             list index
+//	[]                    project the rest of the query over a list
+//	[?status=='ACTIVE']   project over the elements matching == or !=
+//
+// so "[?status=='ACTIVE'].name" lists the names of active servers. Literals
+// are 'quoted strings', numbers, true, false and null.
+type Query []step
+
+type step struct {
+	kind  stepKind
+	field string
+	index int
+	// filter
+	path  []string
+	op    string
+	value interface{}
+}
+
+type stepKind int
+
+const (
+	stepField stepKind = iota
+	stepIndex
+	stepProject
+	stepFilter
+)
+
+// Parse parses a query expression.
+func Parse(s string) (Query, error) {
+	var q Query
+	rest := strings.TrimSpace(s)
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+		case '[':
+			end := closingBracket(rest)
+			if end < 0 {
+				return nil, fmt.Errorf("query %q: unterminated [", s)
+			}
+			inner := strings.TrimSpace(rest[1:end])
+			rest = rest[end+1:]
+			switch {
+			case inner == "" || inner == "*":
+				q = append(q, step{kind: stepProject})
+			case inner[0] == '?':
+				st, err := parseFilter(inner[1:])
+				if err != nil {
+					return nil, fmt.Errorf("query %q: %w", s, err)
+				}
+				q = append(q, st)
+			default:
+				n, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("query %q: bad index [%s]", s, inner)
+				}
+				q = append(q, step{kind: stepIndex, index: n})
+			}
+		default:
+			n := strings.IndexAny(rest, ".[")
+			if n < 0 {
+				n = len(rest)
+			}
+			name := strings.TrimSpace(rest[:n])
+			if name == "" {
+				return nil, fmt.Errorf("query %q: empty field name", s)
+			}
+			q = append(q, step{kind: stepField, field: name})
+			rest = rest[n:]
+		}
+	}
+	return q, nil
+}
+
+// closingBracket returns the index of the ] matching the [ at s[0], skipping
+// quoted literals.
+func closingBracket(s string) int {
+	quoted := false
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\'':
+			quoted = !quoted
+		case ']':
+			if !quoted {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func parseFilter(expr string) (step, error) {
+	op := "=="
+	i := strings.Index(expr, op)
+	if j := strings.Index(expr, "!="); j >= 0 && (i < 0 || j < i) {
+		op, i = "!=", j
+	}
+	if i < 0 {
+		return step{}, fmt.Errorf("filter %q: expected == or !=", expr)
+	}
+	field := strings.TrimSpace(expr[:i])
+	if field == "" {
+		return step{}, fmt.Errorf("filter %q: missing field", expr)
+	}
+	value, err := parseLiteral(strings.TrimSpace(expr[i+len(op):]))
+	if err != nil {
+		return step{}, fmt.Errorf("filter %q: %w", expr, err)
+	}
+	return step{kind: stepFilter, path: strings.Split(field, "."), op: op, value: value}, nil
+}
+
+func parseLiteral(s string) (interface{}, error) {
+	switch {
+	case len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'':
+		return s[1 : len(s)-1], nil
+	case len(s) >= 2 && s[0] == '`' && s[len(s)-1] == '`':
+		s = s[1 : len(s)-1]
+	}
+	switch s {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return json.Number(s), nil
+	}
+	return nil, fmt.Errorf("bad literal %q", s)
+}
+
+// Eval applies q to v, a value decoded from JSON with UseNumber. A path
+// that does not exist yields nil.
+func (q Query) Eval(v interface{}) interface{} {
+	for i, st := range q {
+		switch st.kind {
+		case stepField:
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			v = m[st.field]
+		case stepIndex:
+			list, ok := v.([]interface{})
+			if !ok {
+				return nil
+			}
+			n := st.index
+			if n < 0 {
+				n += len(list)
+			}
+			if n < 0 || n >= len(list) {
+				return nil
+			}
+			v = list[n]
+		case stepProject, stepFilter:
+			list, ok := v.([]interface{})
+			if !ok {
+				return nil
+			}
+			out := []interface{}{}
+			for _, elem := range list {
+				if st.kind == stepFilter && !st.matches(elem) {
+					continue
+				}
+				if r := q[i+1:].Eval(elem); r != nil {
+					out = append(out, r)
+				}
+			}
+			return out
+		}
+	}
+	return v
+}
+
+func (st step) matches(v interface{}) bool {
+	eq := literalEqual(lookup(v, st.path), st.value)
+	if st.op == "!=" {
+		return !eq
+	}
+	return eq
+}
+
+// Lookup follows a dotted path of field names through nested maps.
+func Lookup(v interface{}, path string) interface{} {
+	return lookup(v, strings.Split(path, "."))
+}
+
+func lookup(v interface{}, path []string) interface{} {
+	for _, f := range path {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[f]
+	}
+	return v
+}
+
+func literalEqual(a, b interface{}) bool {
+	an, aok := a.(json.Number)
+	bn, bok := b.(json.Number)
+	if aok && bok {
+		x, _ := an.Float64()
+		y, _ := bn.Float64()
+		return x == y
+	}
+	if aok || bok {
+		return false
+	}
+	switch a.(type) {
+	case map[string]interface{}, []interface{}:
+		return false
+	}
+	return a == b
+}
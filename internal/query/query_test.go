@@ -0,0 +1,33 @@
+package query
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseErrors(t *testing.T) {
+	for _, expr := range []string{"[?status]", "[?=='x']", "[abc]", "[0", "[?a=='x]"} {
+		if _, err := Parse(expr); err == nil {
+			t.Errorf("Parse(%q): expected an error", expr)
+		}
+	}
+}
+
+func TestEvalNumbers(t *testing.T) {
+	v := []interface{}{
+		map[string]interface{}{"name": "a", "size": json.Number("10")},
+		map[string]interface{}{"name": "b", "size": json.Number("10.0")},
+		map[string]interface{}{"name": "c", "size": "10"},
+	}
+	q, err := Parse("[?size==`10`].name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := json.Marshal(q.Eval(v))
+	if string(got) != `["a","b"]` {
+		t.Errorf("got %s", got)
+	}
+	if got := Lookup(v[0], "name.first"); got != nil {
+		t.Errorf("Lookup through a string = %v", got)
+	}
+}
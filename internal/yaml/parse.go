@@ -0,0 +1,458 @@
+// Package yaml reads and writes the subset of YAML that the SDK's
+// templates and the CLI's output use. Values are the plain values
+// encoding/json works with: maps, slices, strings, numbers, booleans and
+// nil.
+package yaml
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parse parses block mappings and sequences, plain and quoted scalars,
+// one-line flow sequences and mappings ([a, b], {k: v}), literal block
+// scalars (| and |-) and comments. Anchors, tags and multiple documents are
+// not supported. Integers decode as int64 and other numbers as float64.
+// A "${...}" reference stays whole inside a plain scalar, even when it
+// holds ": " or ",".
+func Parse(data []byte) (interface{}, error) {
+	p := &yamlParser{}
+	for i, raw := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		if strings.HasPrefix(strings.TrimLeft(raw, " "), "\t") {
+			return nil, fmt.Errorf("yaml line %d: tabs are not allowed for indentation", i+1)
+		}
+		p.lines = append(p.lines, yamlLine{num: i + 1, raw: raw, indent: len(raw) - len(strings.TrimLeft(raw, " "))})
+	}
+	p.skipBlank()
+	if p.pos == len(p.lines) {
+		return nil, nil
+	}
+	if first := p.lines[p.pos]; first.text() == "---" {
+		p.pos++
+		p.skipBlank()
+	}
+	v, err := p.block(p.lines[p.pos].indent)
+	if err != nil {
+		return nil, err
+	}
+	p.skipBlank()
+	if p.pos < len(p.lines) {
+		return nil, p.errorf("unexpected content %q", p.lines[p.pos].text())
+	}
+	return v, nil
+}
+
+type yamlLine struct {
+	num    int
+	raw    string
+	indent int
+	// override replaces the content after the indentation, for a mapping
+	// that starts on a sequence item's line.
+	override *string
+}
+
+// text is the line's content without indentation and comment.
+func (l yamlLine) text() string {
+	if l.override != nil {
+		return *l.override
+	}
+	return strings.TrimSpace(stripComment(l.raw[l.indent:]))
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+func (p *yamlParser) errorf(format string, args ...interface{}) error {
+	num := 0
+	if p.pos < len(p.lines) {
+		num = p.lines[p.pos].num
+	} else if len(p.lines) > 0 {
+		num = p.lines[len(p.lines)-1].num
+	}
+	return fmt.Errorf("yaml line %d: %s", num, fmt.Sprintf(format, args...))
+}
+
+func (p *yamlParser) skipBlank() {
+	for p.pos < len(p.lines) && p.lines[p.pos].text() == "" {
+		p.pos++
+	}
+}
+
+// block parses the mapping or sequence that starts at the current line.
+func (p *yamlParser) block(indent int) (interface{}, error) {
+	if isSeqItem(p.lines[p.pos].text()) {
+		return p.sequence(indent)
+	}
+	return p.mapping(indent)
+}
+
+func isSeqItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+func (p *yamlParser) mapping(indent int) (interface{}, error) {
+	m := map[string]interface{}{}
+	for {
+		p.skipBlank()
+		if p.pos == len(p.lines) || p.lines[p.pos].indent < indent {
+			return m, nil
+		}
+		line := p.lines[p.pos]
+		if line.indent > indent {
+			return nil, p.errorf("unexpected indentation")
+		}
+		text := line.text()
+		if isSeqItem(text) {
+			return m, nil
+		}
+		i := keyEnd(text)
+		if i < 0 {
+			return nil, p.errorf("expected \"key: value\", got %q", text)
+		}
+		key, err := scalarString(strings.TrimSpace(text[:i]))
+		if err != nil {
+			return nil, p.errorf("%v", err)
+		}
+		if _, dup := m[key]; dup {
+			return nil, p.errorf("duplicate key %q", key)
+		}
+		rest := strings.TrimSpace(text[i+1:])
+		p.pos++
+		v, err := p.value(rest, indent)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = v
+	}
+}
+
+func (p *yamlParser) sequence(indent int) (interface{}, error) {
+	list := []interface{}{}
+	for {
+		p.skipBlank()
+		if p.pos == len(p.lines) || p.lines[p.pos].indent < indent {
+			return list, nil
+		}
+		line := p.lines[p.pos]
+		text := line.text()
+		if line.indent > indent {
+			return nil, p.errorf("unexpected indentation")
+		}
+		if !isSeqItem(text) {
+			return list, nil
+		}
+		rest := strings.TrimSpace(strings.TrimPrefix(text, "-"))
+		if rest != "" && keyEnd(rest) >= 0 && !strings.ContainsAny(rest[:1], `"'[{`) {
+			// "- key: value" starts a mapping indented to the key.
+			offset := len(text) - len(strings.TrimLeft(text[1:], " "))
+			p.lines[p.pos].indent = indent + offset
+			p.lines[p.pos].override = &rest
+			v, err := p.mapping(indent + offset)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+			continue
+		}
+		p.pos++
+		v, err := p.value(rest, indent)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+}
+
+// value parses what follows "key:" or "-": a scalar, a flow collection, a
+// block scalar, or a nested block on the next lines.
+func (p *yamlParser) value(rest string, indent int) (interface{}, error) {
+	switch rest {
+	case "":
+		p.skipBlank()
+		if p.pos == len(p.lines) {
+			return nil, nil
+		}
+		next := p.lines[p.pos]
+		if next.indent > indent || (next.indent == indent && isSeqItem(next.text())) {
+			return p.block(next.indent)
+		}
+		return nil, nil
+	case "|", "|-":
+		return p.literal(indent, rest == "|"), nil
+	}
+	if rest[0] == '[' || rest[0] == '{' {
+		f := &flowParser{s: rest}
+		v, err := f.value()
+		if err == nil {
+			f.space()
+			if f.i < len(f.s) {
+				err = fmt.Errorf("unexpected %q after flow collection", f.s[f.i:])
+			}
+		}
+		if err != nil {
+			p.pos--
+			return nil, p.errorf("%v", err)
+		}
+		return v, nil
+	}
+	v, err := scalar(rest)
+	if err != nil {
+		p.pos--
+		return nil, p.errorf("%v", err)
+	}
+	return v, nil
+}
+
+// literal reads a block scalar: the following lines indented deeper than
+// indent, with their common indentation removed.
+func (p *yamlParser) literal(indent int, keepNewline bool) string {
+	var lines []string
+	block := -1
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if strings.TrimSpace(l.raw) == "" {
+			lines = append(lines, "")
+			p.pos++
+			continue
+		}
+		if l.indent <= indent {
+			break
+		}
+		if block < 0 {
+			block = l.indent
+		}
+		if l.indent < block {
+			break
+		}
+		lines = append(lines, l.raw[block:])
+		p.pos++
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	s := strings.Join(lines, "\n")
+	if keepNewline && s != "" {
+		s += "\n"
+	}
+	return s
+}
+
+// stripComment removes a " #" comment outside quotes.
+func stripComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || s[i-1] == ' '):
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// keyEnd returns the index of the colon that ends a mapping key, or -1.
+func keyEnd(s string) int {
+	var quote byte
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '{' && i > 0 && s[i-1] == '$':
+			depth++
+		case c == '}' && depth > 0:
+			depth--
+		case c == ':' && depth == 0 && (i == len(s)-1 || s[i+1] == ' '):
+			return i
+		}
+	}
+	return -1
+}
+
+// scalar converts a plain or quoted scalar.
+func scalar(s string) (interface{}, error) {
+	switch s {
+	case "null", "~":
+		return nil, nil
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	if s[0] == '"' || s[0] == '\'' {
+		return scalarString(s)
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, nil
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil && strings.ContainsAny(s, "0123456789") {
+		return f, nil
+	}
+	return s, nil
+}
+
+// scalarString unquotes a quoted scalar and returns a plain one as is.
+func scalarString(s string) (string, error) {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return strconv.Unquote(s)
+	}
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+	}
+	if s != "" && (s[0] == '"' || s[0] == '\'') {
+		return "", fmt.Errorf("unterminated string %s", s)
+	}
+	return s, nil
+}
+
+// flowParser parses a one-line flow collection.
+type flowParser struct {
+	s string
+	i int
+}
+
+func (f *flowParser) space() {
+	for f.i < len(f.s) && f.s[f.i] == ' ' {
+		f.i++
+	}
+}
+
+func (f *flowParser) value() (interface{}, error) {
+	f.space()
+	if f.i == len(f.s) {
+		return nil, fmt.Errorf("unexpected end of flow collection")
+	}
+	switch f.s[f.i] {
+	case '[':
+		f.i++
+		list := []interface{}{}
+		for {
+			f.space()
+			if f.i < len(f.s) && f.s[f.i] == ']' {
+				f.i++
+				return list, nil
+			}
+			v, err := f.value()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+			if err := f.separator(']'); err != nil {
+				return nil, err
+			}
+		}
+	case '{':
+		f.i++
+		m := map[string]interface{}{}
+		for {
+			f.space()
+			if f.i < len(f.s) && f.s[f.i] == '}' {
+				f.i++
+				return m, nil
+			}
+			k, err := f.token(":")
+			if err != nil {
+				return nil, err
+			}
+			key, err := scalarString(k)
+			if err != nil {
+				return nil, err
+			}
+			if f.i == len(f.s) || f.s[f.i] != ':' {
+				return nil, fmt.Errorf("expected ':' after key %q", key)
+			}
+			f.i++
+			v, err := f.value()
+			if err != nil {
+				return nil, err
+			}
+			m[key] = v
+			if err := f.separator('}'); err != nil {
+				return nil, err
+			}
+		}
+	}
+	tok, err := f.token("")
+	if err != nil {
+		return nil, err
+	}
+	return scalar(tok)
+}
+
+// separator consumes a comma, or stops before the closing bracket.
+func (f *flowParser) separator(end byte) error {
+	f.space()
+	if f.i < len(f.s) && f.s[f.i] == ',' {
+		f.i++
+		return nil
+	}
+	if f.i < len(f.s) && f.s[f.i] == end {
+		return nil
+	}
+	return fmt.Errorf("expected ',' or %q", end)
+}
+
+// token reads a quoted or plain scalar up to a comma, closing bracket or
+// one of stop. "${...}" references are read whole.
+func (f *flowParser) token(stop string) (string, error) {
+	f.space()
+	start := f.i
+	if f.i < len(f.s) && (f.s[f.i] == '"' || f.s[f.i] == '\'') {
+		q := f.s[f.i]
+		for f.i++; f.i < len(f.s); f.i++ {
+			if f.s[f.i] == '\\' && q == '"' {
+				f.i++
+				continue
+			}
+			if f.s[f.i] == q {
+				if q == '\'' && f.i+1 < len(f.s) && f.s[f.i+1] == '\'' {
+					f.i++
+					continue
+				}
+				f.i++
+				return f.s[start:f.i], nil
+			}
+		}
+		return "", fmt.Errorf("unterminated string %s", f.s[start:])
+	}
+	depth := 0
+	for ; f.i < len(f.s); f.i++ {
+		c := f.s[f.i]
+		if c == '{' && f.i > 0 && f.s[f.i-1] == '$' {
+			depth++
+			continue
+		}
+		if depth > 0 {
+			if c == '}' {
+				depth--
+			}
+			continue
+		}
+		if c == ',' || c == ']' || c == '}' || strings.IndexByte(stop, c) >= 0 {
+			break
+		}
+	}
+	tok := strings.TrimSpace(f.s[start:f.i])
+	if tok == "" {
+		return "", fmt.Errorf("empty value in flow collection")
+	}
+	return tok, nil
+}
@@ -0,0 +1,111 @@
+package yaml
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Write writes v, a plain JSON value, to w as a YAML document. Mapping keys
+// are sorted, and strings that would read back as another type are quoted.
+func Write(w io.Writer, v interface{}) error {
+	var buf bytes.Buffer
+	writeBlock(&buf, v, 0)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// writeBlock writes v as a block at indent.
+func writeBlock(buf *bytes.Buffer, v interface{}, indent int) {
+	pad := strings.Repeat("  ", indent)
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			buf.WriteString(pad + "{}\n")
+			return
+		}
+		for _, k := range sortedKeys(v) {
+			buf.WriteString(pad + quote(k) + ":")
+			writeValue(buf, v[k], indent+1)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString(pad + "[]\n")
+			return
+		}
+		for _, elem := range v {
+			buf.WriteString(pad + "-")
+			writeValue(buf, elem, indent+1)
+		}
+	default:
+		buf.WriteString(pad + formatScalar(v) + "\n")
+	}
+}
+
+// writeValue writes the value following a "key:" or "-".
+func writeValue(buf *bytes.Buffer, v interface{}, indent int) {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		if len(vv) > 0 {
+			buf.WriteString("\n")
+			writeBlock(buf, vv, indent)
+			return
+		}
+		buf.WriteString(" {}\n")
+	case []interface{}:
+		if len(vv) > 0 {
+			buf.WriteString("\n")
+			writeBlock(buf, vv, indent)
+			return
+		}
+		buf.WriteString(" []\n")
+	default:
+		buf.WriteString(" " + formatScalar(v) + "\n")
+	}
+}
+
+func formatScalar(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return quote(v)
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return fmt.Sprint(v)
+}
+
+// quote quotes s when it would otherwise read as something other than a
+// plain string.
+func quote(s string) string {
+	if s == "" {
+		return `""`
+	}
+	switch strings.ToLower(s) {
+	case "null", "~", "true", "false", "yes", "no", "on", "off":
+		return strconv.Quote(s)
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return strconv.Quote(s)
+	}
+	if strings.ContainsAny(s, ":#{}[],&*!|>'\"%@`\n\t") || strings.TrimSpace(s) != s || strings.HasPrefix(s, "-") || strings.HasPrefix(s, "?") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return ks
+}
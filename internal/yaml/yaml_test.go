@@ -0,0 +1,32 @@
+package yaml
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestWriteParsesBack(t *testing.T) {
+	v := map[string]interface{}{
+		"name":    "web-1",
+		"port":    int64(3306),
+		"ratio":   1.5,
+		"enabled": true,
+		"note":    nil,
+		"tags":    []interface{}{"a", "true", "10", "-x", "k: v", ""},
+		"meta":    map[string]interface{}{},
+		"list":    []interface{}{},
+		"nested":  []interface{}{map[string]interface{}{"id": "s1", "ports": []interface{}{int64(80)}}},
+	}
+	var buf bytes.Buffer
+	if err := Write(&buf, v); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Parse(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", buf.String(), err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("round trip:\n%s\ngot  %#v\nwant %#v", buf.String(), got, v)
+	}
+}
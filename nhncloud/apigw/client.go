@@ -9,6 +9,12 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 // Client handles API Gateway API operations
@@ -19,24 +25,34 @@ type Client struct {
 	secretKey   string
 	httpClient  *http.Client
 	debug       bool
+	drift       *drift.Detector
 }
 
-// NewClient creates a new API Gateway client
-func NewClient(region, appKey, accessKeyID, secretKey string, hc *http.Client, debug bool) *Client {
-	c := &Client{
+// New creates an API Gateway client that authenticates with the User Access
+// Key in creds. A nil hc uses http.DefaultClient.
+func New(region, appKey string, creds credentials.Credentials, hc *http.Client, debug bool, opts ...option.Option) *Client {
+	settings := option.Apply(opts...)
+	if settings.HTTPClient == nil {
+		settings.HTTPClient = hc
+	}
+	accessKeyID, secretKey := credentials.UserAccessKey(creds)
+	return &Client{
 		region:      region,
 		appKey:      appKey,
 		accessKeyID: accessKeyID,
 		secretKey:   secretKey,
-		httpClient:  hc,
+		httpClient:  client.HTTPClient(settings, http.DefaultClient),
+		drift:       client.Drift(settings),
 		debug:       debug,
 	}
+}
 
-	if hc == nil {
-		c.httpClient = http.DefaultClient
-	}
-
-	return c
+// NewClient creates a new API Gateway client from a User Access Key.
+//
+// Deprecated: Use New, which takes the key as credentials and accepts the
+// shared options.
+func NewClient(region, appKey, accessKeyID, secretKey string, hc *http.Client, debug bool) *Client {
+	return New(region, appKey, credentials.NewStatic(accessKeyID, secretKey), hc, debug)
 }
 
 func (c *Client) getBaseURL() string {
@@ -57,7 +73,7 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		reqBody = bytes.NewReader(jsonBody)
 
 		if c.debug {
-			fmt.Printf("[DEBUG] APIGW Request Body: %s\n", string(jsonBody))
+			fmt.Printf("[DEBUG] APIGW Request Body: %s\n", secret.RedactJSON(jsonBody))
 		}
 	}
 
@@ -93,17 +109,26 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 	if c.debug {
 		fmt.Printf("[DEBUG] APIGW Response: %d %s\n", resp.StatusCode, resp.Status)
 		if len(respBody) > 0 {
-			fmt.Printf("[DEBUG] APIGW Response Body: %s\n", string(respBody))
+			fmt.Printf("[DEBUG] APIGW Response Body: %s\n", secret.RedactJSON(respBody))
 		}
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API error: %d %s - %s", resp.StatusCode, resp.Status, string(respBody))
+		return nil, fmt.Errorf("API error: %d %s - %s", resp.StatusCode, resp.Status, secret.RedactJSON(respBody))
 	}
 
 	return respBody, nil
 }
 
+// decode unmarshals a response body into v, checking it for unmapped
+// fields first when drift detection is enabled.
+func (c *Client) decode(body []byte, v interface{}) error {
+	if err := c.drift.Check("apigw", "", body, v); err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
 // --- Service Operations ---
 
 // ListServices lists all API Gateway services
@@ -113,7 +138,7 @@ func (c *Client) ListServices(ctx context.Context) (*ListServicesOutput, error)
 		return nil, fmt.Errorf("list services: %w", err)
 	}
 	var out ListServicesOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -126,7 +151,7 @@ func (c *Client) GetService(ctx context.Context, serviceID string) (*GetServiceO
 		return nil, fmt.Errorf("get service %s: %w", serviceID, err)
 	}
 	var out GetServiceOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -139,7 +164,7 @@ func (c *Client) CreateService(ctx context.Context, input *CreateServiceInput) (
 		return nil, fmt.Errorf("create service: %w", err)
 	}
 	var out GetServiceOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -152,7 +177,7 @@ func (c *Client) UpdateService(ctx context.Context, serviceID string, input *Upd
 		return nil, fmt.Errorf("update service %s: %w", serviceID, err)
 	}
 	var out GetServiceOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -175,7 +200,7 @@ func (c *Client) ListResources(ctx context.Context, serviceID string) (*ListReso
 		return nil, fmt.Errorf("list resources: %w", err)
 	}
 	var out ListResourcesOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -188,7 +213,7 @@ func (c *Client) CreateResource(ctx context.Context, serviceID string, input *Cr
 		return nil, fmt.Errorf("create resource: %w", err)
 	}
 	var out GetResourceOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -211,7 +236,7 @@ func (c *Client) ListStages(ctx context.Context, serviceID string) (*ListStagesO
 		return nil, fmt.Errorf("list stages: %w", err)
 	}
 	var out ListStagesOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -224,7 +249,7 @@ func (c *Client) CreateStage(ctx context.Context, serviceID string, input *Creat
 		return nil, fmt.Errorf("create stage: %w", err)
 	}
 	var out GetStageOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -237,7 +262,7 @@ func (c *Client) UpdateStage(ctx context.Context, serviceID, stageID string, inp
 		return nil, fmt.Errorf("update stage %s: %w", stageID, err)
 	}
 	var out GetStageOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -260,7 +285,7 @@ func (c *Client) ListDeploys(ctx context.Context, serviceID, stageID string) (*L
 		return nil, fmt.Errorf("list deploys: %w", err)
 	}
 	var out ListDeploysOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -273,7 +298,7 @@ func (c *Client) DeployStage(ctx context.Context, serviceID, stageID string, inp
 		return nil, fmt.Errorf("deploy stage: %w", err)
 	}
 	var out GetDeployOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -286,7 +311,7 @@ func (c *Client) GetLatestDeploy(ctx context.Context, serviceID, stageID string)
 		return nil, fmt.Errorf("get latest deploy: %w", err)
 	}
 	var out GetDeployOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -307,7 +332,7 @@ func (c *Client) RollbackDeploy(ctx context.Context, serviceID, stageID, deployI
 		return nil, fmt.Errorf("rollback deploy: %w", err)
 	}
 	var out GetDeployOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -322,7 +347,7 @@ func (c *Client) ListAPIKeys(ctx context.Context) (*ListAPIKeysOutput, error) {
 		return nil, fmt.Errorf("list API keys: %w", err)
 	}
 	var out ListAPIKeysOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -335,7 +360,7 @@ func (c *Client) CreateAPIKey(ctx context.Context, input *CreateAPIKeyInput) (*G
 		return nil, fmt.Errorf("create API key: %w", err)
 	}
 	var out GetAPIKeyOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -348,7 +373,7 @@ func (c *Client) UpdateAPIKey(ctx context.Context, apiKeyID string, input *Updat
 		return nil, fmt.Errorf("update API key %s: %w", apiKeyID, err)
 	}
 	var out GetAPIKeyOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -369,7 +394,7 @@ func (c *Client) RegenerateAPIKey(ctx context.Context, apiKeyID string, input *R
 		return nil, fmt.Errorf("regenerate API key: %w", err)
 	}
 	var out GetAPIKeyOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -384,7 +409,7 @@ func (c *Client) ListUsagePlans(ctx context.Context) (*ListUsagePlansOutput, err
 		return nil, fmt.Errorf("list usage plans: %w", err)
 	}
 	var out ListUsagePlansOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -397,7 +422,7 @@ func (c *Client) GetUsagePlan(ctx context.Context, usagePlanID string) (*GetUsag
 		return nil, fmt.Errorf("get usage plan %s: %w", usagePlanID, err)
 	}
 	var out GetUsagePlanOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -410,7 +435,7 @@ func (c *Client) CreateUsagePlan(ctx context.Context, input *CreateUsagePlanInpu
 		return nil, fmt.Errorf("create usage plan: %w", err)
 	}
 	var out GetUsagePlanOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -423,7 +448,7 @@ func (c *Client) UpdateUsagePlan(ctx context.Context, usagePlanID string, input
 		return nil, fmt.Errorf("update usage plan %s: %w", usagePlanID, err)
 	}
 	var out GetUsagePlanOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -444,7 +469,7 @@ func (c *Client) ListUsagePlanStages(ctx context.Context, usagePlanID string) (*
 		return nil, fmt.Errorf("list usage plan stages: %w", err)
 	}
 	var out ListUsagePlanStagesOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -475,7 +500,7 @@ func (c *Client) ListSubscriptions(ctx context.Context, usagePlanID, stageID str
 		return nil, fmt.Errorf("list subscriptions: %w", err)
 	}
 	var out ListSubscriptionsOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -488,7 +513,7 @@ func (c *Client) CreateSubscription(ctx context.Context, usagePlanID, stageID st
 		return nil, fmt.Errorf("create subscription: %w", err)
 	}
 	var out GetSubscriptionOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -512,7 +537,7 @@ func (c *Client) ListModels(ctx context.Context, serviceID string) (*ListModelsO
 		return nil, fmt.Errorf("list models: %w", err)
 	}
 	var out ListModelsOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -525,7 +550,7 @@ func (c *Client) CreateModel(ctx context.Context, serviceID string, input *Creat
 		return nil, fmt.Errorf("create model: %w", err)
 	}
 	var out GetModelOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -538,7 +563,7 @@ func (c *Client) UpdateModel(ctx context.Context, serviceID, modelID string, inp
 		return nil, fmt.Errorf("update model %s: %w", modelID, err)
 	}
 	var out GetModelOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -561,7 +586,7 @@ func (c *Client) ListGatewayResponses(ctx context.Context, serviceID string) (*L
 		return nil, fmt.Errorf("list gateway responses: %w", err)
 	}
 	var out ListGatewayResponsesOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -581,11 +606,11 @@ func (c *Client) DeleteGatewayResponse(ctx context.Context, serviceID, gatewayRe
 func (c *Client) GetStageMetrics(ctx context.Context, serviceID, stageID string, input *MetricsInput) (*GetStageMetricsOutput, error) {
 	query := url.Values{}
 	if input != nil {
-		if input.StartTime != "" {
-			query.Set("startTime", input.StartTime)
+		if !input.StartTime.IsZero() {
+			query.Set("startTime", input.StartTime.String())
 		}
-		if input.EndTime != "" {
-			query.Set("endTime", input.EndTime)
+		if !input.EndTime.IsZero() {
+			query.Set("endTime", input.EndTime.String())
 		}
 		if input.TimeUnit != "" {
 			query.Set("timeUnit", input.TimeUnit)
@@ -596,7 +621,7 @@ func (c *Client) GetStageMetrics(ctx context.Context, serviceID, stageID string,
 		return nil, fmt.Errorf("get stage metrics: %w", err)
 	}
 	var out GetStageMetricsOutput
-	if err := json.Unmarshal(respBody, &out); err != nil {
+	if err := c.decode(respBody, &out); err != nil {
 		return nil, fmt.Errorf("decode response: %w", err)
 	}
 	return &out, nil
@@ -1,7 +1,9 @@
 // Package apigw provides API Gateway service client for NHN Cloud.
 package apigw
 
-import "time"
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
 
 // Header represents the API response header
 type Header struct {
@@ -14,14 +16,14 @@ type Header struct {
 
 // Service represents an API Gateway service
 type Service struct {
-	ID          string     `json:"apigwServiceId"`
-	Name        string     `json:"apigwServiceName"`
-	Description string     `json:"apigwServiceDescription,omitempty"`
-	TypeCode    string     `json:"apigwServiceTypeCode"`
-	AppKey      string     `json:"appKey"`
-	RegionCode  string     `json:"regionCode"`
-	CreatedAt   *time.Time `json:"createdAt,omitempty"`
-	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+	ID          string          `json:"apigwServiceId"`
+	Name        string          `json:"apigwServiceName"`
+	Description string          `json:"apigwServiceDescription,omitempty"`
+	TypeCode    string          `json:"apigwServiceTypeCode"`
+	AppKey      string          `json:"appKey"`
+	RegionCode  string          `json:"regionCode"`
+	CreatedAt   *timestamp.Time `json:"createdAt,omitempty"`
+	UpdatedAt   *timestamp.Time `json:"updatedAt,omitempty"`
 }
 
 // ListServicesOutput represents the response for services list
@@ -52,16 +54,16 @@ type UpdateServiceInput struct {
 
 // Stage represents a deployment stage
 type Stage struct {
-	ID                 string     `json:"stageId"`
-	ServiceID          string     `json:"apigwServiceId"`
-	RegionCode         string     `json:"regionCode"`
-	Name               string     `json:"stageName"`
-	Description        string     `json:"stageDescription,omitempty"`
-	URL                string     `json:"stageUrl,omitempty"`
-	BackendEndpointURL string     `json:"backendEndpointUrl,omitempty"`
-	ResourceUpdatedAt  *time.Time `json:"resourceUpdatedAt,omitempty"`
-	CreatedAt          *time.Time `json:"createdAt,omitempty"`
-	UpdatedAt          *time.Time `json:"updatedAt,omitempty"`
+	ID                 string          `json:"stageId"`
+	ServiceID          string          `json:"apigwServiceId"`
+	RegionCode         string          `json:"regionCode"`
+	Name               string          `json:"stageName"`
+	Description        string          `json:"stageDescription,omitempty"`
+	URL                string          `json:"stageUrl,omitempty"`
+	BackendEndpointURL string          `json:"backendEndpointUrl,omitempty"`
+	ResourceUpdatedAt  *timestamp.Time `json:"resourceUpdatedAt,omitempty"`
+	CreatedAt          *timestamp.Time `json:"createdAt,omitempty"`
+	UpdatedAt          *timestamp.Time `json:"updatedAt,omitempty"`
 }
 
 // ListStagesOutput represents the response for stages list
@@ -112,8 +114,8 @@ type Resource struct {
 	BackendServiceType string          `json:"backendServiceType,omitempty"`
 	Plugin             *ResourcePlugin `json:"plugin,omitempty"`
 	ResourceType       string          `json:"resourceType"`
-	CreatedAt          *time.Time      `json:"createdAt,omitempty"`
-	UpdatedAt          *time.Time      `json:"updatedAt,omitempty"`
+	CreatedAt          *timestamp.Time `json:"createdAt,omitempty"`
+	UpdatedAt          *timestamp.Time `json:"updatedAt,omitempty"`
 	Children           []Resource      `json:"children,omitempty"`
 }
 
@@ -145,13 +147,13 @@ type CreateResourceInput struct {
 
 // Deploy represents a deployment
 type Deploy struct {
-	ID          string     `json:"deployId"`
-	ServiceID   string     `json:"apigwServiceId"`
-	StageID     string     `json:"stageId"`
-	StatusCode  string     `json:"deployStatusCode"`
-	Description string     `json:"deployDescription,omitempty"`
-	CreatedAt   *time.Time `json:"createdAt,omitempty"`
-	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+	ID          string          `json:"deployId"`
+	ServiceID   string          `json:"apigwServiceId"`
+	StageID     string          `json:"stageId"`
+	StatusCode  string          `json:"deployStatusCode"`
+	Description string          `json:"deployDescription,omitempty"`
+	CreatedAt   *timestamp.Time `json:"createdAt,omitempty"`
+	UpdatedAt   *timestamp.Time `json:"updatedAt,omitempty"`
 }
 
 // ListDeploysOutput represents the response for deployments list
@@ -175,14 +177,14 @@ type CreateDeployInput struct {
 
 // APIKey represents an API key
 type APIKey struct {
-	ID           string     `json:"apiKeyId"`
-	Name         string     `json:"apiKeyName"`
-	Description  string     `json:"apiKeyDescription,omitempty"`
-	PrimaryKey   string     `json:"primaryKey,omitempty"`
-	SecondaryKey string     `json:"secondaryKey,omitempty"`
-	StatusCode   string     `json:"apiKeyStatusCode"`
-	CreatedAt    *time.Time `json:"createdAt,omitempty"`
-	UpdatedAt    *time.Time `json:"updatedAt,omitempty"`
+	ID           string          `json:"apiKeyId"`
+	Name         string          `json:"apiKeyName"`
+	Description  string          `json:"apiKeyDescription,omitempty"`
+	PrimaryKey   string          `json:"primaryKey,omitempty"`
+	SecondaryKey string          `json:"secondaryKey,omitempty"`
+	StatusCode   string          `json:"apiKeyStatusCode"`
+	CreatedAt    *timestamp.Time `json:"createdAt,omitempty"`
+	UpdatedAt    *timestamp.Time `json:"updatedAt,omitempty"`
 }
 
 // ListAPIKeysOutput represents the response for API keys list
@@ -219,14 +221,14 @@ type RegenerateAPIKeyInput struct {
 
 // UsagePlan represents a usage plan
 type UsagePlan struct {
-	ID                        string     `json:"usagePlanId"`
-	Name                      string     `json:"usagePlanName"`
-	Description               string     `json:"usagePlanDescription,omitempty"`
-	RateLimitRequestPerSecond int        `json:"rateLimitRequestPerSecond,omitempty"`
-	QuotaLimitRequestCount    int        `json:"quotaLimitRequestCount,omitempty"`
-	QuotaPeriodUnitCode       string     `json:"quotaPeriodUnitCode,omitempty"` // DAY, MONTH
-	CreatedAt                 *time.Time `json:"createdAt,omitempty"`
-	UpdatedAt                 *time.Time `json:"updatedAt,omitempty"`
+	ID                        string          `json:"usagePlanId"`
+	Name                      string          `json:"usagePlanName"`
+	Description               string          `json:"usagePlanDescription,omitempty"`
+	RateLimitRequestPerSecond int             `json:"rateLimitRequestPerSecond,omitempty"`
+	QuotaLimitRequestCount    int             `json:"quotaLimitRequestCount,omitempty"`
+	QuotaPeriodUnitCode       string          `json:"quotaPeriodUnitCode,omitempty"` // DAY, MONTH
+	CreatedAt                 *timestamp.Time `json:"createdAt,omitempty"`
+	UpdatedAt                 *timestamp.Time `json:"updatedAt,omitempty"`
 }
 
 // ListUsagePlansOutput represents the response for usage plans list
@@ -276,14 +278,14 @@ type ListUsagePlanStagesOutput struct {
 
 // Subscription represents a subscription
 type Subscription struct {
-	ID          string     `json:"subscriptionId"`
-	UsagePlanID string     `json:"usagePlanId"`
-	StageID     string     `json:"stageId"`
-	APIKeyID    string     `json:"apiKeyId"`
-	APIKeyName  string     `json:"apiKeyName,omitempty"`
-	StatusCode  string     `json:"subscriptionStatusCode"`
-	CreatedAt   *time.Time `json:"createdAt,omitempty"`
-	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+	ID          string          `json:"subscriptionId"`
+	UsagePlanID string          `json:"usagePlanId"`
+	StageID     string          `json:"stageId"`
+	APIKeyID    string          `json:"apiKeyId"`
+	APIKeyName  string          `json:"apiKeyName,omitempty"`
+	StatusCode  string          `json:"subscriptionStatusCode"`
+	CreatedAt   *timestamp.Time `json:"createdAt,omitempty"`
+	UpdatedAt   *timestamp.Time `json:"updatedAt,omitempty"`
 }
 
 // ListSubscriptionsOutput represents the response for subscriptions list
@@ -307,13 +309,13 @@ type CreateSubscriptionInput struct {
 
 // Model represents an API model
 type Model struct {
-	ID          string     `json:"modelId"`
-	ServiceID   string     `json:"apigwServiceId"`
-	Name        string     `json:"modelName"`
-	Description string     `json:"modelDescription,omitempty"`
-	Schema      string     `json:"modelSchema"`
-	CreatedAt   *time.Time `json:"createdAt,omitempty"`
-	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+	ID          string          `json:"modelId"`
+	ServiceID   string          `json:"apigwServiceId"`
+	Name        string          `json:"modelName"`
+	Description string          `json:"modelDescription,omitempty"`
+	Schema      string          `json:"modelSchema"`
+	CreatedAt   *timestamp.Time `json:"createdAt,omitempty"`
+	UpdatedAt   *timestamp.Time `json:"updatedAt,omitempty"`
 }
 
 // ListModelsOutput represents the response for models list
@@ -346,11 +348,11 @@ type UpdateModelInput struct {
 
 // MetricPoint represents a single metric data point
 type MetricPoint struct {
-	Timestamp    string  `json:"timestamp"`
-	RequestCount int64   `json:"requestCount"`
-	SuccessCount int64   `json:"successCount"`
-	FailCount    int64   `json:"failCount"`
-	AvgResponse  float64 `json:"avgResponseTimeMs"`
+	Timestamp    timestamp.Time `json:"timestamp"`
+	RequestCount int64          `json:"requestCount"`
+	SuccessCount int64          `json:"successCount"`
+	FailCount    int64          `json:"failCount"`
+	AvgResponse  float64        `json:"avgResponseTimeMs"`
 }
 
 // StageMetrics represents stage metrics
@@ -371,9 +373,9 @@ type GetStageMetricsOutput struct {
 
 // MetricsInput represents query options for metrics
 type MetricsInput struct {
-	StartTime string `json:"startTime,omitempty"`
-	EndTime   string `json:"endTime,omitempty"`
-	TimeUnit  string `json:"timeUnit,omitempty"` // MINUTE, HOUR, DAY
+	StartTime timestamp.Time `json:"startTime,omitempty"`
+	EndTime   timestamp.Time `json:"endTime,omitempty"`
+	TimeUnit  string         `json:"timeUnit,omitempty"` // MINUTE, HOUR, DAY
 }
 
 // --- Gateway Response Types ---
@@ -386,8 +388,8 @@ type GatewayResponse struct {
 	StatusCode   int               `json:"statusCode"`
 	Headers      map[string]string `json:"headers,omitempty"`
 	Body         string            `json:"body,omitempty"`
-	CreatedAt    *time.Time        `json:"createdAt,omitempty"`
-	UpdatedAt    *time.Time        `json:"updatedAt,omitempty"`
+	CreatedAt    *timestamp.Time   `json:"createdAt,omitempty"`
+	UpdatedAt    *timestamp.Time   `json:"updatedAt,omitempty"`
 }
 
 // ListGatewayResponsesOutput represents the response for gateway responses list
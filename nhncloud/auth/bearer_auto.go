@@ -13,6 +13,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 // TokenCache holds cached token information
@@ -125,7 +127,7 @@ func (a *BearerAuthWithAutoRefresh) issueNewToken() (string, error) {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, string(body))
+		return "", fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, secret.RedactJSON(body))
 	}
 
 	var tokenResp TokenResponse
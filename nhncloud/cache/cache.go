@@ -0,0 +1,264 @@
+// Package cache provides an opt-in TTL cache for read-mostly catalog
+// lookups such as flavors, DB versions, storage types, images and supported
+// Kubernetes versions.
+//
+// Only a fixed set of catalog operations consult the cache; everything else
+// always goes to the API. Entries are stored as raw response bodies, so every
+// hit is decoded into a fresh value and callers can freely modify what they
+// get back. Any successful mutating request (POST, PUT, PATCH, DELETE) made
+// through a client of the same service drops every entry of that service,
+// and a write to the image service also drops compute's ListImages. A
+// response that was in flight when its entries were dropped is not stored.
+// Other writes that change a catalog, such as a flavor change made outside
+// the SDK, are bounded only by the TTL.
+//
+//	cfg.Cache = cache.New(cache.Config{
+//	    DefaultTTL: 10 * time.Minute,
+//	    TTLs: map[string]time.Duration{
+//	        "ListImages":            time.Hour,
+//	        "rds-mysql/ListFlavors": 30 * time.Minute,
+//	    },
+//	    MaxEntries: 256,
+//	})
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Catalog operations that consult the cache.
+const (
+	OpListFlavors          = "ListFlavors"
+	OpListVersions         = "ListVersions"
+	OpListStorageTypes     = "ListStorageTypes"
+	OpListImages           = "ListImages"
+	OpGetSupportedVersions = "GetSupportedVersions"
+)
+
+// Config configures a Cache.
+type Config struct {
+	// DefaultTTL applies to every catalog operation without an entry in TTLs.
+	// Default 5m.
+	DefaultTTL time.Duration
+	// TTLs overrides the TTL per operation. Keys are either an operation name
+	// ("ListFlavors") or a service-qualified one ("rds-mysql/ListFlavors");
+	// the qualified key wins. A negative TTL disables caching for that
+	// operation.
+	TTLs map[string]time.Duration
+	// MaxEntries caps the number of cached responses; the least recently
+	// used entry is evicted first. Default 1024.
+	MaxEntries int
+}
+
+const (
+	defaultTTL        = 5 * time.Minute
+	defaultMaxEntries = 1024
+)
+
+type entryKey struct {
+	service   string
+	operation string
+	key       string
+}
+
+type entry struct {
+	key       entryKey
+	body      []byte
+	expiresAt time.Time
+}
+
+// Cache is a size-capped TTL cache of catalog responses. It is safe for
+// concurrent use and is meant to be shared by every client built from the
+// same nhncloud.Config.
+type Cache struct {
+	cfg Config
+	now func() time.Time
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[entryKey]*list.Element
+
+	// gen counts invalidations. gens records the last one that touched
+	// each service and purged the last Purge.
+	gen    uint64
+	gens   map[string]uint64
+	purged uint64
+}
+
+// dependents lists, per service, the catalog operations of other services
+// that its writes change. Images are managed through the image service but
+// listed by compute.
+var dependents = map[string][]struct{ service, operation string }{
+	"image": {{"compute", OpListImages}},
+}
+
+// New creates a Cache with the given configuration.
+func New(cfg Config) *Cache {
+	if cfg.DefaultTTL == 0 {
+		cfg.DefaultTTL = defaultTTL
+	}
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = defaultMaxEntries
+	}
+	return &Cache{
+		cfg:     cfg,
+		now:     time.Now,
+		lru:     list.New(),
+		entries: make(map[entryKey]*list.Element),
+		gens:    make(map[string]uint64),
+	}
+}
+
+// Config returns the Cache's configuration, with defaults filled in.
+func (c *Cache) Config() Config { return c.cfg }
+
+// TTL returns the TTL for an operation of a service. A zero or negative
+// result means the operation is not cached.
+func (c *Cache) TTL(service, operation string) time.Duration {
+	if ttl, ok := c.cfg.TTLs[service+"/"+operation]; ok {
+		return ttl
+	}
+	if ttl, ok := c.cfg.TTLs[operation]; ok {
+		return ttl
+	}
+	return c.cfg.DefaultTTL
+}
+
+// Key returns the entry key of a catalog request: its full URL, which names
+// the region and API version, and identity, which names the project and
+// credentials it was made for, such as the app key or tenant ID. Clients of
+// different regions and projects share one Cache, and catalogs such as
+// images differ between them.
+func Key(identity, url string) string {
+	return identity + " " + url
+}
+
+// Get returns the cached response body for key, if present and not expired.
+// Build key with Key.
+func (c *Cache) Get(service, operation, key string) ([]byte, bool) {
+	k := entryKey{service, operation, key}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[k]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*entry)
+	if !c.now().Before(e.expiresAt) {
+		c.removeLocked(el)
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	return e.body, true
+}
+
+// Set stores a response body under key using the operation's TTL.
+func (c *Cache) Set(service, operation, key string, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setLocked(service, operation, key, body)
+}
+
+// Generation returns the invalidation generation of a service. It changes
+// whenever entries of the service are dropped.
+func (c *Cache) Generation(service string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generationLocked(service)
+}
+
+// SetIfCurrent is Set, unless the service has been invalidated since
+// Generation returned gen. Clients read the generation before requesting
+// a catalog, so a response that raced a mutation is not cached.
+func (c *Cache) SetIfCurrent(service, operation, key string, body []byte, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generationLocked(service) == gen {
+		c.setLocked(service, operation, key, body)
+	}
+}
+
+func (c *Cache) generationLocked(service string) uint64 {
+	if g := c.gens[service]; g > c.purged {
+		return g
+	}
+	return c.purged
+}
+
+func (c *Cache) setLocked(service, operation, key string, body []byte) {
+	ttl := c.TTL(service, operation)
+	if ttl <= 0 {
+		return
+	}
+	k := entryKey{service, operation, key}
+
+	if el, ok := c.entries[k]; ok {
+		e := el.Value.(*entry)
+		e.body = body
+		e.expiresAt = c.now().Add(ttl)
+		c.lru.MoveToFront(el)
+		return
+	}
+
+	c.entries[k] = c.lru.PushFront(&entry{key: k, body: body, expiresAt: c.now().Add(ttl)})
+	for c.lru.Len() > c.cfg.MaxEntries {
+		c.removeLocked(c.lru.Back())
+	}
+}
+
+// Invalidate drops every entry of one operation of a service.
+func (c *Cache) Invalidate(service, operation string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidateLocked(service, operation)
+}
+
+// InvalidateService drops every entry of a service and the entries of other
+// services its writes change. Clients call it after each successful
+// mutating request.
+func (c *Cache) InvalidateService(service string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidateLocked(service, "")
+	for _, d := range dependents[service] {
+		c.invalidateLocked(d.service, d.operation)
+	}
+}
+
+// Purge drops every entry.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lru.Init()
+	c.entries = make(map[entryKey]*list.Element)
+	c.gen++
+	c.purged = c.gen
+}
+
+// invalidateLocked drops the entries of one operation of a service, or of
+// every operation if operation is empty, and advances its generation.
+func (c *Cache) invalidateLocked(service, operation string) {
+	c.gen++
+	c.gens[service] = c.gen
+	for k, el := range c.entries {
+		if k.service == service && (operation == "" || k.operation == operation) {
+			c.removeLocked(el)
+		}
+	}
+}
+
+// Len returns the number of entries, including expired ones not yet evicted.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+func (c *Cache) removeLocked(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*entry).key)
+}
@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCacheTTL(t *testing.T) {
+	c := New(Config{
+		DefaultTTL: time.Minute,
+		TTLs: map[string]time.Duration{
+			OpListImages:                     time.Hour,
+			"rds-mysql/" + OpListFlavors:     10 * time.Second,
+			"rds-mariadb/" + OpListVersions:  -1,
+			"rds-postgresql/" + OpListImages: 0,
+		},
+	})
+
+	tests := []struct {
+		service, op string
+		want        time.Duration
+	}{
+		{"compute", OpListImages, time.Hour},
+		{"rds-mysql", OpListFlavors, 10 * time.Second},
+		{"rds-mariadb", OpListFlavors, time.Minute},
+		{"rds-mariadb", OpListVersions, -1},
+		{"rds-postgresql", OpListImages, 0},
+	}
+	for _, tt := range tests {
+		if got := c.TTL(tt.service, tt.op); got != tt.want {
+			t.Errorf("TTL(%s, %s) = %v, want %v", tt.service, tt.op, got, tt.want)
+		}
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := New(Config{DefaultTTL: time.Minute})
+	c.now = func() time.Time { return now }
+
+	c.Set("rds-mysql", OpListFlavors, "/db-flavors", []byte(`{}`))
+	if _, ok := c.Get("rds-mysql", OpListFlavors, "/db-flavors"); !ok {
+		t.Fatal("expected hit before expiry")
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := c.Get("rds-mysql", OpListFlavors, "/db-flavors"); ok {
+		t.Error("expected miss after expiry")
+	}
+	if c.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", c.Len())
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := New(Config{MaxEntries: 2})
+
+	c.Set("compute", OpListImages, "a", []byte("a"))
+	c.Set("compute", OpListImages, "b", []byte("b"))
+	c.Get("compute", OpListImages, "a")
+	c.Set("compute", OpListImages, "c", []byte("c"))
+
+	if _, ok := c.Get("compute", OpListImages, "b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	for _, k := range []string{"a", "c"} {
+		if _, ok := c.Get("compute", OpListImages, k); !ok {
+			t.Errorf("expected %s to be cached", k)
+		}
+	}
+}
+
+func TestCacheInvalidateService(t *testing.T) {
+	c := New(Config{})
+
+	c.Set("rds-mysql", OpListFlavors, "/db-flavors", []byte("1"))
+	c.Set("rds-mysql", OpListVersions, "/db-versions", []byte("2"))
+	c.Set("rds-mariadb", OpListFlavors, "/db-flavors", []byte("3"))
+
+	c.Invalidate("rds-mysql", OpListVersions)
+	if _, ok := c.Get("rds-mysql", OpListVersions, "/db-versions"); ok {
+		t.Error("expected ListVersions to be invalidated")
+	}
+
+	c.InvalidateService("rds-mysql")
+	if _, ok := c.Get("rds-mysql", OpListFlavors, "/db-flavors"); ok {
+		t.Error("expected rds-mysql entries to be invalidated")
+	}
+	if _, ok := c.Get("rds-mariadb", OpListFlavors, "/db-flavors"); !ok {
+		t.Error("expected rds-mariadb entries to survive")
+	}
+}
+
+func TestCacheInvalidateDependents(t *testing.T) {
+	c := New(Config{})
+
+	c.Set("compute", OpListImages, "/images", []byte("1"))
+	c.Set("compute", OpListFlavors, "/flavors/detail", []byte("2"))
+
+	c.InvalidateService("image")
+	if _, ok := c.Get("compute", OpListImages, "/images"); ok {
+		t.Error("expected an image service write to invalidate compute's ListImages")
+	}
+	if _, ok := c.Get("compute", OpListFlavors, "/flavors/detail"); !ok {
+		t.Error("expected compute's ListFlavors to survive")
+	}
+}
+
+func TestCacheSetIfCurrent(t *testing.T) {
+	c := New(Config{})
+
+	gen := c.Generation("compute")
+	c.SetIfCurrent("compute", OpListFlavors, "/flavors/detail", []byte("1"), gen)
+	if _, ok := c.Get("compute", OpListFlavors, "/flavors/detail"); !ok {
+		t.Fatal("expected a response with the current generation to be stored")
+	}
+
+	for name, invalidate := range map[string]func(){
+		"InvalidateService": func() { c.InvalidateService("compute") },
+		"dependent":         func() { c.InvalidateService("image") },
+		"Purge":             c.Purge,
+	} {
+		gen := c.Generation("compute")
+		invalidate()
+		c.SetIfCurrent("compute", OpListImages, "/images", []byte("stale"), gen)
+		if _, ok := c.Get("compute", OpListImages, "/images"); ok {
+			t.Errorf("%s: a response that raced the invalidation was stored", name)
+		}
+	}
+
+	// Other services' invalidations do not discard compute's responses.
+	gen = c.Generation("compute")
+	c.InvalidateService("rds-mysql")
+	c.SetIfCurrent("compute", OpListImages, "/images", []byte("1"), gen)
+	if _, ok := c.Get("compute", OpListImages, "/images"); !ok {
+		t.Error("expected an unrelated invalidation to leave the generation alone")
+	}
+}
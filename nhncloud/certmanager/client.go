@@ -8,6 +8,12 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 const DefaultBaseURL = "https://certmanager.api.nhncloudservice.com"
@@ -20,23 +26,46 @@ type Client struct {
 	secretKey   string
 	httpClient  *http.Client
 	debug       bool
+	drift       *drift.Detector
 }
 
-// NewClient creates a new Certificate Manager client
-func NewClient(appKey, accessKeyID, secretKey string, httpClient *http.Client, debug bool) *Client {
-	if httpClient == nil {
-		httpClient = &http.Client{Timeout: 30 * time.Second}
+// New creates a Certificate Manager client that authenticates with the User
+// Access Key in creds. creds may be nil for operations that only need the
+// app key. A nil httpClient uses a client with a 30s timeout.
+func New(appKey string, creds credentials.Credentials, httpClient *http.Client, debug bool, opts ...option.Option) *Client {
+	settings := option.Apply(opts...)
+	if settings.HTTPClient == nil {
+		settings.HTTPClient = httpClient
 	}
+	accessKeyID, secretKey := credentials.UserAccessKey(creds)
 	return &Client{
 		baseURL:     DefaultBaseURL,
 		appKey:      appKey,
 		accessKeyID: accessKeyID,
 		secretKey:   secretKey,
-		httpClient:  httpClient,
+		httpClient:  client.HTTPClient(settings, &http.Client{Timeout: 30 * time.Second}),
+		drift:       client.Drift(settings),
 		debug:       debug,
 	}
 }
 
+// NewClient creates a new Certificate Manager client from a User Access Key.
+//
+// Deprecated: Use New, which takes the key as credentials and accepts the
+// shared options.
+func NewClient(appKey, accessKeyID, secretKey string, httpClient *http.Client, debug bool) *Client {
+	return New(appKey, credentials.NewStatic(accessKeyID, secretKey), httpClient, debug)
+}
+
+// decode unmarshals a response body into v, checking it for unmapped
+// fields first when drift detection is enabled.
+func (c *Client) decode(body []byte, v interface{}) error {
+	if err := c.drift.Check("certmanager", "", body, v); err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
 // buildPath constructs the full API path
 func (c *Client) buildPath(path string) string {
 	return fmt.Sprintf("/certmanager/v1.0/appkeys/%s%s", c.appKey, path)
@@ -75,11 +104,11 @@ func (c *Client) doRequest(ctx context.Context, method, path string) ([]byte, er
 
 	if c.debug {
 		fmt.Printf("[DEBUG] Response status: %d\n", resp.StatusCode)
-		fmt.Printf("[DEBUG] Response body: %s\n", string(respBody))
+		fmt.Printf("[DEBUG] Response body: %s\n", secret.RedactJSON(respBody))
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, secret.RedactJSON(respBody))
 	}
 
 	return respBody, nil
@@ -93,7 +122,7 @@ func (c *Client) ListCertificates(ctx context.Context) (*ListCertificatesOutput,
 	}
 
 	var result ListCertificatesOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -109,7 +138,7 @@ func (c *Client) DownloadCertificateFiles(ctx context.Context, certificateName s
 	}
 
 	var result DownloadCertificateFilesOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -1,7 +1,10 @@
 // Package certmanager provides Certificate Manager service types and client
 package certmanager
 
-import "time"
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
 
 // Header represents the response header
 type Header struct {
@@ -12,20 +15,20 @@ type Header struct {
 
 // Certificate represents a certificate
 type Certificate struct {
-	CertificateName         string    `json:"certificateName"`
-	CertificateType         string    `json:"certificateType"` // SINGLE_DOMAIN, WILDCARD, MULTI_DOMAIN
-	Status                  string    `json:"status"`          // ACTIVE, EXPIRED, REVOKED
-	DomainName              string    `json:"domainName"`
-	SubjectAlternativeNames []string  `json:"subjectAlternativeNames,omitempty"`
-	Issuer                  string    `json:"issuer"`
-	SerialNumber            string    `json:"serialNumber"`
-	NotBefore               time.Time `json:"notBefore"`
-	NotAfter                time.Time `json:"notAfter"`
-	CreatedAt               time.Time `json:"createdAt"`
-	UpdatedAt               time.Time `json:"updatedAt"`
-	KeyAlgorithm            string    `json:"keyAlgorithm,omitempty"` // RSA, ECDSA
-	KeySize                 int       `json:"keySize,omitempty"`
-	SignatureAlgorithm      string    `json:"signatureAlgorithm,omitempty"`
+	CertificateName         string         `json:"certificateName"`
+	CertificateType         string         `json:"certificateType"` // SINGLE_DOMAIN, WILDCARD, MULTI_DOMAIN
+	Status                  string         `json:"status"`          // ACTIVE, EXPIRED, REVOKED
+	DomainName              string         `json:"domainName"`
+	SubjectAlternativeNames []string       `json:"subjectAlternativeNames,omitempty"`
+	Issuer                  string         `json:"issuer"`
+	SerialNumber            string         `json:"serialNumber"`
+	NotBefore               timestamp.Time `json:"notBefore"`
+	NotAfter                timestamp.Time `json:"notAfter"`
+	CreatedAt               timestamp.Time `json:"createdAt"`
+	UpdatedAt               timestamp.Time `json:"updatedAt"`
+	KeyAlgorithm            string         `json:"keyAlgorithm,omitempty"` // RSA, ECDSA
+	KeySize                 int            `json:"keySize,omitempty"`
+	SignatureAlgorithm      string         `json:"signatureAlgorithm,omitempty"`
 }
 
 // ListCertificatesOutput represents the response from listing certificates
@@ -39,9 +42,9 @@ type ListCertificatesOutput struct {
 
 // CertificateFiles represents certificate file data
 type CertificateFiles struct {
-	Certificate      string `json:"certificate"`      // PEM format certificate
-	PrivateKey       string `json:"privateKey"`       // PEM format private key (if available)
-	CertificateChain string `json:"certificateChain"` // PEM format certificate chain
+	Certificate      string        `json:"certificate"`      // PEM format certificate
+	PrivateKey       secret.String `json:"privateKey"`       // PEM format private key (if available)
+	CertificateChain string        `json:"certificateChain"` // PEM format certificate chain
 }
 
 // DownloadCertificateFilesOutput represents the response from downloading certificate files
@@ -0,0 +1,278 @@
+// Package circuitbreaker provides a per-endpoint circuit breaker for the
+// SDK's shared HTTP transport.
+//
+// A Breaker tracks every endpoint host independently. After
+// FailureThreshold consecutive failures the circuit for that host opens and
+// requests are rejected immediately with *errors.CircuitOpenError instead of
+// being sent (and retried). Once OpenTimeout has elapsed the circuit moves to
+// half-open and lets a limited number of probe requests through; enough
+// successes close it again, a single failure reopens it.
+//
+// A single Breaker is safe for concurrent use and is meant to be shared by
+// every client built from the same nhncloud.Config:
+//
+//	cfg.CircuitBreaker = circuitbreaker.New(circuitbreaker.Config{
+//	    FailureThreshold: 5,
+//	    OpenTimeout:      30 * time.Second,
+//	    OnStateChange: func(ev circuitbreaker.StateChange) {
+//	        metrics.Gauge("nhncloud_circuit_state", ev.Host).Set(float64(ev.To))
+//	    },
+//	})
+package circuitbreaker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+)
+
+// State is the state of the circuit for a single endpoint host.
+type State int
+
+const (
+	// StateClosed lets every request through and counts failures.
+	StateClosed State = iota
+	// StateOpen rejects every request until OpenTimeout has elapsed.
+	StateOpen
+	// StateHalfOpen lets a limited number of probe requests through.
+	StateHalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// StateChange describes a transition of the circuit for one host.
+type StateChange struct {
+	Host string
+	From State
+	To   State
+	At   time.Time
+}
+
+// Config configures a Breaker. Zero values are replaced with the defaults
+// documented on each field.
+type Config struct {
+	// FailureThreshold is the number of consecutive failures that opens the
+	// circuit. Default 5.
+	FailureThreshold int
+	// OpenTimeout is how long the circuit stays open before allowing probe
+	// requests. Default 30s.
+	OpenTimeout time.Duration
+	// HalfOpenMaxRequests is the number of concurrent probe requests allowed
+	// while half-open. Default 1.
+	HalfOpenMaxRequests int
+	// SuccessThreshold is the number of consecutive successful probes that
+	// closes the circuit again. Default 1.
+	SuccessThreshold int
+
+	// OnStateChange, if set, is called synchronously on every transition.
+	// Use it to feed metrics; it must not block.
+	OnStateChange func(StateChange)
+	// Logger, if set, receives one record per transition.
+	Logger *slog.Logger
+}
+
+const (
+	defaultFailureThreshold    = 5
+	defaultOpenTimeout         = 30 * time.Second
+	defaultHalfOpenMaxRequests = 1
+	defaultSuccessThreshold    = 1
+)
+
+type hostState struct {
+	state     State
+	failures  int
+	successes int
+	inFlight  int
+	openedAt  time.Time
+}
+
+// Breaker is a set of circuits keyed by endpoint host.
+type Breaker struct {
+	cfg Config
+	now func() time.Time
+
+	mu    sync.Mutex
+	hosts map[string]*hostState
+}
+
+// New creates a Breaker with the given configuration.
+func New(cfg Config) *Breaker {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = defaultFailureThreshold
+	}
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = defaultOpenTimeout
+	}
+	if cfg.HalfOpenMaxRequests <= 0 {
+		cfg.HalfOpenMaxRequests = defaultHalfOpenMaxRequests
+	}
+	if cfg.SuccessThreshold <= 0 {
+		cfg.SuccessThreshold = defaultSuccessThreshold
+	}
+	return &Breaker{
+		cfg:   cfg,
+		now:   time.Now,
+		hosts: make(map[string]*hostState),
+	}
+}
+
+// Allow reports whether a request to host may be sent. It returns a
+// *errors.CircuitOpenError when the circuit is open or the half-open probe
+// budget is exhausted. Every nil return must be paired with a call to Record
+// or Release.
+func (b *Breaker) Allow(host string) error {
+	var changes []StateChange
+
+	b.mu.Lock()
+	hs := b.hostLocked(host)
+	now := b.now()
+
+	if hs.state == StateOpen {
+		if elapsed := now.Sub(hs.openedAt); elapsed < b.cfg.OpenTimeout {
+			b.mu.Unlock()
+			return &errors.CircuitOpenError{Host: host, RetryAfter: b.cfg.OpenTimeout - elapsed}
+		}
+		changes = append(changes, b.setStateLocked(host, hs, StateHalfOpen, now))
+	}
+
+	if hs.state == StateHalfOpen {
+		if hs.inFlight >= b.cfg.HalfOpenMaxRequests {
+			b.mu.Unlock()
+			b.notify(changes)
+			return &errors.CircuitOpenError{Host: host}
+		}
+	}
+	hs.inFlight++
+	b.mu.Unlock()
+
+	b.notify(changes)
+	return nil
+}
+
+// Record reports the outcome of a request previously admitted by Allow.
+// A failure is an outcome that says the endpoint itself is unhealthy
+// (network errors, timeouts, 5xx, 429); client errors such as 400 or 404
+// should be recorded as successes.
+func (b *Breaker) Record(host string, success bool) {
+	var changes []StateChange
+
+	b.mu.Lock()
+	hs := b.hostLocked(host)
+	now := b.now()
+	if hs.inFlight > 0 {
+		hs.inFlight--
+	}
+
+	switch hs.state {
+	case StateClosed:
+		if success {
+			hs.failures = 0
+		} else {
+			hs.failures++
+			if hs.failures >= b.cfg.FailureThreshold {
+				changes = append(changes, b.setStateLocked(host, hs, StateOpen, now))
+			}
+		}
+	case StateHalfOpen:
+		if success {
+			hs.successes++
+			if hs.successes >= b.cfg.SuccessThreshold {
+				changes = append(changes, b.setStateLocked(host, hs, StateClosed, now))
+			}
+		} else {
+			changes = append(changes, b.setStateLocked(host, hs, StateOpen, now))
+		}
+	case StateOpen:
+		// A request admitted before the circuit opened finished late;
+		// its outcome does not change anything.
+	}
+	b.mu.Unlock()
+
+	b.notify(changes)
+}
+
+// Release abandons a request admitted by Allow without recording an
+// outcome, e.g. because the caller's context was cancelled.
+func (b *Breaker) Release(host string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if hs, ok := b.hosts[host]; ok && hs.inFlight > 0 {
+		hs.inFlight--
+	}
+}
+
+// Config returns the Breaker's configuration, with defaults filled in.
+func (b *Breaker) Config() Config { return b.cfg }
+
+// State returns the current state of the circuit for host.
+func (b *Breaker) State(host string) State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if hs, ok := b.hosts[host]; ok {
+		return hs.state
+	}
+	return StateClosed
+}
+
+// Reset forces the circuit for host back to closed.
+func (b *Breaker) Reset(host string) {
+	b.mu.Lock()
+	hs, ok := b.hosts[host]
+	if !ok || hs.state == StateClosed {
+		b.mu.Unlock()
+		return
+	}
+	change := b.setStateLocked(host, hs, StateClosed, b.now())
+	b.mu.Unlock()
+
+	b.notify([]StateChange{change})
+}
+
+func (b *Breaker) hostLocked(host string) *hostState {
+	hs, ok := b.hosts[host]
+	if !ok {
+		hs = &hostState{}
+		b.hosts[host] = hs
+	}
+	return hs
+}
+
+func (b *Breaker) setStateLocked(host string, hs *hostState, to State, now time.Time) StateChange {
+	change := StateChange{Host: host, From: hs.state, To: to, At: now}
+	hs.state = to
+	hs.failures = 0
+	hs.successes = 0
+	if to == StateOpen {
+		hs.openedAt = now
+	}
+	return change
+}
+
+func (b *Breaker) notify(changes []StateChange) {
+	for _, ch := range changes {
+		if b.cfg.Logger != nil {
+			b.cfg.Logger.LogAttrs(context.Background(), slog.LevelWarn, "nhncloud: circuit state changed",
+				slog.String("host", ch.Host),
+				slog.String("from", ch.From.String()),
+				slog.String("to", ch.To.String()),
+			)
+		}
+		if b.cfg.OnStateChange != nil {
+			b.cfg.OnStateChange(ch)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package circuitbreaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+)
+
+func newTestBreaker(cfg Config) (*Breaker, *time.Time) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := New(cfg)
+	b.now = func() time.Time { return now }
+	return b, &now
+}
+
+func TestBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	var changes []StateChange
+	b, _ := newTestBreaker(Config{
+		FailureThreshold: 3,
+		OnStateChange:    func(ch StateChange) { changes = append(changes, ch) },
+	})
+
+	for i := 0; i < 3; i++ {
+		if err := b.Allow("kr1-rds-mysql"); err != nil {
+			t.Fatalf("attempt %d: unexpected error: %v", i, err)
+		}
+		b.Record("kr1-rds-mysql", false)
+	}
+
+	if got := b.State("kr1-rds-mysql"); got != StateOpen {
+		t.Fatalf("State() = %v, want open", got)
+	}
+	err := b.Allow("kr1-rds-mysql")
+	if !errors.IsCircuitOpen(err) {
+		t.Fatalf("Allow() = %v, want CircuitOpenError", err)
+	}
+	if err := b.Allow("kr1-rds-mariadb"); err != nil {
+		t.Errorf("other host should be unaffected, got %v", err)
+	}
+	if len(changes) != 1 || changes[0].From != StateClosed || changes[0].To != StateOpen {
+		t.Errorf("unexpected state changes: %+v", changes)
+	}
+}
+
+func TestBreakerSuccessResetsFailureCount(t *testing.T) {
+	b, _ := newTestBreaker(Config{FailureThreshold: 2})
+
+	b.Allow("h")
+	b.Record("h", false)
+	b.Allow("h")
+	b.Record("h", true)
+	b.Allow("h")
+	b.Record("h", false)
+
+	if got := b.State("h"); got != StateClosed {
+		t.Errorf("State() = %v, want closed", got)
+	}
+}
+
+func TestBreakerHalfOpenProbe(t *testing.T) {
+	b, now := newTestBreaker(Config{FailureThreshold: 1, OpenTimeout: 10 * time.Second})
+
+	b.Allow("h")
+	b.Record("h", false)
+
+	*now = now.Add(11 * time.Second)
+	if err := b.Allow("h"); err != nil {
+		t.Fatalf("probe should be admitted, got %v", err)
+	}
+	if got := b.State("h"); got != StateHalfOpen {
+		t.Fatalf("State() = %v, want half-open", got)
+	}
+	if err := b.Allow("h"); !errors.IsCircuitOpen(err) {
+		t.Errorf("second concurrent probe should be rejected, got %v", err)
+	}
+
+	b.Record("h", true)
+	if got := b.State("h"); got != StateClosed {
+		t.Errorf("State() = %v, want closed after successful probe", got)
+	}
+}
+
+func TestBreakerHalfOpenFailureReopens(t *testing.T) {
+	b, now := newTestBreaker(Config{FailureThreshold: 1, OpenTimeout: time.Second})
+
+	b.Allow("h")
+	b.Record("h", false)
+	*now = now.Add(2 * time.Second)
+	b.Allow("h")
+	b.Record("h", false)
+
+	if got := b.State("h"); got != StateOpen {
+		t.Errorf("State() = %v, want open", got)
+	}
+}
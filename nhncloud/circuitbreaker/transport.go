@@ -0,0 +1,39 @@
+package circuitbreaker
+
+import (
+	"net/http"
+)
+
+// Transport returns a RoundTripper that guards every request sent through
+// next with b, keyed by the request host. It is for clients that send
+// requests with their own http.Client rather than the SDK's transport.
+// A nil next uses http.DefaultTransport.
+func (b *Breaker) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &roundTripper{breaker: b, next: next}
+}
+
+type roundTripper struct {
+	breaker *Breaker
+	next    http.RoundTripper
+}
+
+func (t *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+	if err := t.breaker.Allow(host); err != nil {
+		return nil, err
+	}
+	resp, err := t.next.RoundTrip(req)
+	switch {
+	case req.Context().Err() != nil:
+		// The caller gave up; that says nothing about the endpoint.
+		t.breaker.Release(host)
+	case err != nil:
+		t.breaker.Record(host, false)
+	default:
+		t.breaker.Record(host, resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests)
+	}
+	return resp, err
+}
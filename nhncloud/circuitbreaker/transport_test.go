@@ -0,0 +1,48 @@
+package circuitbreaker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+)
+
+func TestTransport(t *testing.T) {
+	status, requests := http.StatusNotFound, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	b := New(Config{FailureThreshold: 2})
+	hc := &http.Client{Transport: b.Transport(nil)}
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	get := func() error {
+		resp, err := hc.Get(server.URL)
+		if err == nil {
+			resp.Body.Close()
+		}
+		return err
+	}
+
+	for i := 0; i < 3; i++ {
+		get()
+	}
+	if got := b.State(host); got != StateClosed {
+		t.Fatalf("after 404s the circuit is %v, want closed", got)
+	}
+
+	status = http.StatusBadGateway
+	get()
+	get()
+	if err := get(); !errors.IsCircuitOpen(err) {
+		t.Errorf("expected CircuitOpenError, got %v", err)
+	}
+	if requests != 5 {
+		t.Errorf("the open circuit let %d requests through", requests-5)
+	}
+}
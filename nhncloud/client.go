@@ -3,20 +3,39 @@ package nhncloud
 import (
 	"sync"
 
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/apigw"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/certmanager"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cloudtrail"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/colocationgw"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/compute"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/container/ncr"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/container/ncs"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/container/nks"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/dnsplus"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/iam"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/image"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/mirroring"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/floatingip"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/flowlog"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/internetgateway"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/loadbalancer"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/natgateway"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/networkacl"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/port"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/privatedns"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/securitygroup"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/servicegateway"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/transithub"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/vpc"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/mariadb"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/mysql"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/postgresql"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/resourcewatcher"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/s3credential"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/security/keymanager"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/storage/block"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/storage/nas"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/storage/object"
 )
 
@@ -39,6 +58,27 @@ type Client struct {
 	nksClient     *nks.Client
 	ncrClient     *ncr.Client
 	ncsClient     *ncs.Client
+
+	dnsplusClient     *dnsplus.Client
+	keymanagerClient  *keymanager.Client
+	certmanagerClient *certmanager.Client
+	cloudtrailClient  *cloudtrail.Client
+	rwClient          *resourcewatcher.Client
+	apigwClient       *apigw.Client
+	nasClient         *nas.Client
+	imageClient       *image.Client
+	s3credClient      *s3credential.Client
+	mirroringClient   *mirroring.Client
+	colocationClient  *colocationgw.Client
+	transitHubClient  *transithub.Client
+	naclClient        *networkacl.Client
+	privateDNSClient  *privatedns.Client
+	natClient         *natgateway.Client
+	igwClient         *internetgateway.Client
+	sgwClient         *servicegateway.Client
+	flowLogClient     *flowlog.Client
+
+	rawClients map[string]*RawClient
 }
 
 func New(cfg *Config) (*Client, error) {
@@ -55,7 +95,7 @@ func (c *Client) IAM() *iam.Client {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.iam == nil {
-		c.iam = iam.NewClient(c.config.Region, c.config.Credentials, c.config.httpClient(), c.config.Debug)
+		c.iam = iam.NewClient(c.config.Region, c.config.Credentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
 	}
 	return c.iam
 }
@@ -64,7 +104,7 @@ func (c *Client) Compute() *compute.Client {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.compute == nil {
-		c.compute = compute.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug)
+		c.compute = compute.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
 	}
 	return c.compute
 }
@@ -74,7 +114,10 @@ func (c *Client) MySQL() *mysql.Client {
 	defer c.mu.Unlock()
 	if c.mysqlClient == nil {
 		appKey := c.config.AppKeys["rds-mysql"]
-		c.mysqlClient = mysql.NewClient(c.config.Region, appKey, c.config.Credentials, c.config.Debug)
+		c.mysqlClient = mysql.NewClient(c.config.Region, appKey, c.config.Credentials, c.config.Debug, c.config.options()...)
+		if v, ok := c.config.APIVersions["rds-mysql"]; ok {
+			c.mysqlClient.SetAPIVersion(mysql.APIVersion(v))
+		}
 	}
 	return c.mysqlClient
 }
@@ -84,7 +127,10 @@ func (c *Client) MariaDB() *mariadb.Client {
 	defer c.mu.Unlock()
 	if c.mariadbClient == nil {
 		appKey := c.config.AppKeys["rds-mariadb"]
-		c.mariadbClient = mariadb.NewClient(c.config.Region, appKey, c.config.Credentials, c.config.Debug)
+		c.mariadbClient = mariadb.NewClient(c.config.Region, appKey, c.config.Credentials, c.config.Debug, c.config.options()...)
+		if v, ok := c.config.APIVersions["rds-mariadb"]; ok {
+			c.mariadbClient.SetAPIVersion(mariadb.APIVersion(v))
+		}
 	}
 	return c.mariadbClient
 }
@@ -94,7 +140,7 @@ func (c *Client) PostgreSQL() *postgresql.Client {
 	defer c.mu.Unlock()
 	if c.pgClient == nil {
 		appKey := c.config.AppKeys["rds-postgresql"]
-		c.pgClient = postgresql.NewClient(c.config.Region, appKey, c.config.Credentials, c.config.Debug)
+		c.pgClient = postgresql.NewClient(c.config.Region, appKey, c.config.Credentials, c.config.Debug, c.config.options()...)
 	}
 	return c.pgClient
 }
@@ -103,7 +149,7 @@ func (c *Client) VPC() *vpc.Client {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.vpcClient == nil {
-		c.vpcClient = vpc.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug)
+		c.vpcClient = vpc.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
 	}
 	return c.vpcClient
 }
@@ -112,7 +158,7 @@ func (c *Client) SecurityGroup() *securitygroup.Client {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.sgClient == nil {
-		c.sgClient = securitygroup.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug)
+		c.sgClient = securitygroup.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
 	}
 	return c.sgClient
 }
@@ -121,7 +167,7 @@ func (c *Client) FloatingIP() *floatingip.Client {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.fipClient == nil {
-		c.fipClient = floatingip.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug)
+		c.fipClient = floatingip.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
 	}
 	return c.fipClient
 }
@@ -130,7 +176,7 @@ func (c *Client) Port() *port.Client {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.portClient == nil {
-		c.portClient = port.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug)
+		c.portClient = port.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
 	}
 	return c.portClient
 }
@@ -139,7 +185,7 @@ func (c *Client) LoadBalancer() *loadbalancer.Client {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.lbClient == nil {
-		c.lbClient = loadbalancer.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug)
+		c.lbClient = loadbalancer.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
 	}
 	return c.lbClient
 }
@@ -148,7 +194,7 @@ func (c *Client) BlockStorage() *block.Client {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.blockClient == nil {
-		c.blockClient = block.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug)
+		c.blockClient = block.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
 	}
 	return c.blockClient
 }
@@ -157,7 +203,9 @@ func (c *Client) ObjectStorage() *object.Client {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.objectClient == nil {
-		c.objectClient = object.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug)
+		hc := c.config.transferHTTPClient()
+		opts := append(c.config.options(), option.WithHTTPClient(hc))
+		c.objectClient = object.NewClient(c.config.Region, c.config.IdentityCredentials, hc, c.config.Debug, opts...)
 	}
 	return c.objectClient
 }
@@ -166,7 +214,7 @@ func (c *Client) NKS() *nks.Client {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.nksClient == nil {
-		c.nksClient = nks.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug)
+		c.nksClient = nks.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
 	}
 	return c.nksClient
 }
@@ -176,7 +224,7 @@ func (c *Client) NCR() *ncr.Client {
 	defer c.mu.Unlock()
 	if c.ncrClient == nil {
 		appKey := c.config.AppKeys["ncr"]
-		c.ncrClient = ncr.NewClient(c.config.Region, appKey, c.config.Credentials, c.config.httpClient(), c.config.Debug)
+		c.ncrClient = ncr.NewClient(c.config.Region, appKey, c.config.Credentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
 	}
 	return c.ncrClient
 }
@@ -186,7 +234,175 @@ func (c *Client) NCS() *ncs.Client {
 	defer c.mu.Unlock()
 	if c.ncsClient == nil {
 		appKey := c.config.AppKeys["ncs"]
-		c.ncsClient = ncs.NewClient(c.config.Region, appKey, c.config.Credentials, c.config.httpClient(), c.config.Debug)
+		c.ncsClient = ncs.NewClient(c.config.Region, appKey, c.config.Credentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
 	}
 	return c.ncsClient
 }
+
+func (c *Client) DNSPlus() *dnsplus.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.dnsplusClient == nil {
+		appKey := c.config.AppKeys["dnsplus"]
+		c.dnsplusClient = dnsplus.NewClient(appKey, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.dnsplusClient
+}
+
+func (c *Client) KeyManager() *keymanager.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.keymanagerClient == nil {
+		appKey := c.config.AppKeys["keymanager"]
+		c.keymanagerClient = keymanager.New(appKey, c.config.Credentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.keymanagerClient
+}
+
+func (c *Client) CertManager() *certmanager.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.certmanagerClient == nil {
+		appKey := c.config.AppKeys["certmanager"]
+		c.certmanagerClient = certmanager.New(appKey, c.config.Credentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.certmanagerClient
+}
+
+func (c *Client) CloudTrail() *cloudtrail.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cloudtrailClient == nil {
+		appKey := c.config.AppKeys["cloudtrail"]
+		c.cloudtrailClient = cloudtrail.New(appKey, c.config.Credentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.cloudtrailClient
+}
+
+func (c *Client) ResourceWatcher() *resourcewatcher.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.rwClient == nil {
+		appKey := c.config.AppKeys["resourcewatcher"]
+		c.rwClient = resourcewatcher.New(appKey, c.config.Credentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.rwClient
+}
+
+func (c *Client) APIGateway() *apigw.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.apigwClient == nil {
+		appKey := c.config.AppKeys["apigw"]
+		c.apigwClient = apigw.New(c.config.Region, appKey, c.config.Credentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.apigwClient
+}
+
+func (c *Client) NAS() *nas.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.nasClient == nil {
+		c.nasClient = nas.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.nasClient
+}
+
+func (c *Client) Image() *image.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.imageClient == nil {
+		c.imageClient = image.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.imageClient
+}
+
+func (c *Client) S3Credential() *s3credential.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.s3credClient == nil {
+		c.s3credClient = s3credential.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.s3credClient
+}
+
+func (c *Client) Mirroring() *mirroring.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mirroringClient == nil {
+		c.mirroringClient = mirroring.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.mirroringClient
+}
+
+func (c *Client) ColocationGateway() *colocationgw.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.colocationClient == nil {
+		c.colocationClient = colocationgw.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.colocationClient
+}
+
+func (c *Client) TransitHub() *transithub.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.transitHubClient == nil {
+		c.transitHubClient = transithub.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.transitHubClient
+}
+
+func (c *Client) NetworkACL() *networkacl.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.naclClient == nil {
+		c.naclClient = networkacl.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.naclClient
+}
+
+func (c *Client) PrivateDNS() *privatedns.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.privateDNSClient == nil {
+		c.privateDNSClient = privatedns.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.privateDNSClient
+}
+
+func (c *Client) NATGateway() *natgateway.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.natClient == nil {
+		c.natClient = natgateway.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.natClient
+}
+
+func (c *Client) InternetGateway() *internetgateway.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.igwClient == nil {
+		c.igwClient = internetgateway.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.igwClient
+}
+
+func (c *Client) ServiceGateway() *servicegateway.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.sgwClient == nil {
+		c.sgwClient = servicegateway.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.sgwClient
+}
+
+func (c *Client) FlowLog() *flowlog.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.flowLogClient == nil {
+		c.flowLogClient = flowlog.NewClient(c.config.Region, c.config.IdentityCredentials, c.config.httpClient(), c.config.Debug, c.config.options()...)
+	}
+	return c.flowLogClient
+}
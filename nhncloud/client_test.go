@@ -1,9 +1,16 @@
 package nhncloud
 
 import (
+	"context"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/circuitbreaker"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/cloudtest"
 )
 
 func TestNewClient(t *testing.T) {
@@ -39,11 +46,17 @@ func TestClientServiceAccessors(t *testing.T) {
 		Credentials:         creds,
 		IdentityCredentials: identityCreds,
 		AppKeys: map[string]string{
-			"rds-mysql":      "mysql-appkey",
-			"rds-mariadb":    "mariadb-appkey",
-			"rds-postgresql": "pg-appkey",
-			"ncr":            "ncr-appkey",
-			"ncs":            "ncs-appkey",
+			"rds-mysql":       "mysql-appkey",
+			"rds-mariadb":     "mariadb-appkey",
+			"rds-postgresql":  "pg-appkey",
+			"ncr":             "ncr-appkey",
+			"ncs":             "ncs-appkey",
+			"dnsplus":         "dnsplus-appkey",
+			"keymanager":      "keymanager-appkey",
+			"certmanager":     "certmanager-appkey",
+			"cloudtrail":      "cloudtrail-appkey",
+			"resourcewatcher": "rw-appkey",
+			"apigw":           "apigw-appkey",
 		},
 	}
 
@@ -107,6 +120,32 @@ func TestClientServiceAccessors(t *testing.T) {
 	if client.NCS() == nil {
 		t.Error("NCS() returned nil")
 	}
+
+	accessors := map[string]func() bool{
+		"DNSPlus":           func() bool { return client.DNSPlus() != nil },
+		"KeyManager":        func() bool { return client.KeyManager() != nil },
+		"CertManager":       func() bool { return client.CertManager() != nil },
+		"CloudTrail":        func() bool { return client.CloudTrail() != nil },
+		"ResourceWatcher":   func() bool { return client.ResourceWatcher() != nil },
+		"APIGateway":        func() bool { return client.APIGateway() != nil },
+		"NAS":               func() bool { return client.NAS() != nil },
+		"Image":             func() bool { return client.Image() != nil },
+		"S3Credential":      func() bool { return client.S3Credential() != nil },
+		"Mirroring":         func() bool { return client.Mirroring() != nil },
+		"ColocationGateway": func() bool { return client.ColocationGateway() != nil },
+		"TransitHub":        func() bool { return client.TransitHub() != nil },
+		"NetworkACL":        func() bool { return client.NetworkACL() != nil },
+		"PrivateDNS":        func() bool { return client.PrivateDNS() != nil },
+		"NATGateway":        func() bool { return client.NATGateway() != nil },
+		"InternetGateway":   func() bool { return client.InternetGateway() != nil },
+		"ServiceGateway":    func() bool { return client.ServiceGateway() != nil },
+		"FlowLog":           func() bool { return client.FlowLog() != nil },
+	}
+	for name, ok := range accessors {
+		if !ok() {
+			t.Errorf("%s() returned nil", name)
+		}
+	}
 }
 
 func TestClientLazyInitialization(t *testing.T) {
@@ -125,3 +164,221 @@ func TestClientLazyInitialization(t *testing.T) {
 		t.Error("IAM() should return same instance (lazy initialization)")
 	}
 }
+
+func TestRawResolvesServiceEndpoint(t *testing.T) {
+	cfg := &Config{
+		Region:      "kr1",
+		Credentials: credentials.NewStatic("access-key", "secret-key"),
+		AppKeys: map[string]string{
+			"rds-mysql":   "mysql-appkey",
+			"certmanager": "cm-appkey",
+			"ncr":         "ncr-appkey",
+		},
+		APIVersions: map[string]string{"rds-mysql": "v4.0"},
+	}
+	client, _ := New(cfg)
+	ctx := context.Background()
+
+	tests := []struct {
+		service string
+		auth    RawAuth
+		baseURL string
+	}{
+		{"rds-mysql", RawAuthOAuth, "https://kr1-rds-mysql.api.nhncloudservice.com/v4.0"},
+		{"ncr", RawAuthOAuth, "https://kr1-ncr.api.nhncloudservice.com/ncr/v2.0/appkeys/ncr-appkey"},
+		{"certmanager", RawAuthAppKey, "https://certmanager.api.nhncloudservice.com/certmanager/v1.0/appkeys/cm-appkey"},
+		{"iam", RawAuthOAuth, "https://core.api.nhncloudservice.com"},
+	}
+	for _, tt := range tests {
+		raw := client.Raw(tt.service)
+		if raw.Auth() != tt.auth {
+			t.Errorf("Raw(%q).Auth() = %v, want %v", tt.service, raw.Auth(), tt.auth)
+		}
+		got, err := raw.BaseURL(ctx)
+		if err != nil {
+			t.Errorf("Raw(%q).BaseURL() error: %v", tt.service, err)
+			continue
+		}
+		if got != tt.baseURL {
+			t.Errorf("Raw(%q).BaseURL() = %q, want %q", tt.service, got, tt.baseURL)
+		}
+	}
+
+	if client.Raw("rds-mysql") != client.Raw("rds-mysql") {
+		t.Error("Raw() should return the same RawClient per service")
+	}
+}
+
+func TestRawDo(t *testing.T) {
+	srv := cloudtest.New(t)
+	headers := make(map[string]http.Header)
+	echo := func(w http.ResponseWriter, r *http.Request) {
+		headers[r.Host] = r.Header.Clone()
+		cloudtest.WriteJSON(w, http.StatusOK, map[string]string{"path": r.URL.Path})
+	}
+	srv.Mux.HandleFunc("kr1-rds-mysql.api.nhncloudservice.com/", echo)
+	srv.Mux.HandleFunc("kr1-rds-mariadb.api.nhncloudservice.com/", echo)
+	srv.Mux.HandleFunc("compute.test/", echo)
+
+	client, _ := New(&Config{
+		Region:              "kr1",
+		Credentials:         credentials.NewStatic("access-key", "secret-key"),
+		IdentityCredentials: credentials.NewStaticIdentity("user", "pass", "tenant"),
+		AppKeys:             map[string]string{"rds-mysql": "mysql-appkey", "rds-mariadb": "mariadb-appkey"},
+		APIVersions:         map[string]string{"rds-mysql": "v4.0"},
+		HTTPClient:          srv.Client(),
+	})
+	ctx := context.Background()
+
+	tests := []struct {
+		service, host, served string
+		want                  map[string]string
+	}{
+		{"rds-mysql", "kr1-rds-mysql.api.nhncloudservice.com", "/v4.0/db-instances", map[string]string{
+			"X-TC-APP-KEY":        "mysql-appkey",
+			"X-NHN-AUTHORIZATION": "Bearer " + cloudtest.AccessToken,
+		}},
+		{"rds-mariadb", "kr1-rds-mariadb.api.nhncloudservice.com", "/v3.0/db-instances", map[string]string{
+			"X-TC-APP-KEY":               "mariadb-appkey",
+			"X-TC-AUTHENTICATION-ID":     "access-key",
+			"X-TC-AUTHENTICATION-SECRET": "secret-key",
+		}},
+		{"compute", "compute.test", "/servers", map[string]string{
+			"X-Auth-Token": cloudtest.IdentityToken,
+		}},
+	}
+	for _, tt := range tests {
+		var out struct{ Path string }
+		path := tt.served[strings.LastIndex(tt.served, "/"):]
+		if err := client.Raw(tt.service).Do(ctx, "get", path, nil, &out); err != nil {
+			t.Errorf("Raw(%q).Do: %v", tt.service, err)
+			continue
+		}
+		if out.Path != tt.served {
+			t.Errorf("Raw(%q) requested %s, want %s", tt.service, out.Path, tt.served)
+		}
+		for name, want := range tt.want {
+			if got := headers[tt.host].Get(name); got != want {
+				t.Errorf("Raw(%q) %s = %q, want %q", tt.service, name, got, want)
+			}
+		}
+	}
+
+	// *[]byte receives the body undecoded, and errors keep their type.
+	var body []byte
+	if err := client.Raw("compute").Do(ctx, "GET", "/flavors", nil, &body); err != nil || !strings.Contains(string(body), "/flavors") {
+		t.Errorf("Do into *[]byte = %s, %v", body, err)
+	}
+	srv.Mux.HandleFunc("compute.test/servers/missing", func(w http.ResponseWriter, r *http.Request) {
+		cloudtest.WriteJSON(w, http.StatusNotFound, map[string]string{"message": "not found"})
+	})
+	if err := client.Raw("compute").Do(ctx, "GET", "/servers/missing", nil, nil); !errors.IsNotFound(err) {
+		t.Errorf("Do on a 404 = %v, want a not-found error", err)
+	}
+}
+
+func TestRawErrors(t *testing.T) {
+	client, _ := New(&Config{Region: "kr1", Credentials: credentials.NewStatic("a", "s")})
+	ctx := context.Background()
+
+	if err := client.Raw("no-such-service").Do(ctx, "GET", "/", nil, nil); err == nil {
+		t.Error("expected error for unknown service")
+	}
+	if err := client.Raw("rds-mariadb").Do(ctx, "GET", "/db-instances", nil, nil); err != ErrAppKeyRequired {
+		t.Errorf("expected ErrAppKeyRequired, got %v", err)
+	}
+	if err := client.Raw("compute").Do(ctx, "GET", "/servers", nil, nil); err != ErrTenantIDRequired {
+		t.Errorf("expected ErrTenantIDRequired, got %v", err)
+	}
+}
+
+func TestAccessorsShareConfigOptions(t *testing.T) {
+	srv := cloudtest.New(t)
+	unavailable := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusServiceUnavailable) }
+	srv.Mux.HandleFunc("network.test/", unavailable)
+	srv.Mux.HandleFunc("api-keymanager.nhncloudservice.com/", unavailable)
+	srv.Mux.HandleFunc("kr1-rds-mysql.api.nhncloudservice.com/", unavailable)
+
+	client, _ := New(&Config{
+		Region:              "kr1",
+		Credentials:         credentials.NewStatic("access-key", "secret-key"),
+		IdentityCredentials: credentials.NewStaticIdentity("user", "pass", "tenant"),
+		AppKeys:             map[string]string{"keymanager": "km-appkey", "rds-mysql": "mysql-appkey"},
+		HTTPClient:          srv.Client(),
+		CircuitBreaker:      circuitbreaker.New(circuitbreaker.Config{FailureThreshold: 1}),
+	})
+	ctx := context.Background()
+
+	calls := map[string]func() error{
+		"VPC":        func() error { _, err := client.VPC().ListVPCs(ctx); return err },
+		"KeyManager": func() error { _, err := client.KeyManager().GetClientInfo(ctx); return err },
+		"MySQL":      func() error { _, err := client.MySQL().ListInstances(ctx); return err },
+	}
+	for name, call := range calls {
+		if err := call(); err == nil {
+			t.Errorf("%s: first call succeeded, want the 503", name)
+		}
+		if err := call(); !errors.IsCircuitOpen(err) {
+			t.Errorf("%s: second call = %v, want CircuitOpenError", name, err)
+		}
+	}
+
+	// The token requests went through the configured client too.
+	var tokens int
+	for _, r := range srv.Requests() {
+		if strings.Contains(r, "/v2.0/tokens") {
+			tokens++
+		}
+	}
+	if tokens != 1 {
+		t.Errorf("served %d Identity token requests, want 1: %v", tokens, srv.Requests())
+	}
+}
+
+func TestAccessorsReportDrift(t *testing.T) {
+	srv := cloudtest.New(t)
+	srv.HandleJSON("dnsplus.api.nhncloudservice.com/dnsplus/v1.0/appkeys/dns-appkey/zones",
+		`{"header": {"isSuccessful": true}, "newField": 1}`)
+	srv.HandleJSON("api-keymanager.nhncloudservice.com/keymanager/v1.2/appkey/km-appkey/confirm",
+		`{"header": {"isSuccessful": true}, "newField": 1}`)
+	srv.HandleJSON("kr1-api-nas-infrastructure.nhncloudservice.com/v1/volumes", `{"volumes": [], "newField": 1}`)
+	srv.HandleJSON("object-store.test/", `[{"name": "c1", "newField": 1}]`)
+
+	var reports []drift.Report
+	client, _ := New(&Config{
+		Region:              "kr1",
+		Credentials:         credentials.NewStatic("access-key", "secret-key"),
+		IdentityCredentials: credentials.NewStaticIdentity("user", "pass", "tenant"),
+		AppKeys:             map[string]string{"dnsplus": "dns-appkey", "keymanager": "km-appkey"},
+		HTTPClient:          srv.Client(),
+		Drift:               drift.New(drift.Config{OnDrift: func(r drift.Report) { reports = append(reports, r) }}),
+	})
+	ctx := context.Background()
+
+	if _, err := client.DNSPlus().ListZones(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.KeyManager().GetClientInfo(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.NAS().ListVolumes(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ObjectStorage().ListContainers(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, r := range reports {
+		got = append(got, r.Service+" "+r.Operation+" "+strings.Join(r.Paths, ","))
+	}
+	want := []string{
+		"dnsplus ListZones newField",
+		"keymanager GetClientInfo newField",
+		"nas ListVolumes newField",
+		"object-storage ListContainers [].newField",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("reports:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
@@ -9,6 +9,12 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 const DefaultBaseURL = "https://cloud-trail.api.nhncloudservice.com"
@@ -22,29 +28,52 @@ type Client struct {
 	httpClient  *http.Client
 	debug       bool
 	useV2       bool // Use v2.0 API (requires user auth)
+	drift       *drift.Detector
 }
 
-// NewClient creates a new CloudTrail client
-func NewClient(appKey, accessKeyID, secretKey string, httpClient *http.Client, debug bool) *Client {
-	if httpClient == nil {
-		httpClient = &http.Client{Timeout: 30 * time.Second}
+// New creates a CloudTrail client that authenticates with the User Access
+// Key in creds. creds may be nil for operations that only need the app key.
+// A nil httpClient uses a client with a 30s timeout.
+func New(appKey string, creds credentials.Credentials, httpClient *http.Client, debug bool, opts ...option.Option) *Client {
+	settings := option.Apply(opts...)
+	if settings.HTTPClient == nil {
+		settings.HTTPClient = httpClient
 	}
+	accessKeyID, secretKey := credentials.UserAccessKey(creds)
 	return &Client{
 		baseURL:     DefaultBaseURL,
 		appKey:      appKey,
 		accessKeyID: accessKeyID,
 		secretKey:   secretKey,
-		httpClient:  httpClient,
+		httpClient:  client.HTTPClient(settings, &http.Client{Timeout: 30 * time.Second}),
+		drift:       client.Drift(settings),
 		debug:       debug,
 		useV2:       true, // Default to v2.0 for better security
 	}
 }
 
+// NewClient creates a new CloudTrail client from a User Access Key.
+//
+// Deprecated: Use New, which takes the key as credentials and accepts the
+// shared options.
+func NewClient(appKey, accessKeyID, secretKey string, httpClient *http.Client, debug bool) *Client {
+	return New(appKey, credentials.NewStatic(accessKeyID, secretKey), httpClient, debug)
+}
+
 // SetUseV2 sets whether to use v2.0 API
 func (c *Client) SetUseV2(useV2 bool) {
 	c.useV2 = useV2
 }
 
+// decode unmarshals a response body into v, checking it for unmapped
+// fields first when drift detection is enabled.
+func (c *Client) decode(body []byte, v interface{}) error {
+	if err := c.drift.Check("cloudtrail", "", body, v); err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
 // buildPath constructs the full API path
 func (c *Client) buildPath(path string) string {
 	version := "v1.0"
@@ -64,7 +93,7 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		}
 		reqBody = bytes.NewReader(jsonData)
 		if c.debug {
-			fmt.Printf("[DEBUG] Request body: %s\n", string(jsonData))
+			fmt.Printf("[DEBUG] Request body: %s\n", secret.RedactJSON(jsonData))
 		}
 	}
 
@@ -99,11 +128,11 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 
 	if c.debug {
 		fmt.Printf("[DEBUG] Response status: %d\n", resp.StatusCode)
-		fmt.Printf("[DEBUG] Response body: %s\n", string(respBody))
+		fmt.Printf("[DEBUG] Response body: %s\n", secret.RedactJSON(respBody))
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, secret.RedactJSON(respBody))
 	}
 
 	return respBody, nil
@@ -117,7 +146,7 @@ func (c *Client) SearchEvents(ctx context.Context, input *SearchEventsInput) (*S
 	}
 
 	var result SearchEventsOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
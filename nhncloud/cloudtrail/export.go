@@ -0,0 +1,411 @@
+package cloudtrail
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Export defaults.
+const (
+	DefaultExportWindow   = 24 * time.Hour
+	DefaultExportPageSize = 100
+)
+
+// ExportOptions configures Export.
+type ExportOptions struct {
+	// From and To bound the export.
+	From, To time.Time
+	// Window is the span of each search. Zero means DefaultExportWindow;
+	// narrow it if single windows hold more events than the API pages
+	// through.
+	Window time.Duration
+	// PageSize is the page size of each search. Zero means
+	// DefaultExportPageSize.
+	PageSize int
+	// Filter, if set, narrows the search by its list fields; its From,
+	// To, Page and Size are ignored.
+	Filter *SearchEventsInput
+	// Key identifies an event for de-duplication. Zero means EventKey.
+	Key func(*Event) string
+	// CheckpointPath, if set, is where progress is saved after every page.
+	// The keys of the events already written are appended beside it, to
+	// CheckpointPath+".seen". Export resumes from an existing checkpoint
+	// for the same range, and removes both files when the export
+	// completes.
+	CheckpointPath string
+	// OnProgress, if set, is called after every page.
+	OnProgress func(Checkpoint)
+}
+
+// EventKey is the default de-duplication key. An event's EventID names
+// what happened, such as an API action, rather than the occurrence, so the
+// key adds the request ID, member and time.
+func EventKey(e *Event) string {
+	return strings.Join([]string{e.EventID, e.RequestID, e.MemberID, e.EventTime.String()}, "|")
+}
+
+// Checkpoint is the progress of an export.
+type Checkpoint struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+	// WindowStart is the start of the window in progress, and Page the
+	// next page of it to fetch.
+	WindowStart time.Time `json:"windowStart"`
+	Page        int       `json:"page"`
+	// Exported counts the events written so far.
+	Exported int `json:"exported"`
+}
+
+// LoadCheckpoint reads a checkpoint file.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cp Checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("cloudtrail: checkpoint %s: %w", path, err)
+	}
+	return &cp, nil
+}
+
+func (cp *Checkpoint) save(path string) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, data)
+}
+
+// writeFile replaces the file at path with data, through a temporary file
+// so that readers never see it half written.
+func writeFile(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Sink receives exported events. A Sink that also has a Flush() error
+// method is flushed before each checkpoint, so a resumed export never
+// loses buffered events.
+type Sink interface {
+	WriteEvent(e *Event) error
+}
+
+// SinkFunc adapts a function to a Sink.
+type SinkFunc func(e *Event) error
+
+func (f SinkFunc) WriteEvent(e *Event) error { return f(e) }
+
+// JSONLinesSink writes one event per line as JSON.
+type JSONLinesSink struct {
+	bw  *bufio.Writer
+	enc *json.Encoder
+}
+
+// NewJSONLinesSink returns a sink writing to w.
+func NewJSONLinesSink(w io.Writer) *JSONLinesSink {
+	bw := bufio.NewWriter(w)
+	return &JSONLinesSink{bw: bw, enc: json.NewEncoder(bw)}
+}
+
+func (s *JSONLinesSink) WriteEvent(e *Event) error { return s.enc.Encode(e) }
+
+// Flush writes any buffered events.
+func (s *JSONLinesSink) Flush() error { return s.bw.Flush() }
+
+// csvHeader is the header row of CSVSink.
+var csvHeader = []string{
+	"event_time", "event_id", "event_type", "event_source_type", "member_type", "member_id",
+	"source_ip", "user_agent", "org_id", "project_id", "product_id", "region", "request_id",
+	"resources", "request", "response",
+}
+
+// CSVSink writes events as CSV. Resources are written as
+// "type:id" separated by spaces.
+type CSVSink struct {
+	cw     *csv.Writer
+	header bool
+}
+
+// NewCSVSink returns a sink writing to w, starting with a header row if
+// header is set; leave it unset when appending to a resumed export.
+func NewCSVSink(w io.Writer, header bool) *CSVSink {
+	return &CSVSink{cw: csv.NewWriter(w), header: header}
+}
+
+func (s *CSVSink) WriteEvent(e *Event) error {
+	if s.header {
+		s.header = false
+		if err := s.cw.Write(csvHeader); err != nil {
+			return err
+		}
+	}
+	res := make([]string, len(e.Resources))
+	for i, r := range e.Resources {
+		res[i] = r.ResourceType + ":" + r.ResourceID
+	}
+	return s.cw.Write([]string{
+		e.EventTime.String(), e.EventID, e.EventType, e.EventSourceType, e.MemberType, e.MemberID,
+		e.SourceIP, e.UserAgent, e.OrgID, e.ProjectID, e.ProductID, e.Region, e.RequestID,
+		strings.Join(res, " "), e.Request, e.Response,
+	})
+}
+
+// Flush writes any buffered events.
+func (s *CSVSink) Flush() error {
+	s.cw.Flush()
+	return s.cw.Error()
+}
+
+// Export searches the events from opts.From to opts.To and writes them to
+// sink, oldest window first. It splits the range into windows, pages
+// through each fully and skips events already written, since windows
+// share their boundary instant and pages shift as events arrive. It
+// returns the number of events written, including those of the run a
+// checkpoint resumed. A resumed export starts at the page after the last
+// checkpoint, so events a sink accepted after it are written again.
+func (c *Client) Export(ctx context.Context, sink Sink, opts ExportOptions) (int, error) {
+	if !opts.From.Before(opts.To) {
+		return 0, fmt.Errorf("cloudtrail: export: From must be before To")
+	}
+	if opts.Window <= 0 {
+		opts.Window = DefaultExportWindow
+	}
+	if opts.PageSize <= 0 {
+		opts.PageSize = DefaultExportPageSize
+	}
+	if opts.Key == nil {
+		opts.Key = EventKey
+	}
+
+	cp := &Checkpoint{From: opts.From, To: opts.To, WindowStart: opts.From, Page: 1}
+	if opts.CheckpointPath != "" {
+		saved, err := LoadCheckpoint(opts.CheckpointPath)
+		switch {
+		case err == nil:
+			if !saved.From.Equal(opts.From) || !saved.To.Equal(opts.To) {
+				return 0, fmt.Errorf("cloudtrail: export: checkpoint %s is for %s to %s", opts.CheckpointPath, saved.From, saved.To)
+			}
+			cp = saved
+		case !errors.Is(err, os.ErrNotExist):
+			return 0, err
+		}
+	}
+	// seen holds the keys of the events of the window in progress, and
+	// prevSeen those of the one before it, which are all a window can
+	// repeat.
+	seen, prevSeen := map[string]bool{}, map[string]bool{}
+	var log *seenLog
+	if opts.CheckpointPath != "" {
+		log = &seenLog{path: opts.CheckpointPath + ".seen"}
+		var err error
+		if seen, prevSeen, err = log.load(cp.WindowStart); err != nil {
+			return 0, err
+		}
+	}
+
+	for start := cp.WindowStart; start.Before(opts.To); start = cp.WindowStart {
+		end := start.Add(opts.Window)
+		if end.After(opts.To) {
+			end = opts.To
+		}
+		for {
+			out, last, err := c.searchPage(ctx, opts.Filter, start, end, cp.Page, opts.PageSize)
+			if err != nil {
+				return cp.Exported, fmt.Errorf("cloudtrail: export %w", err)
+			}
+			var added []string
+			for i := range out.Body.Events {
+				e := &out.Body.Events[i]
+				key := opts.Key(e)
+				if seen[key] || prevSeen[key] {
+					continue
+				}
+				seen[key] = true
+				added = append(added, key)
+				if err := sink.WriteEvent(e); err != nil {
+					return cp.Exported, err
+				}
+				cp.Exported++
+			}
+
+			if last {
+				cp.WindowStart, cp.Page = end, 1
+				prevSeen, seen = seen, map[string]bool{}
+			} else {
+				cp.Page++
+			}
+			if err := checkpoint(sink, cp, log, start, added, last, &opts); err != nil {
+				return cp.Exported, err
+			}
+			if last {
+				break
+			}
+		}
+	}
+
+	if opts.CheckpointPath != "" {
+		for _, path := range []string{opts.CheckpointPath, log.path} {
+			if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return cp.Exported, err
+			}
+		}
+	}
+	return cp.Exported, nil
+}
+
+// searchPage searches one page of the events from from to to, narrowed by
+// filter's list fields, and reports whether it is the last page.
+func (c *Client) searchPage(ctx context.Context, filter *SearchEventsInput, from, to time.Time, page, size int) (*SearchEventsOutput, bool, error) {
+	in := &SearchEventsInput{}
+	if filter != nil {
+		*in = *filter
+	}
+	in.From, in.To, in.Page, in.Size = from, to, page, size
+	out, err := c.SearchEvents(ctx, in)
+	if err == nil && !out.Header.IsSuccessful {
+		err = fmt.Errorf("%d %s", out.Header.ResultCode, out.Header.ResultMessage)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("%s to %s, page %d: %w", from.Format(time.RFC3339), to.Format(time.RFC3339), page, err)
+	}
+	// A response without totalCount reads as 0, so only a positive total
+	// ends the paging early.
+	last := len(out.Body.Events) < size || (out.Body.TotalCount > 0 && page*size >= out.Body.TotalCount)
+	return out, last, nil
+}
+
+// checkpoint flushes sink and records the progress after a page of the
+// window starting at start, which added keys to the window's seen set and
+// was its last page if last is set.
+func checkpoint(sink Sink, cp *Checkpoint, log *seenLog, start time.Time, added []string, last bool, opts *ExportOptions) error {
+	if f, ok := sink.(interface{ Flush() error }); ok {
+		if err := f.Flush(); err != nil {
+			return err
+		}
+	}
+	if log != nil {
+		// The keys go first: a checkpoint that lags them refetches a page
+		// whose events are all skipped, rather than written twice.
+		err := log.append(start, added)
+		if err == nil && last {
+			err = log.keep(start)
+		}
+		if err == nil {
+			err = cp.save(opts.CheckpointPath)
+		}
+		if err != nil {
+			return fmt.Errorf("cloudtrail: save checkpoint: %w", err)
+		}
+	}
+	if opts.OnProgress != nil {
+		opts.OnProgress(*cp)
+	}
+	return nil
+}
+
+// seenLog is the file of the keys of the events an export has written,
+// one "<window start> <key>" line each. It holds the window in progress
+// and the one before it: pages append to it, and the end of a window
+// drops the window before.
+type seenLog struct {
+	path string
+}
+
+// load returns the keys of the window starting at start and those of the
+// window before it. A missing file holds no keys.
+func (l *seenLog) load(start time.Time) (seen, prev map[string]bool, err error) {
+	seen, prev = map[string]bool{}, map[string]bool{}
+	f, err := os.Open(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return seen, prev, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	tag := windowTag(start)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		window, key, ok := strings.Cut(scanner.Text(), " ")
+		switch {
+		case !ok:
+			continue
+		case window == tag:
+			seen[key] = true
+		default:
+			prev[key] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("cloudtrail: %s: %w", l.path, err)
+	}
+	return seen, prev, nil
+}
+
+// append adds keys to the window starting at start.
+func (l *seenLog) append(start time.Time, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	var b strings.Builder
+	tag := windowTag(start)
+	for _, k := range keys {
+		b.WriteString(tag + " " + k + "\n")
+	}
+	f, err := os.OpenFile(l.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(b.String()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// keep drops every window but the one starting at start.
+func (l *seenLog) keep(start time.Time) error {
+	data, err := os.ReadFile(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	prefix := []byte(windowTag(start) + " ")
+	var kept []byte
+	for _, line := range bytes.SplitAfter(data, []byte("\n")) {
+		if bytes.HasPrefix(line, prefix) {
+			kept = append(kept, line...)
+		}
+	}
+	return writeFile(l.path, kept)
+}
+
+func windowTag(start time.Time) string {
+	return strconv.FormatInt(start.UnixNano(), 10)
+}
@@ -0,0 +1,195 @@
+package cloudtrail
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
+var exportStart = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+// fakeTrail serves /events/search over the events returned by events,
+// with From and To inclusive and 1-based pages, and counts the searches.
+func fakeTrail(t *testing.T, events func(in *SearchEventsInput) []Event) (*Client, *int) {
+	searches := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/appkeys/key/events/search") {
+			http.NotFound(w, r)
+			return
+		}
+		searches++
+		var in SearchEventsInput
+		if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+			t.Error(err)
+		}
+		var match []Event
+		for _, e := range events(&in) {
+			if !e.EventTime.Before(timestamp.New(in.From)) && !e.EventTime.After(timestamp.New(in.To)) {
+				match = append(match, e)
+			}
+		}
+		out := SearchEventsOutput{Header: Header{IsSuccessful: true}}
+		out.Body.TotalCount = len(match)
+		if lo := (in.Page - 1) * in.Size; lo < len(match) {
+			out.Body.Events = match[lo:min(lo+in.Size, len(match))]
+		}
+		json.NewEncoder(w).Encode(out)
+	}))
+	t.Cleanup(srv.Close)
+	c := NewClient("key", "", "", srv.Client(), false)
+	c.baseURL = srv.URL
+	return c, &searches
+}
+
+// hourlyEvents returns n events an hour apart from exportStart, so that
+// those on the hour of a window boundary are returned by both windows.
+func hourlyEvents(n int) func(*SearchEventsInput) []Event {
+	events := make([]Event, n)
+	for i := range events {
+		events[i] = Event{
+			EventTime: timestamp.New(exportStart.Add(time.Duration(i) * time.Hour)),
+			EventID:   "iaas.instance.create",
+			RequestID: fmt.Sprintf("req-%02d", i),
+			MemberID:  "member",
+		}
+	}
+	return func(*SearchEventsInput) []Event { return events }
+}
+
+func TestExportDeduplicatesAcrossWindows(t *testing.T) {
+	c, _ := fakeTrail(t, hourlyEvents(48))
+	var out bytes.Buffer
+	sink := NewJSONLinesSink(&out)
+	n, err := c.Export(context.Background(), sink, ExportOptions{
+		From: exportStart, To: exportStart.Add(48 * time.Hour), Window: 6 * time.Hour, PageSize: 4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if n != 48 || len(lines) != 48 {
+		t.Fatalf("exported %d events in %d lines, want 48", n, len(lines))
+	}
+	var last Event
+	if err := json.Unmarshal([]byte(lines[47]), &last); err != nil || last.RequestID != "req-47" {
+		t.Errorf("last line = %s (%v)", lines[47], err)
+	}
+
+	var csvOut bytes.Buffer
+	csvSink := NewCSVSink(&csvOut, true)
+	if _, err := c.Export(context.Background(), csvSink, ExportOptions{From: exportStart, To: exportStart.Add(2 * time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+	csvLines := strings.Split(strings.TrimSpace(csvOut.String()), "\n")
+	if len(csvLines) != 4 || !strings.HasPrefix(csvLines[0], "event_time,event_id") ||
+		csvLines[1] != "2024-03-01T00:00:00Z,iaas.instance.create,,,,member,,,,,,,req-00,,," {
+		t.Errorf("CSV:\n%s", csvOut.String())
+	}
+}
+
+func TestExportResumesFromCheckpoint(t *testing.T) {
+	c, searches := fakeTrail(t, hourlyEvents(30))
+	path := filepath.Join(t.TempDir(), "export.checkpoint")
+	opts := ExportOptions{
+		From: exportStart, To: exportStart.Add(30 * time.Hour), Window: 12 * time.Hour, PageSize: 5,
+		CheckpointPath: path,
+	}
+
+	var got []string
+	sink := SinkFunc(func(e *Event) error {
+		got = append(got, e.RequestID)
+		return nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	pages := 0
+	opts.OnProgress = func(cp Checkpoint) {
+		if pages++; pages == 4 {
+			cancel()
+		}
+	}
+	if _, err := c.Export(ctx, sink, opts); err == nil {
+		t.Fatal("expected the cancelled export to fail")
+	}
+	cp, err := LoadCheckpoint(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Window one holds 13 events (00:00 to 12:00 inclusive): three pages,
+	// then one page of window two, which repeats 12:00.
+	if cp.Exported != 17 || cp.Page != 2 || !cp.WindowStart.Equal(exportStart.Add(12*time.Hour)) {
+		t.Fatalf("checkpoint = %+v", cp)
+	}
+	seen, prev, err := (&seenLog{path: path + ".seen"}).load(cp.WindowStart)
+	if err != nil || len(seen) != 4 || len(prev) != 13 {
+		t.Fatalf("seen %d keys, %d of the window before (%v); want 4 and 13", len(seen), len(prev), err)
+	}
+
+	*searches = 0
+	opts.OnProgress = nil
+	n, err := c.Export(context.Background(), sink, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 30 || len(got) != 30 {
+		t.Fatalf("exported %d, sink got %d, want 30", n, len(got))
+	}
+	for i, id := range got {
+		if id != fmt.Sprintf("req-%02d", i) {
+			t.Fatalf("event %d = %s, want events in order without repeats: %v", i, id, got)
+		}
+	}
+	if *searches != 4 {
+		t.Errorf("resume made %d searches, want 4", *searches)
+	}
+	for _, p := range []string{path, path + ".seen"} {
+		if _, err := os.Stat(p); !os.IsNotExist(err) {
+			t.Errorf("%s left after completion: %v", p, err)
+		}
+	}
+
+	other := opts
+	other.To = other.To.Add(time.Hour)
+	if err := (&Checkpoint{From: opts.From, To: opts.To}).save(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Export(context.Background(), sink, other); err == nil {
+		t.Error("expected an error for a checkpoint of another range")
+	}
+}
+
+func TestExportWithoutTotalCount(t *testing.T) {
+	events := hourlyEvents(5)(nil)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var in SearchEventsInput
+		json.NewDecoder(r.Body).Decode(&in)
+		var page []Event
+		if lo := (in.Page - 1) * in.Size; lo < len(events) {
+			page = events[lo:min(lo+in.Size, len(events))]
+		}
+		// No totalCount, as some responses omit it.
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"header": map[string]interface{}{"isSuccessful": true},
+			"body":   map[string]interface{}{"events": page},
+		})
+	}))
+	defer srv.Close()
+	c := NewClient("key", "", "", srv.Client(), false)
+	c.baseURL = srv.URL
+
+	n, err := c.Export(context.Background(), SinkFunc(func(*Event) error { return nil }), ExportOptions{
+		From: exportStart, To: exportStart.Add(5 * time.Hour), PageSize: 2,
+	})
+	if err != nil || n != 5 {
+		t.Errorf("Export = %d, %v; want all 5 events", n, err)
+	}
+}
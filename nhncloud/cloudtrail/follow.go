@@ -0,0 +1,155 @@
+package cloudtrail
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Follow defaults.
+const (
+	DefaultFollowInterval = 10 * time.Second
+	DefaultFollowOverlap  = 5 * time.Minute
+)
+
+// FollowOptions configures Follow.
+type FollowOptions struct {
+	// Filter, if set, narrows the search by its list fields, such as
+	// MemberIDList and EventSourceTypeList; its From, To, Page and Size
+	// are ignored.
+	Filter *SearchEventsInput
+	// Since is where following starts. Zero means now, so only new events
+	// are delivered.
+	Since time.Time
+	// Interval is the time between polls. Zero means
+	// DefaultFollowInterval.
+	Interval time.Duration
+	// Overlap is how far before the high-water mark each poll searches
+	// again, to catch events that CloudTrail records late. Events that
+	// arrive later than that are missed. Zero means DefaultFollowOverlap.
+	Overlap time.Duration
+	// PageSize is the page size of each search. Zero means
+	// DefaultExportPageSize.
+	PageSize int
+	// Key identifies an event for de-duplication. Zero means EventKey.
+	Key func(*Event) string
+	// OnError, if set, is called with every failed poll. The next poll
+	// retries from the same high-water mark, so no events are lost.
+	OnError func(error)
+}
+
+// Follow polls for new events until ctx ends, calling fn with each one in
+// event time order, like tail -f. Each poll searches from the high-water
+// mark, the time of the last successful poll, less opts.Overlap, and skips
+// the events it has already delivered. Follow returns ctx.Err(), or the
+// first error fn returns.
+//
+//	err := client.CloudTrail().Follow(ctx, cloudtrail.FollowOptions{
+//	    Filter: &cloudtrail.SearchEventsInput{MemberIDList: []string{suspect}},
+//	}, func(e *cloudtrail.Event) error {
+//	    fmt.Println(e.EventTime, e.EventID, e.SourceIP)
+//	    return nil
+//	})
+func (c *Client) Follow(ctx context.Context, opts FollowOptions, fn func(e *Event) error) error {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultFollowInterval
+	}
+	if opts.Overlap <= 0 {
+		opts.Overlap = DefaultFollowOverlap
+	}
+	if opts.PageSize <= 0 {
+		opts.PageSize = DefaultExportPageSize
+	}
+	if opts.Key == nil {
+		opts.Key = EventKey
+	}
+	since := opts.Since
+	if since.IsZero() {
+		since = time.Now()
+	}
+
+	mark := since
+	// seen maps the keys of delivered events to their times, so keys can
+	// be dropped once they fall behind the overlap.
+	seen := map[string]time.Time{}
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		from := mark.Add(-opts.Overlap)
+		if from.Before(since) {
+			from = since
+		}
+		to := time.Now()
+		events, err := c.searchAll(ctx, opts.Filter, from, to, opts.PageSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if opts.OnError != nil {
+				opts.OnError(err)
+			}
+			timer.Reset(opts.Interval)
+			continue
+		}
+		sort.SliceStable(events, func(i, j int) bool { return events[i].EventTime.Before(events[j].EventTime) })
+		for i := range events {
+			e := &events[i]
+			key := opts.Key(e)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = e.EventTime.Time
+			if err := fn(e); err != nil {
+				return err
+			}
+		}
+
+		mark = to
+		for key, t := range seen {
+			if t.Before(mark.Add(-opts.Overlap)) {
+				delete(seen, key)
+			}
+		}
+		timer.Reset(opts.Interval)
+	}
+}
+
+// FollowEvents runs Follow in a goroutine and delivers the events on the
+// returned channel, which is closed when ctx ends.
+func (c *Client) FollowEvents(ctx context.Context, opts FollowOptions) <-chan Event {
+	ch := make(chan Event)
+	go func() {
+		defer close(ch)
+		c.Follow(ctx, opts, func(e *Event) error {
+			select {
+			case ch <- *e:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	return ch
+}
+
+// searchAll returns every page of the events from from to to.
+func (c *Client) searchAll(ctx context.Context, filter *SearchEventsInput, from, to time.Time, size int) ([]Event, error) {
+	var events []Event
+	for page := 1; ; page++ {
+		out, last, err := c.searchPage(ctx, filter, from, to, page, size)
+		if err != nil {
+			return nil, fmt.Errorf("cloudtrail: follow %w", err)
+		}
+		events = append(events, out.Body.Events...)
+		if last {
+			return events, nil
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package cloudtrail
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
+func TestFollowDeliversLateEventsOnce(t *testing.T) {
+	now := time.Now()
+	at := func(ago time.Duration, id string) Event {
+		return Event{EventTime: timestamp.New(now.Add(-ago)), EventID: "iaas.instance.delete", RequestID: id, MemberID: "suspect"}
+	}
+	var mu sync.Mutex
+	events := []Event{at(90*time.Minute, "old"), at(30*time.Minute, "recent"), at(3*time.Hour, "before-since")}
+	var members [][]string
+	c, _ := fakeTrail(t, func(in *SearchEventsInput) []Event {
+		mu.Lock()
+		defer mu.Unlock()
+		members = append(members, in.MemberIDList)
+		// The API lists newest first.
+		out := make([]Event, len(events))
+		for i, e := range events {
+			out[len(events)-1-i] = e
+		}
+		return out
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ch := c.FollowEvents(ctx, FollowOptions{
+		Filter:   &SearchEventsInput{MemberIDList: []string{"suspect"}},
+		Since:    now.Add(-2 * time.Hour),
+		Interval: 5 * time.Millisecond,
+		Overlap:  time.Hour,
+		PageSize: 2,
+	})
+
+	var got []string
+	next := func() {
+		select {
+		case e := <-ch:
+			got = append(got, e.RequestID)
+		case <-ctx.Done():
+			t.Fatalf("timed out; got %v", got)
+		}
+	}
+	next()
+	next()
+	// An event recorded late, with a time before the last poll, is still
+	// within the overlap.
+	mu.Lock()
+	events = append(events, at(10*time.Minute, "late"))
+	mu.Unlock()
+	next()
+	cancel()
+	for e := range ch {
+		got = append(got, e.RequestID)
+	}
+
+	if want := []string{"old", "recent", "late"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(members) < 2 || !reflect.DeepEqual(members[0], []string{"suspect"}) {
+		t.Errorf("member filters = %v", members)
+	}
+}
+
+func TestFollowReportsPollErrors(t *testing.T) {
+	c := NewClient("key", "", "", nil, false)
+	c.baseURL = "http://127.0.0.1:0"
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := 0
+	err := c.Follow(ctx, FollowOptions{
+		Interval: time.Millisecond,
+		OnError: func(err error) {
+			if errs++; errs == 3 {
+				cancel()
+			}
+		},
+	}, func(*Event) error { return nil })
+	if !errors.Is(err, context.Canceled) || errs != 3 {
+		t.Errorf("Follow = %v after %d errors", err, errs)
+	}
+}
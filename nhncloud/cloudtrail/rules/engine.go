@@ -0,0 +1,215 @@
+package rules
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cloudtrail"
+)
+
+// Alert is a rule that fired.
+type Alert struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity,omitempty"`
+	// Group is the GroupBy values the events share, joined by "|".
+	Group string `json:"group,omitempty"`
+	// Time is the time of the event that raised the alert.
+	Time time.Time `json:"time"`
+	// Events are the matching events counted toward the threshold, oldest
+	// first.
+	Events []cloudtrail.Event `json:"events"`
+}
+
+func (a *Alert) String() string {
+	e := a.Events[len(a.Events)-1]
+	s := fmt.Sprintf("%s [%s] %s by %s", a.Time.Format(time.RFC3339), a.Rule, e.EventID, e.MemberID)
+	if e.SourceIP != "" {
+		s += " from " + e.SourceIP
+	}
+	if a.Severity != "" {
+		s = strings.ToUpper(a.Severity) + " " + s
+	}
+	if len(a.Events) > 1 {
+		s += fmt.Sprintf(" (%d events)", len(a.Events))
+	}
+	return s
+}
+
+// Engine evaluates rules over events and notifies their alerts. It is safe
+// for concurrent use.
+type Engine struct {
+	rules     []Rule
+	notifiers []Notifier
+	// OnError, if set, receives notifier errors from Run and ReadJSONL,
+	// which then keep going; otherwise the first one stops them.
+	OnError func(error)
+
+	mu sync.Mutex
+	// pending holds the matching events of threshold rules by rule and
+	// group, until they leave the window or raise an alert.
+	pending map[string][]cloudtrail.Event
+	count   int
+}
+
+// NewEngine returns an engine for rules, notifying notifiers.
+func NewEngine(rules []Rule, notifiers ...Notifier) (*Engine, error) {
+	rs := make([]Rule, len(rules))
+	copy(rs, rules)
+	names := map[string]bool{}
+	for i := range rs {
+		rs[i].When = append([]Condition(nil), rs[i].When...)
+		if err := rs[i].compile(); err != nil {
+			return nil, err
+		}
+		if names[rs[i].Name] {
+			return nil, fmt.Errorf("rules: duplicate rule %q", rs[i].Name)
+		}
+		names[rs[i].Name] = true
+	}
+	return &Engine{rules: rs, notifiers: notifiers, pending: map[string][]cloudtrail.Event{}}, nil
+}
+
+// sweepEvery is how many events pass between drops of stale groups.
+const sweepEvery = 1000
+
+// Process evaluates e, notifies the alerts it raises and returns them.
+// The error joins the notifiers' errors; every notifier is tried.
+func (en *Engine) Process(ctx context.Context, e *cloudtrail.Event) ([]*Alert, error) {
+	alerts := en.evaluate(e)
+	var errs []error
+	for _, a := range alerts {
+		for _, n := range en.notifiers {
+			if err := n.Notify(ctx, a); err != nil {
+				errs = append(errs, fmt.Errorf("rules: notify %s: %w", a.Rule, err))
+			}
+		}
+	}
+	return alerts, errors.Join(errs...)
+}
+
+func (en *Engine) evaluate(e *cloudtrail.Event) []*Alert {
+	en.mu.Lock()
+	defer en.mu.Unlock()
+	now := e.EventTime.Time
+	var alerts []*Alert
+	for i := range en.rules {
+		r := &en.rules[i]
+		if !r.match(e) {
+			continue
+		}
+		group := r.group(e)
+		if r.Threshold <= 1 {
+			alerts = append(alerts, &Alert{Rule: r.Name, Severity: r.Severity, Group: group, Time: now, Events: []cloudtrail.Event{*e}})
+			continue
+		}
+		key := r.Name + "\x00" + group
+		events := inWindow(append(en.pending[key], *e), now, time.Duration(r.Window))
+		if len(events) < r.Threshold {
+			en.pending[key] = events
+			continue
+		}
+		// The alert takes the events, so the next one starts a new count.
+		delete(en.pending, key)
+		alerts = append(alerts, &Alert{Rule: r.Name, Severity: r.Severity, Group: group, Time: now, Events: events})
+	}
+
+	if en.count++; en.count%sweepEvery == 0 {
+		for i := range en.rules {
+			r := &en.rules[i]
+			if r.Threshold <= 1 {
+				continue
+			}
+			for key, events := range en.pending {
+				if strings.HasPrefix(key, r.Name+"\x00") {
+					if events = inWindow(events, now, time.Duration(r.Window)); len(events) == 0 {
+						delete(en.pending, key)
+					} else {
+						en.pending[key] = events
+					}
+				}
+			}
+		}
+	}
+	return alerts
+}
+
+// inWindow returns the events within window of now, before or after it,
+// oldest first. It filters events in place. Events can arrive out of
+// order, so a late one may be older than those already pending.
+func inWindow(events []cloudtrail.Event, now time.Time, window time.Duration) []cloudtrail.Event {
+	kept := events[:0]
+	for _, e := range events {
+		d := e.EventTime.Time.Sub(now)
+		if d < 0 {
+			d = -d
+		}
+		if d <= window {
+			kept = append(kept, e)
+		}
+	}
+	sort.SliceStable(kept, func(i, j int) bool {
+		return kept[i].EventTime.Time.Before(kept[j].EventTime.Time)
+	})
+	return kept
+}
+
+// handle processes e for Run and ReadJSONL.
+func (en *Engine) handle(ctx context.Context, e *cloudtrail.Event) error {
+	_, err := en.Process(ctx, e)
+	if err != nil && en.OnError != nil {
+		en.OnError(err)
+		return nil
+	}
+	return err
+}
+
+// Run processes events until the channel is closed or ctx ends, as with
+// the stream of cloudtrail.Client.FollowEvents. It returns ctx.Err() or
+// nil when the channel closes.
+func (en *Engine) Run(ctx context.Context, events <-chan cloudtrail.Event) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if err := en.handle(ctx, &e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ReadJSONL processes the events of a JSON Lines export, as written by
+// cloudtrail.JSONLinesSink, in file order. Blank lines are skipped.
+func (en *Engine) ReadJSONL(ctx context.Context, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var e cloudtrail.Event
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			return fmt.Errorf("rules: line %d: %w", n, err)
+		}
+		if err := en.handle(ctx, &e); err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
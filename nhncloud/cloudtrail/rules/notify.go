@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cloudtrail"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
+)
+
+// Notifier delivers alerts.
+type Notifier interface {
+	Notify(ctx context.Context, a *Alert) error
+}
+
+// NotifierFunc adapts a function to a Notifier.
+type NotifierFunc func(ctx context.Context, a *Alert) error
+
+func (f NotifierFunc) Notify(ctx context.Context, a *Alert) error { return f(ctx, a) }
+
+// TextNotifier writes one line per alert.
+type TextNotifier struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewTextNotifier returns a notifier writing to w.
+func NewTextNotifier(w io.Writer) *TextNotifier {
+	return &TextNotifier{w: w}
+}
+
+func (n *TextNotifier) Notify(ctx context.Context, a *Alert) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	_, err := fmt.Fprintln(n.w, a)
+	return err
+}
+
+// Summary is the body WebhookNotifier posts by default: the alert without
+// its events, whose request and response bodies can carry credentials.
+type Summary struct {
+	Rule     string    `json:"rule"`
+	Severity string    `json:"severity,omitempty"`
+	Group    string    `json:"group,omitempty"`
+	Time     time.Time `json:"time"`
+	// Text is the alert's one-line description.
+	Text string `json:"text"`
+	// Count is the number of events in the alert.
+	Count int `json:"count"`
+	// RequestIDs identify the events in CloudTrail, oldest first.
+	RequestIDs []string `json:"requestIds,omitempty"`
+}
+
+// Summarize returns the summary of a.
+func Summarize(a *Alert) *Summary {
+	s := &Summary{Rule: a.Rule, Severity: a.Severity, Group: a.Group, Time: a.Time, Text: a.String(), Count: len(a.Events)}
+	for _, e := range a.Events {
+		if e.RequestID != "" {
+			s.RequestIDs = append(s.RequestIDs, e.RequestID)
+		}
+	}
+	return s
+}
+
+// WebhookNotifier posts each alert as JSON to a URL, such as a chat
+// incoming webhook behind a relay. The body is the alert's Summary unless
+// Events is set.
+type WebhookNotifier struct {
+	URL string
+	// Events posts the whole Alert, events included, with the sensitive
+	// values of their request and response bodies redacted.
+	Events bool
+	// Header is added to every request, for authentication.
+	Header http.Header
+	// Client sends the requests. Nil means a client with a 10 second
+	// timeout.
+	Client *http.Client
+}
+
+var defaultWebhookClient = &http.Client{Timeout: 10 * time.Second}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, a *Alert) error {
+	var v interface{} = Summarize(a)
+	if n.Events {
+		v = redactAlert(a)
+	}
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range n.Header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := n.Client
+	if client == nil {
+		client = defaultWebhookClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s: status %d", n.URL, resp.StatusCode)
+	}
+	return nil
+}
+
+// redactAlert returns a copy of a whose events' request and response
+// bodies have their sensitive values redacted.
+func redactAlert(a *Alert) *Alert {
+	out := *a
+	out.Events = make([]cloudtrail.Event, len(a.Events))
+	for i, e := range a.Events {
+		e.Request = string(secret.RedactJSON([]byte(e.Request)))
+		e.Response = string(secret.RedactJSON([]byte(e.Response)))
+		out.Events[i] = e
+	}
+	return &out
+}
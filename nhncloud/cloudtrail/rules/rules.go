@@ -0,0 +1,272 @@
+// Package rules raises alerts from CloudTrail events with declarative
+// rules, without a separate SIEM.
+//
+// A rule holds when all its conditions match an event's fields. Fields are
+// named as in cloudtrail.Event's JSON: "eventId", "eventType", "memberId",
+// "sourceIp", "request", and "resources.resourceId" and the like, which
+// match if any resource does. A rule with a threshold alerts when that
+// many matching events, grouped by GroupBy, fall within its window:
+//
+//	[
+//	  {"name": "sg-open-to-world", "severity": "high",
+//	   "when": [{"field": "eventId", "contains": "security-group-rule"},
+//	            {"field": "request", "contains": "0.0.0.0/0"}]},
+//	  {"name": "access-key-created", "severity": "medium",
+//	   "when": [{"field": "eventId", "matches": "(?i)access.?key.*create"}]},
+//	  {"name": "signin-failures", "severity": "high",
+//	   "when": [{"field": "eventType", "equals": "SIGNIN"},
+//	            {"field": "response", "contains": "fail"}],
+//	   "threshold": 5, "window": "10m", "group_by": ["memberId"]}
+//	]
+//
+// Event IDs vary by service; check the events CloudTrail records for the
+// actions of interest before relying on a rule. An Engine evaluates the
+// rules over the follow-mode stream or an exported JSON Lines file and
+// sends alerts to its notifiers:
+//
+//	rs, err := rules.LoadRules("rules.json")
+//	engine, err := rules.NewEngine(rs, rules.NewTextNotifier(os.Stderr))
+//	err = engine.Run(ctx, client.CloudTrail().FollowEvents(ctx, cloudtrail.FollowOptions{}))
+package rules
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cloudtrail"
+)
+
+// Rule is a declarative alert condition.
+type Rule struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Severity is passed through to alerts, such as "high".
+	Severity string `json:"severity,omitempty"`
+	// When lists the conditions an event must all meet.
+	When []Condition `json:"when"`
+	// Threshold is the number of matching events that raise an alert.
+	// Zero means 1: every matching event alerts.
+	Threshold int `json:"threshold,omitempty"`
+	// Window is the span, in event time, the threshold is counted over.
+	// It is required with a threshold above 1.
+	Window Duration `json:"window,omitempty"`
+	// GroupBy names fields whose values count separately, such as
+	// "memberId" to count failed sign-ins per member.
+	GroupBy []string `json:"group_by,omitempty"`
+}
+
+// Condition tests one field. Every operator set must hold; Not inverts the
+// result. A multi-valued field, such as "resources.resourceId", meets the
+// condition if any value does.
+type Condition struct {
+	Field    string   `json:"field"`
+	Equals   string   `json:"equals,omitempty"`
+	In       []string `json:"in,omitempty"`
+	Prefix   string   `json:"prefix,omitempty"`
+	Contains string   `json:"contains,omitempty"`
+	// Matches is a regular expression.
+	Matches string `json:"matches,omitempty"`
+	// CIDR tests an IP address field, such as "sourceIp".
+	CIDR string `json:"cidr,omitempty"`
+	Not  bool   `json:"not,omitempty"`
+
+	re  *regexp.Regexp
+	net *net.IPNet
+}
+
+// Duration is a time.Duration written as a string such as "10m".
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"10m\": %w", err)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// fields extract the values of the fields conditions can test.
+var fields = map[string]func(e *cloudtrail.Event) []string{
+	"eventId":         func(e *cloudtrail.Event) []string { return []string{e.EventID} },
+	"eventType":       func(e *cloudtrail.Event) []string { return []string{e.EventType} },
+	"eventSourceType": func(e *cloudtrail.Event) []string { return []string{e.EventSourceType} },
+	"memberType":      func(e *cloudtrail.Event) []string { return []string{e.MemberType} },
+	"memberId":        func(e *cloudtrail.Event) []string { return []string{e.MemberID} },
+	"sourceIp":        func(e *cloudtrail.Event) []string { return []string{e.SourceIP} },
+	"userAgent":       func(e *cloudtrail.Event) []string { return []string{e.UserAgent} },
+	"orgId":           func(e *cloudtrail.Event) []string { return []string{e.OrgID} },
+	"projectId":       func(e *cloudtrail.Event) []string { return []string{e.ProjectID} },
+	"productId":       func(e *cloudtrail.Event) []string { return []string{e.ProductID} },
+	"region":          func(e *cloudtrail.Event) []string { return []string{e.Region} },
+	"requestId":       func(e *cloudtrail.Event) []string { return []string{e.RequestID} },
+	"request":         func(e *cloudtrail.Event) []string { return []string{e.Request} },
+	"response":        func(e *cloudtrail.Event) []string { return []string{e.Response} },
+	"resources.resourceType": func(e *cloudtrail.Event) []string {
+		return resourceValues(e, func(r cloudtrail.Resource) string { return r.ResourceType })
+	},
+	"resources.resourceId": func(e *cloudtrail.Event) []string {
+		return resourceValues(e, func(r cloudtrail.Resource) string { return r.ResourceID })
+	},
+	"resources.resourceName": func(e *cloudtrail.Event) []string {
+		return resourceValues(e, func(r cloudtrail.Resource) string { return r.ResourceName })
+	},
+}
+
+func resourceValues(e *cloudtrail.Event, get func(cloudtrail.Resource) string) []string {
+	values := make([]string, len(e.Resources))
+	for i, r := range e.Resources {
+		values[i] = get(r)
+	}
+	return values
+}
+
+// ParseRules decodes a JSON array of rules and checks them.
+func ParseRules(data []byte) ([]Rule, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var rs []Rule
+	if err := dec.Decode(&rs); err != nil {
+		return nil, fmt.Errorf("rules: %w", err)
+	}
+	for i := range rs {
+		if err := rs[i].compile(); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+// LoadRules reads a rules file.
+func LoadRules(path string) ([]Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRules(data)
+}
+
+// compile checks the rule and prepares its conditions.
+func (r *Rule) compile() error {
+	if r.Name == "" {
+		return fmt.Errorf("rules: rule without a name")
+	}
+	if len(r.When) == 0 {
+		return fmt.Errorf("rules: %s: no conditions", r.Name)
+	}
+	if r.Threshold > 1 && r.Window <= 0 {
+		return fmt.Errorf("rules: %s: a threshold needs a window", r.Name)
+	}
+	for _, f := range r.GroupBy {
+		if _, ok := fields[f]; !ok {
+			return fmt.Errorf("rules: %s: unknown group_by field %q", r.Name, f)
+		}
+	}
+	for i := range r.When {
+		if err := r.When[i].compile(); err != nil {
+			return fmt.Errorf("rules: %s: condition %d: %w", r.Name, i+1, err)
+		}
+	}
+	return nil
+}
+
+func (c *Condition) compile() error {
+	if _, ok := fields[c.Field]; !ok {
+		return fmt.Errorf("unknown field %q", c.Field)
+	}
+	if c.Equals == "" && c.In == nil && c.Prefix == "" && c.Contains == "" && c.Matches == "" && c.CIDR == "" {
+		return fmt.Errorf("no operator for %s", c.Field)
+	}
+	if c.Matches != "" {
+		re, err := regexp.Compile(c.Matches)
+		if err != nil {
+			return err
+		}
+		c.re = re
+	}
+	if c.CIDR != "" {
+		_, n, err := net.ParseCIDR(c.CIDR)
+		if err != nil {
+			return err
+		}
+		c.net = n
+	}
+	return nil
+}
+
+// match reports whether e meets the condition.
+func (c *Condition) match(e *cloudtrail.Event) bool {
+	for _, v := range fields[c.Field](e) {
+		if c.matchValue(v) {
+			return !c.Not
+		}
+	}
+	return c.Not
+}
+
+func (c *Condition) matchValue(v string) bool {
+	if c.Equals != "" && v != c.Equals {
+		return false
+	}
+	if c.In != nil && !contains(c.In, v) {
+		return false
+	}
+	if c.Prefix != "" && !strings.HasPrefix(v, c.Prefix) {
+		return false
+	}
+	if c.Contains != "" && !strings.Contains(v, c.Contains) {
+		return false
+	}
+	if c.re != nil && !c.re.MatchString(v) {
+		return false
+	}
+	if c.net != nil {
+		ip := net.ParseIP(v)
+		if ip == nil || !c.net.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// match reports whether e meets all of the rule's conditions.
+func (r *Rule) match(e *cloudtrail.Event) bool {
+	for i := range r.When {
+		if !r.When[i].match(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// group returns the key e counts under.
+func (r *Rule) group(e *cloudtrail.Event) string {
+	parts := make([]string, len(r.GroupBy))
+	for i, f := range r.GroupBy {
+		parts[i] = strings.Join(fields[f](e), ",")
+	}
+	return strings.Join(parts, "|")
+}
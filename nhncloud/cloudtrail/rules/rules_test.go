@@ -0,0 +1,174 @@
+package rules
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cloudtrail"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
+const testRules = `[
+  {"name": "sg-open-to-world", "severity": "high",
+   "when": [{"field": "eventId", "contains": "security-group-rule"},
+            {"field": "request", "contains": "0.0.0.0/0"}]},
+  {"name": "outside-office",
+   "when": [{"field": "eventType", "equals": "API"},
+            {"field": "sourceIp", "cidr": "10.0.0.0/8", "not": true},
+            {"field": "resources.resourceType", "in": ["AccessKey", "DeletionProtection"]}]},
+  {"name": "signin-failures", "severity": "medium",
+   "when": [{"field": "eventType", "equals": "SIGNIN"},
+            {"field": "response", "matches": "(?i)fail"}],
+   "threshold": 3, "window": "10m", "group_by": ["memberId"]}
+]`
+
+var t0 = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func event(min int, typ, id, member, ip, request, response string, resources ...cloudtrail.Resource) cloudtrail.Event {
+	return cloudtrail.Event{
+		EventTime: timestamp.New(t0.Add(time.Duration(min) * time.Minute)),
+		EventType: typ, EventID: id, MemberID: member, SourceIP: ip,
+		Request: request, Response: response, Resources: resources,
+	}
+}
+
+func TestEngine(t *testing.T) {
+	rs, err := ParseRules([]byte(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var text bytes.Buffer
+	engine, err := NewEngine(rs, NewTextNotifier(&text))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := []cloudtrail.Event{
+		event(0, "API", "network.security-group-rule.create", "alice", "10.1.2.3", `{"remote_ip_prefix":"0.0.0.0/0"}`, ""),
+		event(1, "API", "network.security-group-rule.create", "alice", "10.1.2.3", `{"remote_ip_prefix":"10.0.0.0/8"}`, ""),
+		event(2, "API", "iam.access-key.create", "bob", "203.0.113.9", "", "", cloudtrail.Resource{ResourceType: "AccessKey"}),
+		event(3, "API", "iam.access-key.create", "bob", "10.9.9.9", "", "", cloudtrail.Resource{ResourceType: "AccessKey"}),
+		// Two failures for carol, then a third outside the window.
+		event(4, "SIGNIN", "signin", "carol", "", "", "FAILED"),
+		event(5, "SIGNIN", "signin", "carol", "", "", "FAILED"),
+		event(16, "SIGNIN", "signin", "carol", "", "", "FAILED"),
+		// Dave's three failures alert once; the fourth starts a new count.
+		event(20, "SIGNIN", "signin", "dave", "", "", "Failed"),
+		event(21, "SIGNIN", "signin", "dave", "", "", "failed"),
+		event(21, "SIGNIN", "signin", "carol", "", "", "success"),
+		event(22, "SIGNIN", "signin", "dave", "", "", "failed"),
+		event(23, "SIGNIN", "signin", "dave", "", "", "failed"),
+	}
+	var jsonl bytes.Buffer
+	sink := cloudtrail.NewJSONLinesSink(&jsonl)
+	for i := range events {
+		sink.WriteEvent(&events[i])
+	}
+	sink.Flush()
+	if err := engine.ReadJSONL(context.Background(), &jsonl); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"HIGH 2024-06-01T12:00:00Z [sg-open-to-world] network.security-group-rule.create by alice from 10.1.2.3",
+		"2024-06-01T12:02:00Z [outside-office] iam.access-key.create by bob from 203.0.113.9",
+		"MEDIUM 2024-06-01T12:22:00Z [signin-failures] signin by dave (3 events)",
+	}
+	if got := strings.Split(strings.TrimSpace(text.String()), "\n"); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("alerts:\n%s\nwant:\n%s", text.String(), strings.Join(want, "\n"))
+	}
+
+	for _, bad := range []string{
+		`[{"name": "x", "when": []}]`,
+		`[{"name": "x", "when": [{"field": "nope", "equals": "a"}]}]`,
+		`[{"name": "x", "when": [{"field": "eventId"}]}]`,
+		`[{"name": "x", "when": [{"field": "eventId", "matches": "("}]}]`,
+		`[{"name": "x", "when": [{"field": "eventId", "equals": "a"}], "threshold": 2}]`,
+		`[{"name": "x", "when": [{"field": "eventId", "equals": "a"}], "window": 10}]`,
+	} {
+		if _, err := ParseRules([]byte(bad)); err == nil {
+			t.Errorf("expected an error for %s", bad)
+		}
+	}
+}
+
+func TestInWindowOutOfOrder(t *testing.T) {
+	events := []cloudtrail.Event{event(10, "", "a", "", "", "", ""), event(2, "", "b", "", "", "", ""), event(9, "", "c", "", "", "", "")}
+	got := inWindow(events, t0.Add(9*time.Minute), 5*time.Minute)
+	if len(got) != 2 || got[0].EventID != "c" || got[1].EventID != "a" {
+		t.Errorf("inWindow = %+v", got)
+	}
+}
+
+func TestWebhookNotifierAndRun(t *testing.T) {
+	var got []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer x" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		got = append(got, body)
+	}))
+	defer srv.Close()
+
+	rs, err := ParseRules([]byte(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hook := &WebhookNotifier{URL: srv.URL, Header: http.Header{"Authorization": {"Bearer x"}}}
+	engine, err := NewEngine(rs, hook)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch := make(chan cloudtrail.Event, 2)
+	ch <- event(0, "API", "network.security-group-rule.create", "alice", "10.1.2.3", `{"cidr":"0.0.0.0/0","password":"hunter2"}`, "")
+	close(ch)
+	if err := engine.Run(context.Background(), ch); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0]["rule"] != "sg-open-to-world" || got[0]["count"] != 1.0 || got[0]["events"] != nil {
+		t.Errorf("webhook got %+v", got)
+	}
+
+	// With Events the alert goes whole, minus the secrets in its bodies.
+	hook.Events = true
+	ch = make(chan cloudtrail.Event, 1)
+	ch <- event(0, "API", "network.security-group-rule.create", "alice", "10.1.2.3", `{"cidr":"0.0.0.0/0","password":"hunter2"}`, "")
+	close(ch)
+	if err := engine.Run(context.Background(), ch); err != nil {
+		t.Fatal(err)
+	}
+	if events, _ := got[1]["events"].([]interface{}); len(events) != 1 {
+		t.Fatalf("webhook got %+v", got[1])
+	} else if req := events[0].(map[string]interface{})["request"].(string); strings.Contains(req, "hunter2") || !strings.Contains(req, "0.0.0.0/0") {
+		t.Errorf("request = %s", req)
+	}
+	hook.Events = false
+
+	// A failing notifier stops Run unless OnError takes the error.
+	hook.Header = nil
+	ch = make(chan cloudtrail.Event, 1)
+	ch <- event(0, "API", "network.security-group-rule.create", "alice", "10.1.2.3", "0.0.0.0/0", "")
+	if err := engine.Run(context.Background(), ch); err == nil || !strings.Contains(err.Error(), "status 401") {
+		t.Errorf("Run = %v", err)
+	}
+	var errs []error
+	engine.OnError = func(err error) { errs = append(errs, err) }
+	ch <- event(1, "API", "network.security-group-rule.create", "alice", "10.1.2.3", "0.0.0.0/0", "")
+	close(ch)
+	if err := engine.Run(context.Background(), ch); err != nil || len(errs) != 1 {
+		t.Errorf("Run = %v with errors %v", err, errs)
+	}
+
+	if _, err := NewEngine(append(rs, rs[0])); err == nil {
+		t.Error("expected an error for a duplicate rule")
+	}
+}
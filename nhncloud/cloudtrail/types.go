@@ -1,7 +1,11 @@
 // Package cloudtrail provides CloudTrail service types and client
 package cloudtrail
 
-import "time"
+import (
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
 
 // Header represents the response header
 type Header struct {
@@ -38,22 +42,22 @@ type SearchEventsResult struct {
 
 // Event represents a CloudTrail event
 type Event struct {
-	EventTime       time.Time  `json:"eventTime"`
-	EventSourceType string     `json:"eventSourceType"` // CONSOLE, API
-	EventType       string     `json:"eventType"`       // API, SIGNIN, SIGNOUT, etc
-	MemberType      string     `json:"memberType"`      // TOAST, IAM
-	MemberID        string     `json:"memberId"`
-	EventID         string     `json:"eventId"`
-	SourceIP        string     `json:"sourceIp"`
-	UserAgent       string     `json:"userAgent"`
-	OrgID           string     `json:"orgId"`
-	ProjectID       string     `json:"projectId"`
-	ProductID       string     `json:"productId"`
-	Region          string     `json:"region"`
-	Resources       []Resource `json:"resources,omitempty"`
-	RequestID       string     `json:"requestId,omitempty"`
-	Request         string     `json:"request,omitempty"`
-	Response        string     `json:"response,omitempty"`
+	EventTime       timestamp.Time `json:"eventTime"`
+	EventSourceType string         `json:"eventSourceType"` // CONSOLE, API
+	EventType       string         `json:"eventType"`       // API, SIGNIN, SIGNOUT, etc
+	MemberType      string         `json:"memberType"`      // TOAST, IAM
+	MemberID        string         `json:"memberId"`
+	EventID         string         `json:"eventId"`
+	SourceIP        string         `json:"sourceIp"`
+	UserAgent       string         `json:"userAgent"`
+	OrgID           string         `json:"orgId"`
+	ProjectID       string         `json:"projectId"`
+	ProductID       string         `json:"productId"`
+	Region          string         `json:"region"`
+	Resources       []Resource     `json:"resources,omitempty"`
+	RequestID       string         `json:"requestId,omitempty"`
+	Request         string         `json:"request,omitempty"`
+	Response        string         `json:"response,omitempty"`
 }
 
 // Resource represents a resource affected by an event
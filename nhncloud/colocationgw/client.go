@@ -8,6 +8,7 @@ import (
 
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
 )
 
 // Client represents a Colocation Gateway API client
@@ -17,14 +18,21 @@ type Client struct {
 	httpClient    *client.Client
 	tokenProvider *client.IdentityTokenProvider
 	debug         bool
+	options       []client.ClientOption
 }
 
 // NewClient creates a new Colocation Gateway client
-func NewClient(region string, creds credentials.IdentityCredentials, hc *http.Client, debug bool) *Client {
+func NewClient(region string, creds credentials.IdentityCredentials, hc *http.Client, debug bool, opts ...option.Option) *Client {
+	settings := option.Apply(opts...)
+	if settings.HTTPClient == nil {
+		settings.HTTPClient = hc
+	}
+
 	c := &Client{
 		region:      region,
 		credentials: creds,
 		debug:       debug,
+		options:     client.Options(settings, "colocation-gateway"),
 	}
 
 	if creds != nil {
@@ -33,6 +41,7 @@ func NewClient(region string, creds credentials.IdentityCredentials, hc *http.Cl
 			creds.GetUsername(),
 			creds.GetPassword(),
 		)
+		c.tokenProvider.SetHTTPClient(settings.HTTPClient)
 	}
 
 	return c
@@ -55,6 +64,7 @@ func (c *Client) ensureClient(ctx context.Context) error {
 	opts := []client.ClientOption{
 		client.WithDebug(c.debug),
 	}
+	opts = append(opts, c.options...)
 	c.httpClient = client.NewClient(baseURL, c.tokenProvider, opts...)
 
 	return nil
@@ -1,22 +1,24 @@
 // Package colocationgw provides Colocation Gateway service types and client
 package colocationgw
 
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
 // ColocationGateway represents a colocation gateway
 type ColocationGateway struct {
-	ID              string `json:"id"`
-	Name            string `json:"name"`
-	TenantID        string `json:"tenant_id"`
-	Description     string `json:"description,omitempty"`
-	Status          string `json:"status"`
-	RouterID        string `json:"router_id,omitempty"`
-	SubnetID        string `json:"subnet_id,omitempty"`
-	NetworkID       string `json:"network_id,omitempty"`
-	LocalIPAddress  string `json:"local_ip_address,omitempty"`
-	RemoteIPAddress string `json:"remote_ip_address,omitempty"`
-	VLANID          int    `json:"vlan_id,omitempty"`
-	ConnectionType  string `json:"connection_type,omitempty"`
-	CreatedAt       string `json:"created_at,omitempty"`
-	UpdatedAt       string `json:"updated_at,omitempty"`
+	ID              string         `json:"id"`
+	Name            string         `json:"name"`
+	TenantID        string         `json:"tenant_id"`
+	Description     string         `json:"description,omitempty"`
+	Status          string         `json:"status"`
+	RouterID        string         `json:"router_id,omitempty"`
+	SubnetID        string         `json:"subnet_id,omitempty"`
+	NetworkID       string         `json:"network_id,omitempty"`
+	LocalIPAddress  string         `json:"local_ip_address,omitempty"`
+	RemoteIPAddress string         `json:"remote_ip_address,omitempty"`
+	VLANID          int            `json:"vlan_id,omitempty"`
+	ConnectionType  string         `json:"connection_type,omitempty"`
+	CreatedAt       timestamp.Time `json:"created_at,omitempty"`
+	UpdatedAt       timestamp.Time `json:"updated_at,omitempty"`
 }
 
 // ListOutput represents the response from List operation
@@ -7,6 +7,8 @@ import (
 
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/endpoint"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
 )
 
 type Client struct {
@@ -15,13 +17,22 @@ type Client struct {
 	httpClient    *client.Client
 	tokenProvider *client.IdentityTokenProvider
 	debug         bool
+	extraOpts     []client.ClientOption
 }
 
-func NewClient(region string, creds credentials.IdentityCredentials, hc *http.Client, debug bool) *Client {
+// NewClient creates a client. Options (for example a shared catalog cache)
+// are applied to the underlying HTTP client after the defaults.
+func NewClient(region string, creds credentials.IdentityCredentials, hc *http.Client, debug bool, opts ...option.Option) *Client {
+	settings := option.Apply(opts...)
+	if settings.HTTPClient == nil {
+		settings.HTTPClient = hc
+	}
+
 	c := &Client{
 		region:      region,
 		credentials: creds,
 		debug:       debug,
+		extraOpts:   client.Options(settings, string(endpoint.ServiceCompute)),
 	}
 
 	if creds != nil {
@@ -30,6 +41,7 @@ func NewClient(region string, creds credentials.IdentityCredentials, hc *http.Cl
 			creds.GetUsername(),
 			creds.GetPassword(),
 		)
+		c.tokenProvider.SetHTTPClient(settings.HTTPClient)
 	}
 
 	return c
@@ -56,6 +68,7 @@ func (c *Client) ensureClient(ctx context.Context) error {
 	opts := []client.ClientOption{
 		client.WithDebug(c.debug),
 	}
+	opts = append(opts, c.extraOpts...)
 
 	c.httpClient = client.NewClient(baseURL, c.tokenProvider, opts...)
 	return nil
@@ -3,6 +3,8 @@ package compute
 import (
 	"context"
 	"fmt"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cache"
 )
 
 func (c *Client) ListFlavors(ctx context.Context) (*ListFlavorsOutput, error) {
@@ -11,7 +13,7 @@ func (c *Client) ListFlavors(ctx context.Context) (*ListFlavorsOutput, error) {
 	}
 
 	var out ListFlavorsOutput
-	if err := c.httpClient.GET(ctx, "/flavors/detail", &out); err != nil {
+	if err := c.httpClient.CachedGET(ctx, cache.OpListFlavors, "/flavors/detail", &out); err != nil {
 		return nil, fmt.Errorf("list flavors: %w", err)
 	}
 	return &out, nil
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/url"
 	"sort"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cache"
 )
 
 func (c *Client) ListImages(ctx context.Context) (*ListImagesOutput, error) {
@@ -38,7 +40,7 @@ func (c *Client) ListImagesWithFilter(ctx context.Context, params map[string]str
 	}
 
 	var out ListImagesOutput
-	if err := c.httpClient.GET(ctx, endpoint, &out); err != nil {
+	if err := c.httpClient.CachedGET(ctx, cache.OpListImages, endpoint, &out); err != nil {
 		return nil, fmt.Errorf("list images: %w", err)
 	}
 	return &out, nil
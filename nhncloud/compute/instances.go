@@ -120,3 +120,16 @@ func (c *Client) ConfirmResize(ctx context.Context, serverID string) error {
 	}
 	return nil
 }
+
+// DetachInterface detaches the port from the server. Detaching is
+// asynchronous: the port's device ID clears once it is done.
+func (c *Client) DetachInterface(ctx context.Context, serverID, portID string) error {
+	if err := c.ensureClient(ctx); err != nil {
+		return err
+	}
+
+	if err := c.httpClient.DELETE(ctx, "/servers/"+serverID+"/os-interface/"+portID, nil); err != nil {
+		return fmt.Errorf("detach port %s from server %s: %w", portID, serverID, err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/waiter"
+)
+
+// ServerStatus is a server's status. Values the SDK does not know, and
+// UNKNOWN itself, are kept verbatim and report false from every helper.
+type ServerStatus string
+
+const (
+	ServerStatusActive           ServerStatus = "ACTIVE"
+	ServerStatusBuild            ServerStatus = "BUILD"
+	ServerStatusDeleted          ServerStatus = "DELETED"
+	ServerStatusError            ServerStatus = "ERROR"
+	ServerStatusHardReboot       ServerStatus = "HARD_REBOOT"
+	ServerStatusMigrating        ServerStatus = "MIGRATING"
+	ServerStatusPassword         ServerStatus = "PASSWORD"
+	ServerStatusPaused           ServerStatus = "PAUSED"
+	ServerStatusReboot           ServerStatus = "REBOOT"
+	ServerStatusRebuild          ServerStatus = "REBUILD"
+	ServerStatusRescue           ServerStatus = "RESCUE"
+	ServerStatusResize           ServerStatus = "RESIZE"
+	ServerStatusRevertResize     ServerStatus = "REVERT_RESIZE"
+	ServerStatusShelved          ServerStatus = "SHELVED"
+	ServerStatusShelvedOffloaded ServerStatus = "SHELVED_OFFLOADED"
+	ServerStatusShutoff          ServerStatus = "SHUTOFF"
+	ServerStatusSoftDeleted      ServerStatus = "SOFT_DELETED"
+	ServerStatusSuspended        ServerStatus = "SUSPENDED"
+	ServerStatusUnknown          ServerStatus = "UNKNOWN"
+	ServerStatusVerifyResize     ServerStatus = "VERIFY_RESIZE"
+)
+
+// IsTerminal reports whether the server has settled. VERIFY_RESIZE is
+// settled: it waits for the resize to be confirmed or reverted.
+func (s ServerStatus) IsTerminal() bool {
+	switch s {
+	case ServerStatusActive, ServerStatusDeleted, ServerStatusError, ServerStatusPaused, ServerStatusRescue,
+		ServerStatusShelved, ServerStatusShelvedOffloaded, ServerStatusShutoff, ServerStatusSoftDeleted,
+		ServerStatusSuspended, ServerStatusVerifyResize:
+		return true
+	}
+	return false
+}
+
+// IsFailed reports whether the server is in an error state.
+func (s ServerStatus) IsFailed() bool { return s == ServerStatusError }
+
+// IsTransitioning reports whether a server task is running.
+func (s ServerStatus) IsTransitioning() bool {
+	switch s {
+	case ServerStatusBuild, ServerStatusHardReboot, ServerStatusMigrating, ServerStatusPassword,
+		ServerStatusReboot, ServerStatusRebuild, ServerStatusResize, ServerStatusRevertResize:
+		return true
+	}
+	return false
+}
+
+// WaitForServer polls GetServer every interval (10s when zero) until the
+// server is in want. want must be a settled status such as
+// ServerStatusActive; waiting for ServerStatusDeleted ends with a nil output
+// once the server is gone. ERROR ends the wait with
+// *errors.FailedStateError. Bound the wait with a context deadline.
+func (c *Client) WaitForServer(ctx context.Context, serverID string, want ServerStatus, interval time.Duration) (*GetServerOutput, error) {
+	if !want.IsTerminal() || want.IsFailed() {
+		return nil, &errors.ValidationError{Field: "want", Reason: fmt.Sprintf("%q is not a settled server status", want)}
+	}
+
+	var last *GetServerOutput
+	err := waiter.Poll(ctx, interval, func(ctx context.Context) (bool, error) {
+		out, err := c.GetServer(ctx, serverID)
+		if err != nil {
+			if want == ServerStatusDeleted && waiter.IsNotFound(err) {
+				last = nil
+				return true, nil
+			}
+			return false, err
+		}
+		last = out
+		switch s := out.Server.Status; {
+		case s == want:
+			return true, nil
+		case s.IsFailed():
+			return false, &errors.FailedStateError{Resource: "server", ID: serverID, Status: string(s)}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return last, fmt.Errorf("wait for server %s to be %s: %w", serverID, want, err)
+	}
+	return last, nil
+}
@@ -1,9 +1,14 @@
 package compute
 
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
 type Server struct {
 	ID               string               `json:"id"`
 	Name             string               `json:"name"`
-	Status           string               `json:"status"`
+	Status           ServerStatus         `json:"status"`
 	TenantID         string               `json:"tenant_id"`
 	UserID           string               `json:"user_id"`
 	KeyName          string               `json:"key_name,omitempty"`
@@ -18,8 +23,8 @@ type Server struct {
 	TaskState        string               `json:"OS-EXT-STS:task_state"`
 	VMState          string               `json:"OS-EXT-STS:vm_state"`
 	Protect          bool                 `json:"NHN-EXT-ATTR:protect"`
-	Created          string               `json:"created"`
-	Updated          string               `json:"updated,omitempty"`
+	Created          timestamp.Time       `json:"created"`
+	Updated          timestamp.Time       `json:"updated,omitempty"`
 	Addresses        map[string][]Address `json:"addresses,omitempty"`
 	Metadata         map[string]string    `json:"metadata,omitempty"`
 	SecurityGroups   []SecurityGroup      `json:"security_groups,omitempty"`
@@ -44,13 +49,13 @@ type Flavor struct {
 }
 
 type Image struct {
-	ID      string `json:"id"`
-	Name    string `json:"name"`
-	Status  string `json:"status"`
-	MinDisk int    `json:"minDisk"`
-	MinRAM  int    `json:"minRam"`
-	Created string `json:"created"`
-	Updated string `json:"updated,omitempty"`
+	ID      string         `json:"id"`
+	Name    string         `json:"name"`
+	Status  string         `json:"status"`
+	MinDisk int            `json:"minDisk"`
+	MinRAM  int            `json:"minRam"`
+	Created timestamp.Time `json:"created"`
+	Updated timestamp.Time `json:"updated,omitempty"`
 }
 
 type KeyPair struct {
@@ -124,7 +129,7 @@ type CreateKeyPairOutput struct {
 	KeyPair struct {
 		Name        string `json:"name"`
 		PublicKey   string `json:"public_key"`
-		PrivateKey  string `json:"private_key,omitempty"`
+		PrivateKey  secret.String `json:"private_key,omitempty"`
 		Fingerprint string `json:"fingerprint"`
 	} `json:"keypair"`
 }
@@ -2,9 +2,14 @@ package nhncloud
 
 import (
 	"net/http"
+	"time"
 
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cache"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/circuitbreaker"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/capture"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
 )
 
 type Config struct {
@@ -15,9 +20,30 @@ type Config struct {
 
 	AppKeys map[string]string
 
+	// APIVersions selects the API version per service, keyed like AppKeys
+	// ("rds-mysql": "v4.0"). Services without an entry use their default.
+	APIVersions map[string]string
+
+	// HTTPClient sends every request of every service, including token
+	// requests. Without it requests time out after 30s, except Object
+	// Storage transfers, which are bounded only by their context.
 	HTTPClient *http.Client
 	Debug      bool
 	UserAgent  string
+
+	// CircuitBreaker, if set, is shared by every client built from this
+	// config and short-circuits requests to endpoint hosts that keep failing.
+	CircuitBreaker *circuitbreaker.Breaker
+
+	// Cache, if set, serves catalog lookups (flavors, versions, storage
+	// types, images, supported NKS versions) from memory.
+	Cache *cache.Cache
+
+	// Drift, if set, reports response fields that the SDK's types do not
+	// map, for every client the accessors build. Without it,
+	// NHN_SDK_STRICT_DECODE selects a default detector. See package drift
+	// for the responses that are not checked.
+	Drift *drift.Detector
 }
 
 func (c *Config) validate() error {
@@ -39,9 +65,32 @@ func (c *Config) httpClient() *http.Client {
 	}
 	// Use a fresh client (not http.DefaultClient) so we don't mutate the
 	// global default transport for unrelated programs.
+	return &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: capture.NewTransport(http.DefaultTransport),
+	}
+}
+
+// transferHTTPClient is httpClient without the default timeout, for Object
+// Storage uploads and downloads that may run for much longer.
+func (c *Config) transferHTTPClient() *http.Client {
+	if c.HTTPClient != nil {
+		return capture.WrapClient(c.HTTPClient)
+	}
 	return &http.Client{Transport: capture.NewTransport(http.DefaultTransport)}
 }
 
+// options returns the settings every service client built from this config
+// shares.
+func (c *Config) options() []option.Option {
+	return []option.Option{
+		option.WithHTTPClient(c.httpClient()),
+		option.WithCircuitBreaker(c.CircuitBreaker),
+		option.WithCache(c.Cache),
+		option.WithDrift(c.Drift),
+	}
+}
+
 // UserAgentString returns the user agent string for HTTP requests.
 func (c *Config) UserAgentString() string {
 	if c.UserAgent != "" {
@@ -7,6 +7,8 @@ import (
 
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/endpoint"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
 )
 
 type Client struct {
@@ -16,14 +18,21 @@ type Client struct {
 	httpClient    *client.Client
 	tokenProvider *client.OAuthTokenProvider
 	debug         bool
+	options       []client.ClientOption
 }
 
-func NewClient(region, appKey string, creds credentials.Credentials, hc *http.Client, debug bool) *Client {
+func NewClient(region, appKey string, creds credentials.Credentials, hc *http.Client, debug bool, opts ...option.Option) *Client {
+	settings := option.Apply(opts...)
+	if settings.HTTPClient == nil {
+		settings.HTTPClient = hc
+	}
+
 	c := &Client{
 		region:      region,
 		appKey:      appKey,
 		credentials: creds,
 		debug:       debug,
+		options:     client.Options(settings, "ncr"),
 	}
 
 	if creds != nil {
@@ -31,6 +40,7 @@ func NewClient(region, appKey string, creds credentials.Credentials, hc *http.Cl
 			creds.GetAccessKeyID(),
 			creds.GetSecretAccessKey(),
 		)
+		c.tokenProvider.SetHTTPClient(settings.HTTPClient)
 		c.initHTTPClient()
 	}
 
@@ -38,10 +48,11 @@ func NewClient(region, appKey string, creds credentials.Credentials, hc *http.Cl
 }
 
 func (c *Client) initHTTPClient() {
-	baseURL := fmt.Sprintf("https://%s-ncr.api.nhncloudservice.com/ncr/v2.0/appkeys/%s", c.region, c.appKey)
+	baseURL := endpoint.ResolveWithAppKey(endpoint.ServiceNCR, c.region, c.appKey)
 	opts := []client.ClientOption{
 		client.WithDebug(c.debug),
 	}
+	opts = append(opts, c.options...)
 	c.httpClient = client.NewClient(baseURL, c.tokenProvider, opts...)
 }
 
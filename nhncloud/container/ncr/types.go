@@ -1,5 +1,7 @@
 package ncr
 
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
 type ResponseHeader struct {
 	IsSuccessful  bool   `json:"isSuccessful"`
 	ResultCode    int    `json:"resultCode"`
@@ -7,13 +9,13 @@ type ResponseHeader struct {
 }
 
 type Registry struct {
-	ID        int64  `json:"project_id"`
-	Name      string `json:"name"`
-	URI       string `json:"uri"`
-	IsPublic  bool   `json:"isPublic"`
-	Status    string `json:"status"`
-	CreatedAt string `json:"creation_time"`
-	UpdatedAt string `json:"update_time"`
+	ID        int64          `json:"project_id"`
+	Name      string         `json:"name"`
+	URI       string         `json:"uri"`
+	IsPublic  bool           `json:"isPublic"`
+	Status    string         `json:"status"`
+	CreatedAt timestamp.Time `json:"creation_time"`
+	UpdatedAt timestamp.Time `json:"update_time"`
 }
 
 type ListRegistriesOutput struct {
@@ -43,15 +45,15 @@ type UpdateRegistryInput struct {
 }
 
 type Image struct {
-	ID         string   `json:"id"`
-	Name       string   `json:"name"`
-	RegistryID string   `json:"registryId"`
-	PullCount  int64    `json:"pullCount"`
-	Tags       []string `json:"tags,omitempty"`
-	Digest     string   `json:"digest,omitempty"`
-	Size       int64    `json:"size"`
-	CreatedAt  string   `json:"createdAt"`
-	UpdatedAt  string   `json:"updatedAt"`
+	ID         string         `json:"id"`
+	Name       string         `json:"name"`
+	RegistryID string         `json:"registryId"`
+	PullCount  int64          `json:"pullCount"`
+	Tags       []string       `json:"tags,omitempty"`
+	Digest     string         `json:"digest,omitempty"`
+	Size       int64          `json:"size"`
+	CreatedAt  timestamp.Time `json:"createdAt"`
+	UpdatedAt  timestamp.Time `json:"updatedAt"`
 }
 
 type ListImagesOutput struct {
@@ -65,11 +67,11 @@ type GetImageOutput struct {
 }
 
 type Tag struct {
-	Name         string `json:"name"`
-	Digest       string `json:"digest"`
-	Size         int64  `json:"size"`
-	CreatedAt    string `json:"createdAt"`
-	LastPulledAt string `json:"lastPulledAt,omitempty"`
+	Name         string         `json:"name"`
+	Digest       string         `json:"digest"`
+	Size         int64          `json:"size"`
+	CreatedAt    timestamp.Time `json:"createdAt"`
+	LastPulledAt timestamp.Time `json:"lastPulledAt,omitempty"`
 }
 
 type ListTagsOutput struct {
@@ -83,8 +85,8 @@ type ImageScanResult struct {
 	Tag             string                `json:"tag"`
 	Digest          string                `json:"digest"`
 	Status          string                `json:"status"`
-	ScanStartedAt   string                `json:"scanStartedAt"`
-	ScanCompletedAt string                `json:"scanCompletedAt,omitempty"`
+	ScanStartedAt   timestamp.Time        `json:"scanStartedAt"`
+	ScanCompletedAt timestamp.Time        `json:"scanCompletedAt,omitempty"`
 	Vulnerabilities []Vulnerability       `json:"vulnerabilities,omitempty"`
 	Summary         *VulnerabilitySummary `json:"summary,omitempty"`
 }
@@ -114,14 +116,14 @@ type GetImageScanResultOutput struct {
 }
 
 type Webhook struct {
-	ID         string   `json:"id"`
-	Name       string   `json:"name"`
-	RegistryID string   `json:"registryId"`
-	TargetURL  string   `json:"targetUrl"`
-	Enabled    bool     `json:"enabled"`
-	Events     []string `json:"events"`
-	CreatedAt  string   `json:"createdAt"`
-	UpdatedAt  string   `json:"updatedAt"`
+	ID         string         `json:"id"`
+	Name       string         `json:"name"`
+	RegistryID string         `json:"registryId"`
+	TargetURL  string         `json:"targetUrl"`
+	Enabled    bool           `json:"enabled"`
+	Events     []string       `json:"events"`
+	CreatedAt  timestamp.Time `json:"createdAt"`
+	UpdatedAt  timestamp.Time `json:"updatedAt"`
 }
 
 type ListWebhooksOutput struct {
@@ -1,5 +1,7 @@
 package ncs
 
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
 type ResponseHeader struct {
 	IsSuccessful  bool   `json:"isSuccessful"`
 	ResultCode    int    `json:"resultCode"`
@@ -17,8 +19,8 @@ type Workload struct {
 	Status            string            `json:"status"`
 	Containers        []Container       `json:"containers,omitempty"`
 	Labels            map[string]string `json:"labels,omitempty"`
-	CreatedAt         string            `json:"createdAt"`
-	UpdatedAt         string            `json:"updatedAt"`
+	CreatedAt         timestamp.Time    `json:"createdAt"`
+	UpdatedAt         timestamp.Time    `json:"updatedAt"`
 }
 
 type Container struct {
@@ -119,15 +121,15 @@ type UpdateWorkloadInput struct {
 }
 
 type Template struct {
-	ID          string      `json:"id"`
-	Name        string      `json:"name"`
-	Description string      `json:"description,omitempty"`
-	Version     string      `json:"version"`
-	Type        string      `json:"type"`
-	IsPublic    bool        `json:"isPublic"`
-	Containers  []Container `json:"containers,omitempty"`
-	CreatedAt   string      `json:"createdAt"`
-	UpdatedAt   string      `json:"updatedAt"`
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Version     string         `json:"version"`
+	Type        string         `json:"type"`
+	IsPublic    bool           `json:"isPublic"`
+	Containers  []Container    `json:"containers,omitempty"`
+	CreatedAt   timestamp.Time `json:"createdAt"`
+	UpdatedAt   timestamp.Time `json:"updatedAt"`
 }
 
 type ListTemplatesOutput struct {
@@ -149,8 +151,8 @@ type Service struct {
 	ExternalIP string            `json:"externalIP,omitempty"`
 	Ports      []ServicePort     `json:"ports,omitempty"`
 	Selector   map[string]string `json:"selector,omitempty"`
-	CreatedAt  string            `json:"createdAt"`
-	UpdatedAt  string            `json:"updatedAt"`
+	CreatedAt  timestamp.Time    `json:"createdAt"`
+	UpdatedAt  timestamp.Time    `json:"updatedAt"`
 }
 
 type ServicePort struct {
@@ -185,9 +187,9 @@ type CreateServiceOutput struct {
 }
 
 type LogEntry struct {
-	Timestamp string `json:"timestamp"`
-	Message   string `json:"message"`
-	Stream    string `json:"stream"`
+	Timestamp timestamp.Time `json:"timestamp"`
+	Message   string         `json:"message"`
+	Stream    string         `json:"stream"`
 }
 
 type GetWorkloadLogsOutput struct {
@@ -244,16 +246,16 @@ type UpdateResourceLimitsInput struct {
 
 // Event types for workload events
 type Event struct {
-	EventID    string `json:"eventId"`
-	EventType  string `json:"eventType"` // Normal, Warning
-	Reason     string `json:"reason"`
-	Message    string `json:"message"`
-	Count      int    `json:"count"`
-	FirstTime  string `json:"firstTimestamp"`
-	LastTime   string `json:"lastTimestamp"`
-	Source     string `json:"source,omitempty"`
-	ObjectKind string `json:"objectKind,omitempty"`
-	ObjectName string `json:"objectName,omitempty"`
+	EventID    string         `json:"eventId"`
+	EventType  string         `json:"eventType"` // Normal, Warning
+	Reason     string         `json:"reason"`
+	Message    string         `json:"message"`
+	Count      int            `json:"count"`
+	FirstTime  timestamp.Time `json:"firstTimestamp"`
+	LastTime   timestamp.Time `json:"lastTimestamp"`
+	Source     string         `json:"source,omitempty"`
+	ObjectKind string         `json:"objectKind,omitempty"`
+	ObjectName string         `json:"objectName,omitempty"`
 }
 
 type GetWorkloadEventsOutput struct {
@@ -263,14 +265,14 @@ type GetWorkloadEventsOutput struct {
 
 // Volume types for persistent volume management
 type PersistentVolume struct {
-	VolumeID    string `json:"volumeId"`
-	Name        string `json:"name"`
-	Size        int    `json:"size"` // GB
-	Status      string `json:"status"`
-	VolumeType  string `json:"volumeType"`
-	StorageType string `json:"storageType,omitempty"`
-	CreatedAt   string `json:"createdAt"`
-	AttachedTo  string `json:"attachedTo,omitempty"`
+	VolumeID    string         `json:"volumeId"`
+	Name        string         `json:"name"`
+	Size        int            `json:"size"` // GB
+	Status      string         `json:"status"`
+	VolumeType  string         `json:"volumeType"`
+	StorageType string         `json:"storageType,omitempty"`
+	CreatedAt   timestamp.Time `json:"createdAt"`
+	AttachedTo  string         `json:"attachedTo,omitempty"`
 }
 
 type ListVolumesOutput struct {
@@ -303,22 +305,22 @@ type ExecOutput struct {
 	Output     string          `json:"output,omitempty"`
 	ExitCode   int             `json:"exitCode"`
 	Error      string          `json:"error,omitempty"`
-	StartedAt  string          `json:"startedAt"`
-	FinishedAt string          `json:"finishedAt,omitempty"`
+	StartedAt  timestamp.Time  `json:"startedAt"`
+	FinishedAt timestamp.Time  `json:"finishedAt,omitempty"`
 }
 
 type ContainerStatus struct {
-	ContainerName string `json:"containerName"`
-	State         string `json:"state"`
-	Ready         bool   `json:"ready"`
-	RestartCount  int    `json:"restartCount"`
-	Image         string `json:"image"`
-	ContainerID   string `json:"containerId,omitempty"`
-	StartedAt     string `json:"startedAt,omitempty"`
-	FinishedAt    string `json:"finishedAt,omitempty"`
-	ExitCode      int    `json:"exitCode,omitempty"`
-	Reason        string `json:"reason,omitempty"`
-	Message       string `json:"message,omitempty"`
+	ContainerName string         `json:"containerName"`
+	State         string         `json:"state"`
+	Ready         bool           `json:"ready"`
+	RestartCount  int            `json:"restartCount"`
+	Image         string         `json:"image"`
+	ContainerID   string         `json:"containerId,omitempty"`
+	StartedAt     timestamp.Time `json:"startedAt,omitempty"`
+	FinishedAt    timestamp.Time `json:"finishedAt,omitempty"`
+	ExitCode      int            `json:"exitCode,omitempty"`
+	Reason        string         `json:"reason,omitempty"`
+	Message       string         `json:"message,omitempty"`
 }
 
 type GetContainerStatusOutput struct {
@@ -345,7 +347,7 @@ type AutoScalingStatus struct {
 	CurrentReplicas int                `json:"currentReplicas"`
 	DesiredReplicas int                `json:"desiredReplicas"`
 	Policy          *AutoScalingPolicy `json:"policy,omitempty"`
-	LastScaleTime   string             `json:"lastScaleTime,omitempty"`
+	LastScaleTime   timestamp.Time     `json:"lastScaleTime,omitempty"`
 	Conditions      []struct {
 		Type    string `json:"type"`
 		Status  string `json:"status"`
@@ -373,8 +375,8 @@ type NetworkPolicy struct {
 	PolicyName  string              `json:"policyName"`
 	Description string              `json:"description,omitempty"`
 	Rules       []NetworkPolicyRule `json:"rules"`
-	CreatedAt   string              `json:"createdAt"`
-	UpdatedAt   string              `json:"updatedAt"`
+	CreatedAt   timestamp.Time      `json:"createdAt"`
+	UpdatedAt   timestamp.Time      `json:"updatedAt"`
 }
 
 type ListNetworkPoliciesOutput struct {
@@ -0,0 +1,119 @@
+package nks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/waiter"
+)
+
+// Status is the status of a cluster or node group, an <ACTION>_<STATE>
+// pair such as CREATE_COMPLETE. The helpers classify values by their
+// _IN_PROGRESS, _COMPLETE and _FAILED suffix, so actions the SDK does not
+// list are still recognized.
+type Status string
+
+const (
+	StatusCreateInProgress   Status = "CREATE_IN_PROGRESS"
+	StatusCreateComplete     Status = "CREATE_COMPLETE"
+	StatusCreateFailed       Status = "CREATE_FAILED"
+	StatusUpdateInProgress   Status = "UPDATE_IN_PROGRESS"
+	StatusUpdateComplete     Status = "UPDATE_COMPLETE"
+	StatusUpdateFailed       Status = "UPDATE_FAILED"
+	StatusDeleteInProgress   Status = "DELETE_IN_PROGRESS"
+	StatusDeleteComplete     Status = "DELETE_COMPLETE"
+	StatusDeleteFailed       Status = "DELETE_FAILED"
+	StatusResumeComplete     Status = "RESUME_COMPLETE"
+	StatusResumeFailed       Status = "RESUME_FAILED"
+	StatusRestoreComplete    Status = "RESTORE_COMPLETE"
+	StatusRollbackInProgress Status = "ROLLBACK_IN_PROGRESS"
+	StatusRollbackComplete   Status = "ROLLBACK_COMPLETE"
+	StatusRollbackFailed     Status = "ROLLBACK_FAILED"
+	StatusSnapshotComplete   Status = "SNAPSHOT_COMPLETE"
+	StatusCheckComplete      Status = "CHECK_COMPLETE"
+	StatusAdoptComplete      Status = "ADOPT_COMPLETE"
+)
+
+// IsTerminal reports whether the last action finished, successfully or not.
+func (s Status) IsTerminal() bool {
+	return strings.HasSuffix(string(s), "_COMPLETE") || s.IsFailed()
+}
+
+// IsFailed reports whether the last action failed.
+func (s Status) IsFailed() bool { return strings.HasSuffix(string(s), "_FAILED") }
+
+// IsTransitioning reports whether an action is running.
+func (s Status) IsTransitioning() bool { return strings.HasSuffix(string(s), "_IN_PROGRESS") }
+
+// WaitForCluster polls GetCluster every interval (10s when zero) until the
+// cluster is in want, which must be a _COMPLETE status. Waiting for
+// StatusDeleteComplete ends with a nil output once the cluster is gone. A
+// _FAILED status ends the wait with *errors.FailedStateError carrying the
+// cluster's status_reason. Bound the wait with a context deadline.
+func (c *Client) WaitForCluster(ctx context.Context, clusterID string, want Status, interval time.Duration) (*GetClusterOutput, error) {
+	var last *GetClusterOutput
+	gone, err := wait(ctx, "cluster", clusterID, want, interval, func(ctx context.Context) (Status, string, error) {
+		out, err := c.GetCluster(ctx, clusterID)
+		if err != nil {
+			return "", "", err
+		}
+		last = out
+		return out.Status, out.StatusReason, nil
+	})
+	if gone {
+		return nil, nil
+	}
+	return last, err
+}
+
+// WaitForNodeGroup is WaitForCluster for a node group.
+func (c *Client) WaitForNodeGroup(ctx context.Context, clusterID, nodeGroupID string, want Status, interval time.Duration) (*GetNodeGroupOutput, error) {
+	var last *GetNodeGroupOutput
+	gone, err := wait(ctx, "node group", nodeGroupID, want, interval, func(ctx context.Context) (Status, string, error) {
+		out, err := c.GetNodeGroup(ctx, clusterID, nodeGroupID)
+		if err != nil {
+			return "", "", err
+		}
+		last = out
+		return out.Status, "", nil
+	})
+	if gone {
+		return nil, nil
+	}
+	return last, err
+}
+
+// wait polls get until it reports want. gone is set when want is
+// StatusDeleteComplete and the resource no longer exists.
+func wait(ctx context.Context, resource, id string, want Status, interval time.Duration,
+	get func(context.Context) (Status, string, error)) (gone bool, err error) {
+	if !want.IsTerminal() || want.IsFailed() {
+		return false, &errors.ValidationError{Field: "want", Reason: fmt.Sprintf("%q is not a _COMPLETE %s status", want, resource)}
+	}
+	var last Status
+	err = waiter.Poll(ctx, interval, func(ctx context.Context) (bool, error) {
+		status, reason, err := get(ctx)
+		if err != nil {
+			if want == StatusDeleteComplete && waiter.IsNotFound(err) {
+				gone = true
+				return true, nil
+			}
+			return false, err
+		}
+		last = status
+		switch {
+		case status == want:
+			return true, nil
+		case status.IsFailed():
+			return false, &errors.FailedStateError{Resource: resource, ID: id, Status: string(status), Reason: reason}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("wait for %s %s to be %s (last %s): %w", resource, id, want, last, err)
+	}
+	return gone, nil
+}
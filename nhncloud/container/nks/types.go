@@ -1,10 +1,12 @@
 package nks
 
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
 type Cluster struct {
 	ID                string            `json:"uuid"`
 	Name              string            `json:"name"`
 	TenantID          string            `json:"tenant_id"`
-	Status            string            `json:"status"`
+	Status            Status            `json:"status"`
 	StatusReason      string            `json:"status_reason,omitempty"`
 	HealthStatus      string            `json:"health_status,omitempty"`
 	APIAddress        string            `json:"api_address"`
@@ -20,8 +22,8 @@ type Cluster struct {
 	NetworkID         string            `json:"fixed_network,omitempty"`
 	SubnetID          string            `json:"fixed_subnet,omitempty"`
 	Labels            map[string]string `json:"labels,omitempty"`
-	CreatedAt         string            `json:"created_at"`
-	UpdatedAt         string            `json:"updated_at"`
+	CreatedAt         timestamp.Time    `json:"created_at"`
+	UpdatedAt         timestamp.Time    `json:"updated_at"`
 }
 
 type ListClustersOutput struct {
@@ -55,18 +57,18 @@ type UpdateClusterInput struct {
 }
 
 type NodeGroup struct {
-	ID           string `json:"uuid"`
-	Name         string `json:"name"`
-	ClusterID    string `json:"cluster_id"`
-	NodeCount    int    `json:"node_count"`
-	MinNodeCount int    `json:"min_node_count"`
-	MaxNodeCount int    `json:"max_node_count"`
-	FlavorID     string `json:"flavor_id"`
-	ImageID      string `json:"image_id"`
-	Role         string `json:"role"`
-	Status       string `json:"status"`
-	CreatedAt    string `json:"created_at"`
-	UpdatedAt    string `json:"updated_at"`
+	ID           string         `json:"uuid"`
+	Name         string         `json:"name"`
+	ClusterID    string         `json:"cluster_id"`
+	NodeCount    int            `json:"node_count"`
+	MinNodeCount int            `json:"min_node_count"`
+	MaxNodeCount int            `json:"max_node_count"`
+	FlavorID     string         `json:"flavor_id"`
+	ImageID      string         `json:"image_id"`
+	Role         string         `json:"role"`
+	Status       Status         `json:"status"`
+	CreatedAt    timestamp.Time `json:"created_at"`
+	UpdatedAt    timestamp.Time `json:"updated_at"`
 }
 
 type ListNodeGroupsOutput struct {
@@ -114,8 +116,8 @@ type ClusterTemplate struct {
 	MasterFlavor        string            `json:"master_flavor_id"`
 	Flavor              string            `json:"flavor_id"`
 	Labels              map[string]string `json:"labels,omitempty"`
-	CreatedAt           string            `json:"created_at"`
-	UpdatedAt           string            `json:"updated_at"`
+	CreatedAt           timestamp.Time    `json:"created_at"`
+	UpdatedAt           timestamp.Time    `json:"updated_at"`
 }
 
 type ListClusterTemplatesOutput struct {
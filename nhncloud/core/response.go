@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 // ResponseHeader is the standard NHN Cloud API response header
@@ -22,6 +25,9 @@ type WithHeader interface {
 
 // ParseResponse parses HTTP response into typed result
 // This ensures complete response parsing - all fields must be in result struct
+//
+// Unmapped fields are reported by the detector NHN_SDK_STRICT_DECODE
+// selects; the clients built on ParseResponse take no drift.Detector.
 func ParseResponse(resp *http.Response, result interface{}) error {
 	defer resp.Body.Close()
 
@@ -36,15 +42,19 @@ func ParseResponse(resp *http.Response, result interface{}) error {
 		return &HTTPError{
 			StatusCode: resp.StatusCode,
 			Status:     resp.Status,
-			Body:       string(body),
+			Body:       string(secret.RedactJSON(body)),
 		}
 	}
 
+	if err := drift.FromEnv().Check("", "", body, result); err != nil {
+		return err
+	}
+
 	// Unmarshal to result structure
 	if err := json.Unmarshal(body, result); err != nil {
 		return &ParseError{
 			StatusCode: resp.StatusCode,
-			Body:       string(body),
+			Body:       string(secret.RedactJSON(body)),
 			Err:        err,
 		}
 	}
@@ -14,3 +14,12 @@ type IdentityCredentials interface {
 	GetPassword() string
 	GetTenantID() string
 }
+
+// UserAccessKey returns the User Access Key ID and secret in creds, or two
+// empty strings when creds is nil.
+func UserAccessKey(creds Credentials) (accessKeyID, secretAccessKey string) {
+	if creds == nil {
+		return "", ""
+	}
+	return creds.GetAccessKeyID(), creds.GetSecretAccessKey()
+}
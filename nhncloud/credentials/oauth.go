@@ -10,6 +10,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 const (
@@ -18,10 +20,10 @@ const (
 )
 
 type Token struct {
-	AccessToken string    `json:"access_token"`
-	TokenType   string    `json:"token_type"`
-	ExpiresIn   int       `json:"expires_in"`
-	IssuedAt    time.Time `json:"issued_at"`
+	AccessToken secret.String `json:"access_token"`
+	TokenType   string        `json:"token_type"`
+	ExpiresIn   int           `json:"expires_in"`
+	IssuedAt    time.Time     `json:"issued_at"`
 }
 
 func (t *Token) IsExpired() bool {
@@ -54,6 +56,14 @@ func NewTokenProvider(accessKeyID, secretAccessKey string) *TokenProvider {
 	}
 }
 
+// SetHTTPClient sends token requests with hc. A nil hc keeps the default
+// client, which times out after 30s.
+func (p *TokenProvider) SetHTTPClient(hc *http.Client) {
+	if hc != nil {
+		p.httpClient = hc
+	}
+}
+
 func (p *TokenProvider) GetToken() (*Token, error) {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
@@ -76,7 +86,7 @@ func (p *TokenProvider) GetBearerToken() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return token.AccessToken, nil
+	return token.AccessToken.Reveal(), nil
 }
 
 func (p *TokenProvider) fetchNewToken() (*Token, error) {
@@ -105,13 +115,13 @@ func (p *TokenProvider) fetchNewToken() (*Token, error) {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, secret.RedactJSON(body))
 	}
 
 	var tokenResponse struct {
-		AccessToken string `json:"access_token"`
-		TokenType   string `json:"token_type"`
-		ExpiresIn   int    `json:"expires_in"`
+		AccessToken secret.String `json:"access_token"`
+		TokenType   string        `json:"token_type"`
+		ExpiresIn   int           `json:"expires_in"`
 	}
 	if err := json.Unmarshal(body, &tokenResponse); err != nil {
 		return nil, fmt.Errorf("parsing token response: %w", err)
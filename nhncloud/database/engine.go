@@ -0,0 +1,233 @@
+// Package database provides an engine-agnostic view of the RDS for MySQL,
+// MariaDB and PostgreSQL clients.
+//
+// Engine covers the operations fleet tooling performs the same way on every
+// engine and returns normalized types. Each implementation wraps the engine's
+// rds client; use a type assertion to reach engine-specific features:
+//
+//	engines := []database.Engine{
+//	    database.NewMySQL(c.MySQL()),
+//	    database.NewMariaDB(c.MariaDB()),
+//	    database.NewPostgreSQL(c.PostgreSQL()),
+//	}
+//	for _, e := range engines {
+//	    instances, err := e.ListInstances(ctx)
+//	    ...
+//	    if pg, ok := e.(*database.PostgreSQLEngine); ok {
+//	        pg.Client().ListHBARules(ctx, instances[0].ID)
+//	    }
+//	}
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/rdscore"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
+// Kind identifies a database engine.
+type Kind string
+
+const (
+	KindMySQL      Kind = "mysql"
+	KindMariaDB    Kind = "mariadb"
+	KindPostgreSQL Kind = "postgresql"
+)
+
+// Status is the normalized state of a DB instance. It combines the engine's
+// dbInstanceStatus with its progressStatus, so an available instance that is
+// being backed up reports StatusBackingUp.
+type Status string
+
+const (
+	StatusAvailable   Status = "available"
+	StatusCreating    Status = "creating"
+	StatusModifying   Status = "modifying"
+	StatusBackingUp   Status = "backing-up"
+	StatusRestoring   Status = "restoring"
+	StatusStarting    Status = "starting"
+	StatusStopping    Status = "stopping"
+	StatusRestarting  Status = "restarting"
+	StatusStopped     Status = "stopped"
+	StatusFailover    Status = "failover"
+	StatusStorageFull Status = "storage-full"
+	StatusDeleting    Status = "deleting"
+	StatusDeleted     Status = "deleted"
+	StatusFailed      Status = "failed"
+	StatusUnknown     Status = "unknown"
+)
+
+// IsTerminal reports whether the instance has settled with no operation in
+// progress. StatusUnknown is neither terminal nor transitioning.
+func (s Status) IsTerminal() bool {
+	switch s {
+	case StatusAvailable, StatusStopped, StatusStorageFull, StatusDeleted, StatusFailed:
+		return true
+	}
+	return false
+}
+
+// IsFailed reports whether the instance is in an error state.
+func (s Status) IsFailed() bool { return s == StatusFailed }
+
+// IsTransitioning reports whether an operation is in progress.
+func (s Status) IsTransitioning() bool {
+	switch s {
+	case StatusCreating, StatusModifying, StatusBackingUp, StatusRestoring, StatusStarting,
+		StatusStopping, StatusRestarting, StatusFailover, StatusDeleting:
+		return true
+	}
+	return false
+}
+
+// Instance is a DB instance of any engine.
+type Instance struct {
+	Engine      Kind   `json:"engine"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+	Port        int    `json:"port"`
+	FlavorID    string `json:"flavorId"`
+
+	Status Status `json:"status"`
+	// RawStatus and RawProgressStatus are the engine's values, for
+	// diagnostics.
+	RawStatus         string `json:"rawStatus"`
+	RawProgressStatus string `json:"rawProgressStatus"`
+
+	StorageType   string `json:"storageType"`
+	StorageSizeGB int    `json:"storageSizeGb"`
+
+	DeletionProtection bool `json:"deletionProtection"`
+
+	CreatedAt timestamp.Time `json:"createdAt"`
+	UpdatedAt timestamp.Time `json:"updatedAt"`
+}
+
+// Backup is a backup of any engine.
+type Backup struct {
+	Engine     Kind   `json:"engine"`
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	InstanceID string `json:"instanceId"`
+	Version    string `json:"version"`
+	// Type is AUTO or MANUAL.
+	Type      string         `json:"type"`
+	Status    string         `json:"status"`
+	SizeBytes int64          `json:"sizeBytes"`
+	CreatedAt timestamp.Time `json:"createdAt"`
+}
+
+// Flavor is a DB instance size.
+type Flavor struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	VCPUs int    `json:"vcpus"`
+	RAMMB int    `json:"ramMb"`
+}
+
+// Endpoint is an address clients connect to.
+type Endpoint struct {
+	Domain    string `json:"domain"`
+	IPAddress string `json:"ipAddress"`
+	Port      int    `json:"port"`
+	Public    bool   `json:"public"`
+}
+
+// Engine is implemented by MySQLEngine, MariaDBEngine and PostgreSQLEngine.
+// Methods that start asynchronous work return the RDS job ID.
+type Engine interface {
+	Kind() Kind
+
+	ListInstances(ctx context.Context) ([]Instance, error)
+	GetInstance(ctx context.Context, instanceID string) (*Instance, error)
+	RestartInstance(ctx context.Context, instanceID string) (string, error)
+	ResizeStorage(ctx context.Context, instanceID string, sizeGB int) (string, error)
+	SetDeletionProtection(ctx context.Context, instanceID string, enabled bool) (string, error)
+
+	CreateBackup(ctx context.Context, instanceID, name string) (string, error)
+	// ListBackups returns every backup of an instance, following pagination.
+	ListBackups(ctx context.Context, instanceID string) ([]Backup, error)
+
+	ListFlavors(ctx context.Context) ([]Flavor, error)
+	ListEndpoints(ctx context.Context, instanceID string) ([]Endpoint, error)
+}
+
+const backupPageSize = 100
+
+// NormalizeStatus maps an engine's dbInstanceStatus and progressStatus to a
+// Status. An in-flight progress status takes precedence. The classification
+// is the one the rds clients use, so a status this reports as StatusFailed
+// also ends their WaitForInstance.
+func NormalizeStatus(status, progressStatus string) Status {
+	s := rdscore.InstanceStatus(strings.ToUpper(status))
+	p := rdscore.ProgressStatus(strings.ToUpper(progressStatus))
+
+	if p.IsTransitioning() {
+		switch p {
+		case rdscore.ProgressStatusCreating:
+			return StatusCreating
+		case rdscore.ProgressStatusBackingUp:
+			return StatusBackingUp
+		case rdscore.ProgressStatusRestoring:
+			return StatusRestoring
+		case rdscore.ProgressStatusStarting:
+			return StatusStarting
+		case rdscore.ProgressStatusStopping:
+			return StatusStopping
+		case rdscore.ProgressStatusRestarting, rdscore.ProgressStatusForceRestarting:
+			return StatusRestarting
+		case rdscore.ProgressStatusDeleting:
+			return StatusDeleting
+		case rdscore.ProgressStatusFailingOver:
+			return StatusFailover
+		}
+		return StatusModifying
+	}
+
+	if s.IsFailed() {
+		return StatusFailed
+	}
+	for status, is := range instanceStatuses {
+		if s == is {
+			return status
+		}
+	}
+	return StatusUnknown
+}
+
+// instanceStatuses maps each Status that is a plain dbInstanceStatus to it.
+var instanceStatuses = map[Status]rdscore.InstanceStatus{
+	StatusAvailable:   rdscore.InstanceStatusAvailable,
+	StatusCreating:    rdscore.InstanceStatusBeforeCreate,
+	StatusStopped:     rdscore.InstanceStatusShutdown,
+	StatusStorageFull: rdscore.InstanceStatusStorageFull,
+	StatusFailover:    rdscore.InstanceStatusFailover,
+	StatusDeleted:     rdscore.InstanceStatusDeleted,
+}
+
+// WaitForInstance polls e until the instance settles in want, which must be
+// a terminal status other than StatusFailed. A zero interval polls at the
+// SDK's default rate. Waiting for StatusDeleted also ends when the instance
+// is gone, with a nil Instance.
+func WaitForInstance(ctx context.Context, e Engine, instanceID string, want Status, interval time.Duration) (*Instance, error) {
+	raw, ok := instanceStatuses[want]
+	if !ok || !want.IsTerminal() {
+		return nil, &errors.ValidationError{Field: "want", Reason: fmt.Sprintf("%q is not a settled instance status", want)}
+	}
+	return rdscore.WaitForInstance(ctx, instanceID, raw, interval,
+		func(ctx context.Context) (*Instance, error) { return e.GetInstance(ctx, instanceID) },
+		func(in *Instance) (rdscore.InstanceStatus, rdscore.ProgressStatus) {
+			return rdscore.InstanceStatus(strings.ToUpper(in.RawStatus)), rdscore.ProgressStatus(strings.ToUpper(in.RawProgressStatus))
+		})
+}
+
+func publicEndpointType(endPointType string) bool {
+	return strings.EqualFold(endPointType, "EXTERNAL") || strings.EqualFold(endPointType, "PUBLIC")
+}
@@ -0,0 +1,178 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/cloudtest"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/rdscore"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/mariadb"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/mysql"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/postgresql"
+)
+
+func TestNormalizeStatus(t *testing.T) {
+	tests := []struct {
+		status, progress string
+		want             Status
+	}{
+		{"AVAILABLE", "NONE", StatusAvailable},
+		{"AVAILABLE", "", StatusAvailable},
+		{"AVAILABLE", "BACKING_UP", StatusBackingUp},
+		{"AVAILABLE", "APPLYING_PARAMETER_GROUP", StatusModifying},
+		{"BEFORE_CREATE", "CREATING", StatusCreating},
+		{"SHUTDOWN", "NONE", StatusStopped},
+		{"STORAGE_FULL", "NONE", StatusStorageFull},
+		{"FAIL_TO_CREATE", "NONE", StatusFailed},
+		{"available", "none", StatusAvailable},
+		{"SOMETHING_NEW", "", StatusUnknown},
+	}
+	for _, tt := range tests {
+		if got := NormalizeStatus(tt.status, tt.progress); got != tt.want {
+			t.Errorf("NormalizeStatus(%q, %q) = %q, want %q", tt.status, tt.progress, got, tt.want)
+		}
+	}
+
+	// The rds clients' waiters and this package must agree on failure.
+	for _, s := range []rdscore.InstanceStatus{"AVAILABLE", "FAIL_TO_CREATE", "FAIL_TO_CONNECT", "REPLICATION_STOP", "ERROR", "FAILED"} {
+		if got := NormalizeStatus(string(s), "NONE"); got.IsFailed() != s.IsFailed() {
+			t.Errorf("NormalizeStatus(%q) = %q, but rdscore IsFailed = %v", s, got, s.IsFailed())
+		}
+	}
+}
+
+// serveRDS answers an RDS API at base with one instance, db-1, and n of
+// its backups, reporting totalCounts only if withTotal is set.
+func serveRDS(srv *cloudtest.Server, base string, n int, withTotal bool) {
+	ok := map[string]interface{}{"isSuccessful": true}
+	srv.Mux.HandleFunc(base+"/db-instances/db-1", func(w http.ResponseWriter, r *http.Request) {
+		cloudtest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"header": ok, "dbInstanceId": "db-1", "dbInstanceName": "orders", "dbPort": 3306,
+			"dbInstanceStatus": "AVAILABLE", "progressStatus": "BACKING_UP",
+		})
+	})
+	srv.Mux.HandleFunc(base+"/db-instances/db-1/network-info", func(w http.ResponseWriter, r *http.Request) {
+		cloudtest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			// MySQL and MariaDB type endpoints; PostgreSQL flags them.
+			"header": ok, "endPoints": []map[string]interface{}{
+				{"domain": "internal.db", "endPointType": "INTERNAL"},
+				{"domain": "public.db", "endPointType": "EXTERNAL", "isPublicAccess": true},
+			},
+		})
+	})
+	srv.Mux.HandleFunc(base+"/backups", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		var backups []map[string]interface{}
+		for i := (page - 1) * size; i < n && i < page*size; i++ {
+			backups = append(backups, map[string]interface{}{"backupId": fmt.Sprintf("b-%03d", i), "dbInstanceId": "db-1", "backupSize": 1024})
+		}
+		body := map[string]interface{}{"header": ok, "backups": backups}
+		if withTotal {
+			body["totalCounts"] = n
+		}
+		cloudtest.WriteJSON(w, http.StatusOK, body)
+	})
+}
+
+func TestEngines(t *testing.T) {
+	creds := credentials.NewStatic("access-key", "secret-key")
+	tests := []struct {
+		kind      Kind
+		base      string
+		withTotal bool
+		engine    func(option.Option) Engine
+	}{
+		{KindMySQL, "kr1-rds-mysql.api.nhncloudservice.com/v3.0", true, func(o option.Option) Engine {
+			return NewMySQL(mysql.NewClient("kr1", "appkey", creds, false, o))
+		}},
+		{KindMariaDB, "kr1-rds-mariadb.api.nhncloudservice.com/v3.0", false, func(o option.Option) Engine {
+			return NewMariaDB(mariadb.NewClient("kr1", "appkey", creds, false, o))
+		}},
+		{KindPostgreSQL, "kr1-rds-postgres.api.nhncloudservice.com/v1.0", false, func(o option.Option) Engine {
+			return NewPostgreSQL(postgresql.NewClient("kr1", "appkey", creds, false, o))
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.kind), func(t *testing.T) {
+			srv := cloudtest.New(t)
+			// One full page and a partial one.
+			serveRDS(srv, tt.base, backupPageSize+20, tt.withTotal)
+			e := tt.engine(option.WithHTTPClient(srv.Client()))
+			ctx := context.Background()
+
+			if e.Kind() != tt.kind {
+				t.Errorf("Kind = %s", e.Kind())
+			}
+			inst, err := e.GetInstance(ctx, "db-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if inst.Engine != tt.kind || inst.Name != "orders" || inst.Status != StatusBackingUp || inst.RawStatus != "AVAILABLE" {
+				t.Errorf("instance = %+v", inst)
+			}
+
+			backups, err := e.ListBackups(ctx, "db-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(backups) != backupPageSize+20 || backups[len(backups)-1].ID != fmt.Sprintf("b-%03d", backupPageSize+19) {
+				t.Fatalf("got %d backups, want %d", len(backups), backupPageSize+20)
+			}
+			if b := backups[0]; b.Engine != tt.kind || b.InstanceID != "db-1" || b.SizeBytes != 1024 {
+				t.Errorf("backup = %+v", b)
+			}
+
+			endpoints, err := e.ListEndpoints(ctx, "db-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(endpoints) != 2 || endpoints[0].Public || !endpoints[1].Public || endpoints[1].Port != 3306 {
+				t.Errorf("endpoints = %+v", endpoints)
+			}
+		})
+	}
+}
+
+func TestWaitForInstance(t *testing.T) {
+	srv := cloudtest.New(t)
+	base := "kr1-rds-mysql.api.nhncloudservice.com/v3.0"
+	ok := map[string]interface{}{"isSuccessful": true}
+	// db-1 stops after two polls; db-2 is gone.
+	polls := 0
+	srv.Mux.HandleFunc(base+"/db-instances/db-1", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status, progress := "AVAILABLE", "STOPPING"
+		if polls > 2 {
+			status, progress = "SHUTDOWN", "NONE"
+		}
+		cloudtest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"header": ok, "dbInstanceId": "db-1", "dbInstanceStatus": status, "progressStatus": progress,
+		})
+	})
+	srv.Mux.HandleFunc(base+"/db-instances/db-2", func(w http.ResponseWriter, r *http.Request) {
+		cloudtest.WriteJSON(w, http.StatusNotFound, map[string]interface{}{
+			"header": map[string]interface{}{"isSuccessful": false, "resultCode": 404, "resultMessage": "not found"},
+		})
+	})
+	e := NewMySQL(mysql.NewClient("kr1", "appkey", credentials.NewStatic("access-key", "secret-key"), false,
+		option.WithHTTPClient(srv.Client())))
+	ctx := context.Background()
+
+	inst, err := WaitForInstance(ctx, e, "db-1", StatusStopped, time.Millisecond)
+	if err != nil || inst.Status != StatusStopped || polls != 3 {
+		t.Errorf("WaitForInstance = %+v, %v after %d polls", inst, err, polls)
+	}
+	if inst, err := WaitForInstance(ctx, e, "db-2", StatusDeleted, time.Millisecond); err != nil || inst != nil {
+		t.Errorf("deleted: %+v, %v", inst, err)
+	}
+	if _, err := WaitForInstance(ctx, e, "db-1", StatusStopping, 0); err == nil {
+		t.Error("expected an error waiting for a transitional status")
+	}
+}
@@ -8,20 +8,21 @@ import (
 	"net/http"
 
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/core"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
 )
 
 // Backup represents a database backup
 type Backup struct {
-	BackupID          string `json:"backupId"`
-	BackupName        string `json:"backupName"`
-	BackupType        string `json:"backupType,omitempty"`
-	BackupStatus      string `json:"backupStatus"`
-	DBInstanceID      string `json:"dbInstanceId"`
-	DBInstanceName    string `json:"dbInstanceName,omitempty"`
-	BackupSize        int64  `json:"backupSize,omitempty"`
-	BackupStartedAt   string `json:"backupStartedAt,omitempty"`
-	BackupCompletedAt string `json:"backupCompletedAt,omitempty"`
-	CreatedAt         string `json:"createdAt"`
+	BackupID          string         `json:"backupId"`
+	BackupName        string         `json:"backupName"`
+	BackupType        string         `json:"backupType,omitempty"`
+	BackupStatus      string         `json:"backupStatus"`
+	DBInstanceID      string         `json:"dbInstanceId"`
+	DBInstanceName    string         `json:"dbInstanceName,omitempty"`
+	BackupSize        int64          `json:"backupSize,omitempty"`
+	BackupStartedAt   timestamp.Time `json:"backupStartedAt,omitempty"`
+	BackupCompletedAt timestamp.Time `json:"backupCompletedAt,omitempty"`
+	CreatedAt         timestamp.Time `json:"createdAt"`
 }
 
 // ListBackupsResponse is the response for ListBackups
@@ -25,6 +25,11 @@
 //	for _, inst := range instances.DBInstances {
 //	    fmt.Printf("%s: %s\n", inst.DBInstanceName, inst.DBInstanceStatus)
 //	}
+//
+// Deprecated: use github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/mariadb,
+// which covers every operation of this package and is the client returned by
+// nhncloud.Client.MariaDB. This package receives fixes only and will be
+// removed in the next major version. See docs/MIGRATION_RDS.md.
 package mariadb
 
 import (
@@ -32,6 +37,7 @@ import (
 
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/auth"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/core"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 // Client is the MariaDB API client
@@ -69,6 +75,15 @@ func NewClient(cfg Config) (*Client, error) {
 	}, nil
 }
 
+// Format prints the configuration with SecretKey redacted, for every verb.
+func (c Config) Format(f fmt.State, verb rune) {
+	redacted := struct {
+		Region, AppKey, AccessKey string
+		SecretKey                 secret.String
+	}{c.Region, c.AppKey, c.AccessKey, secret.String(c.SecretKey)}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), redacted)
+}
+
 // Validate validates the configuration
 func (c *Config) Validate() error {
 	if c.Region == "" {
@@ -8,17 +8,18 @@ import (
 	"net/http"
 
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/core"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
 )
 
 // ParameterGroup represents a database parameter group
 type ParameterGroup struct {
-	ParameterGroupID   string      `json:"parameterGroupId"`
-	ParameterGroupName string      `json:"parameterGroupName"`
-	Description        string      `json:"description,omitempty"`
-	DBVersion          string      `json:"dbVersion"`
-	Parameters         []Parameter `json:"parameters,omitempty"`
-	CreatedAt          string      `json:"createdAt,omitempty"`
-	UpdatedAt          string      `json:"updatedAt,omitempty"`
+	ParameterGroupID   string         `json:"parameterGroupId"`
+	ParameterGroupName string         `json:"parameterGroupName"`
+	Description        string         `json:"description,omitempty"`
+	DBVersion          string         `json:"dbVersion"`
+	Parameters         []Parameter    `json:"parameters,omitempty"`
+	CreatedAt          timestamp.Time `json:"createdAt,omitempty"`
+	UpdatedAt          timestamp.Time `json:"updatedAt,omitempty"`
 }
 
 // Parameter represents a database parameter
@@ -1,6 +1,9 @@
 package mariadb
 
-import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/core"
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/core"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
 
 // MariaDBResponse is the common response wrapper for MariaDB APIs
 type MariaDBResponse struct {
@@ -57,8 +60,8 @@ type DatabaseInstance struct {
 	ReadReplicaCount      int                     `json:"readReplicaCount,omitempty"`
 	ProgressStatus        string                  `json:"progressStatus,omitempty"`
 	DeletionProtection    bool                    `json:"deletionProtection,omitempty"`
-	CreatedAt             string                  `json:"createdAt"`
-	UpdatedAt             string                  `json:"updatedAt"`
+	CreatedAt             timestamp.Time          `json:"createdAt"`
+	UpdatedAt             timestamp.Time          `json:"updatedAt"`
 }
 
 // DatabaseInstanceNetwork represents network configuration
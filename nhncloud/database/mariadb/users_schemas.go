@@ -8,17 +8,18 @@ import (
 	"net/http"
 
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/core"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
 )
 
 // DBUser represents a database user
 type DBUser struct {
-	DBUserID             string `json:"dbUserId,omitempty"`
-	DBUserName           string `json:"dbUserName"`
-	Host                 string `json:"host"`
-	AuthorityType        string `json:"authorityType"`
-	AuthenticationPlugin string `json:"authenticationPlugin,omitempty"`
-	TLSOption            string `json:"tlsOption,omitempty"`
-	CreatedAt            string `json:"createdAt,omitempty"`
+	DBUserID             string         `json:"dbUserId,omitempty"`
+	DBUserName           string         `json:"dbUserName"`
+	Host                 string         `json:"host"`
+	AuthorityType        string         `json:"authorityType"`
+	AuthenticationPlugin string         `json:"authenticationPlugin,omitempty"`
+	TLSOption            string         `json:"tlsOption,omitempty"`
+	CreatedAt            timestamp.Time `json:"createdAt,omitempty"`
 }
 
 // ListDBUsersResponse is the response for ListDBUsers
@@ -209,9 +210,9 @@ func (c *Client) DeleteDBUser(ctx context.Context, instanceID, userID string) (*
 
 // DBSchema represents a database schema
 type DBSchema struct {
-	DBSchemaID   string `json:"dbSchemaId,omitempty"`
-	DBSchemaName string `json:"dbSchemaName"`
-	CreatedAt    string `json:"createdAt,omitempty"`
+	DBSchemaID   string         `json:"dbSchemaId,omitempty"`
+	DBSchemaName string         `json:"dbSchemaName"`
+	CreatedAt    timestamp.Time `json:"createdAt,omitempty"`
 }
 
 // ListSchemasResponse is the response for ListSchemas
@@ -0,0 +1,156 @@
+package database
+
+import (
+	"context"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/mysql"
+)
+
+// MySQLEngine implements Engine for RDS for MySQL.
+type MySQLEngine struct {
+	client *mysql.Client
+}
+
+var _ Engine = (*MySQLEngine)(nil)
+
+// NewMySQL wraps an RDS for MySQL client.
+func NewMySQL(client *mysql.Client) *MySQLEngine {
+	return &MySQLEngine{client: client}
+}
+
+// Client returns the wrapped client for MySQL-specific operations.
+func (e *MySQLEngine) Client() *mysql.Client { return e.client }
+
+func (e *MySQLEngine) Kind() Kind { return KindMySQL }
+
+func (e *MySQLEngine) ListInstances(ctx context.Context) ([]Instance, error) {
+	out, err := e.client.ListInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instances := make([]Instance, 0, len(out.DBInstances))
+	for i := range out.DBInstances {
+		instances = append(instances, e.instance(&out.DBInstances[i]))
+	}
+	return instances, nil
+}
+
+func (e *MySQLEngine) GetInstance(ctx context.Context, instanceID string) (*Instance, error) {
+	out, err := e.client.GetInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	inst := e.instance(&out.DatabaseInstance)
+	return &inst, nil
+}
+
+func (e *MySQLEngine) RestartInstance(ctx context.Context, instanceID string) (string, error) {
+	out, err := e.client.RestartInstance(ctx, instanceID, &mysql.RestartInstanceRequest{})
+	if err != nil {
+		return "", err
+	}
+	return out.JobID, nil
+}
+
+func (e *MySQLEngine) ResizeStorage(ctx context.Context, instanceID string, sizeGB int) (string, error) {
+	out, err := e.client.ModifyStorageInfo(ctx, instanceID, &mysql.ModifyStorageInfoInput{StorageSize: sizeGB})
+	if err != nil {
+		return "", err
+	}
+	return out.JobID, nil
+}
+
+func (e *MySQLEngine) SetDeletionProtection(ctx context.Context, instanceID string, enabled bool) (string, error) {
+	out, err := e.client.ModifyDeletionProtection(ctx, instanceID, &mysql.ModifyDeletionProtectionRequest{UseDeletionProtection: enabled})
+	if err != nil {
+		return "", err
+	}
+	return out.JobID, nil
+}
+
+func (e *MySQLEngine) CreateBackup(ctx context.Context, instanceID, name string) (string, error) {
+	out, err := e.client.CreateBackup(ctx, instanceID, &mysql.CreateBackupRequest{BackupName: name})
+	if err != nil {
+		return "", err
+	}
+	return out.JobID, nil
+}
+
+func (e *MySQLEngine) ListBackups(ctx context.Context, instanceID string) ([]Backup, error) {
+	var backups []Backup
+	for page := 1; ; page++ {
+		out, err := e.client.ListBackups(ctx, instanceID, "", page, backupPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range out.Backups {
+			backups = append(backups, Backup{
+				Engine:     KindMySQL,
+				ID:         b.BackupID,
+				Name:       b.BackupName,
+				InstanceID: b.DBInstanceID,
+				Version:    b.DBVersion,
+				Type:       b.BackupType,
+				Status:     string(b.BackupStatus),
+				SizeBytes:  b.BackupSize,
+				CreatedAt:  b.CreatedYmdt,
+			})
+		}
+		if len(out.Backups) < backupPageSize || (out.TotalCounts > 0 && len(backups) >= out.TotalCounts) {
+			return backups, nil
+		}
+	}
+}
+
+func (e *MySQLEngine) ListFlavors(ctx context.Context) ([]Flavor, error) {
+	out, err := e.client.ListFlavors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	flavors := make([]Flavor, 0, len(out.DBFlavors))
+	for _, f := range out.DBFlavors {
+		flavors = append(flavors, Flavor{ID: f.FlavorID, Name: f.FlavorName, VCPUs: f.Vcpus, RAMMB: f.Ram})
+	}
+	return flavors, nil
+}
+
+func (e *MySQLEngine) ListEndpoints(ctx context.Context, instanceID string) ([]Endpoint, error) {
+	inst, err := e.client.GetInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	out, err := e.client.GetNetworkInfo(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	endpoints := make([]Endpoint, 0, len(out.EndPoints))
+	for _, ep := range out.EndPoints {
+		endpoints = append(endpoints, Endpoint{
+			Domain:    ep.Domain,
+			IPAddress: ep.IPAddress,
+			Port:      inst.DBPort,
+			Public:    publicEndpointType(ep.EndPointType),
+		})
+	}
+	return endpoints, nil
+}
+
+func (e *MySQLEngine) instance(in *mysql.DatabaseInstance) Instance {
+	return Instance{
+		Engine:             KindMySQL,
+		ID:                 in.DBInstanceID,
+		Name:               in.DBInstanceName,
+		Description:        in.Description,
+		Version:            in.DBVersion,
+		Port:               in.DBPort,
+		FlavorID:           in.DBFlavorID,
+		Status:             NormalizeStatus(string(in.DBInstanceStatus), string(in.ProgressStatus)),
+		RawStatus:          string(in.DBInstanceStatus),
+		RawProgressStatus:  string(in.ProgressStatus),
+		StorageType:        in.StorageType,
+		StorageSizeGB:      in.StorageSize,
+		DeletionProtection: in.UseDeletionProtection,
+		CreatedAt:          in.CreatedYmdt,
+		UpdatedAt:          in.UpdatedYmdt,
+	}
+}
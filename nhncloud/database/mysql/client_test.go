@@ -2,6 +2,8 @@ package mysql_test
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/database/mysql"
@@ -79,3 +81,12 @@ func TestListInstances_ContextRequired(t *testing.T) {
 	// Actual call would require mock server or live credentials
 	// Will be tested in integration tests
 }
+
+func TestConfigRedactsSecretKey(t *testing.T) {
+	cfg := mysql.Config{Region: "kr1", AppKey: "app-key", AccessKey: "access-key", SecretKey: "test-secret-key"}
+	for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+		if got := fmt.Sprintf(format, cfg); strings.Contains(got, "test-secret-key") || !strings.Contains(got, "access-key") {
+			t.Errorf("%s: %s", format, got)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/core"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
 )
 
 // SecurityGroup represents a database security group
@@ -16,8 +17,8 @@ type SecurityGroup struct {
 	DBSecurityGroupName string         `json:"dbSecurityGroupName"`
 	Description         string         `json:"description,omitempty"`
 	Rules               []SecurityRule `json:"rules,omitempty"`
-	CreatedAt           string         `json:"createdAt,omitempty"`
-	UpdatedAt           string         `json:"updatedAt,omitempty"`
+	CreatedAt           timestamp.Time `json:"createdAt,omitempty"`
+	UpdatedAt           timestamp.Time `json:"updatedAt,omitempty"`
 }
 
 // SecurityRule represents a security group rule
@@ -1,6 +1,9 @@
 package mysql
 
-import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/core"
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/core"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
 
 // MySQLResponse is the common response wrapper for MySQL APIs
 type MySQLResponse struct {
@@ -58,8 +61,8 @@ type DatabaseInstance struct {
 	NeedToApplyParamGroup bool                     `json:"needToApplyParameterGroup,omitempty"`
 	NeedMigration         bool                     `json:"needMigration,omitempty"`
 	SupportUpgrade        bool                     `json:"supportUpgrade,omitempty"`
-	CreatedYmdt           string                   `json:"createdYmdt"`
-	UpdatedYmdt           string                   `json:"updatedYmdt"`
+	CreatedYmdt           timestamp.Time           `json:"createdYmdt"`
+	UpdatedYmdt           timestamp.Time           `json:"updatedYmdt"`
 }
 
 // DatabaseInstanceNetwork represents network configuration
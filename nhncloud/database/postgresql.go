@@ -0,0 +1,159 @@
+package database
+
+import (
+	"context"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/postgresql"
+)
+
+// PostgreSQLEngine implements Engine for RDS for PostgreSQL.
+type PostgreSQLEngine struct {
+	client *postgresql.Client
+}
+
+var _ Engine = (*PostgreSQLEngine)(nil)
+
+// NewPostgreSQL wraps an RDS for PostgreSQL client.
+func NewPostgreSQL(client *postgresql.Client) *PostgreSQLEngine {
+	return &PostgreSQLEngine{client: client}
+}
+
+// Client returns the wrapped client for PostgreSQL-specific operations such
+// as HBA rules and extensions.
+func (e *PostgreSQLEngine) Client() *postgresql.Client { return e.client }
+
+func (e *PostgreSQLEngine) Kind() Kind { return KindPostgreSQL }
+
+func (e *PostgreSQLEngine) ListInstances(ctx context.Context) ([]Instance, error) {
+	out, err := e.client.ListInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instances := make([]Instance, 0, len(out.DBInstances))
+	for i := range out.DBInstances {
+		instances = append(instances, e.instance(&out.DBInstances[i]))
+	}
+	return instances, nil
+}
+
+func (e *PostgreSQLEngine) GetInstance(ctx context.Context, instanceID string) (*Instance, error) {
+	out, err := e.client.GetInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	inst := e.instance(&out.DBInstance)
+	return &inst, nil
+}
+
+func (e *PostgreSQLEngine) RestartInstance(ctx context.Context, instanceID string) (string, error) {
+	out, err := e.client.RestartInstance(ctx, instanceID, &postgresql.RestartInstanceRequest{})
+	if err != nil {
+		return "", err
+	}
+	return out.JobID, nil
+}
+
+func (e *PostgreSQLEngine) ResizeStorage(ctx context.Context, instanceID string, sizeGB int) (string, error) {
+	out, err := e.client.ModifyStorageInfo(ctx, instanceID, &postgresql.ModifyStorageInfoInput{StorageSize: sizeGB})
+	if err != nil {
+		return "", err
+	}
+	return out.JobID, nil
+}
+
+func (e *PostgreSQLEngine) SetDeletionProtection(ctx context.Context, instanceID string, enabled bool) (string, error) {
+	out, err := e.client.ModifyDeletionProtection(ctx, instanceID, &postgresql.ModifyDeletionProtectionRequest{UseDeletionProtection: enabled})
+	if err != nil {
+		return "", err
+	}
+	return out.JobID, nil
+}
+
+func (e *PostgreSQLEngine) CreateBackup(ctx context.Context, instanceID, name string) (string, error) {
+	out, err := e.client.CreateBackup(ctx, instanceID, &postgresql.CreateBackupRequest{BackupName: name})
+	if err != nil {
+		return "", err
+	}
+	return out.JobID, nil
+}
+
+// ListBackups pages until a short page, since the PostgreSQL API does not
+// report a total count.
+func (e *PostgreSQLEngine) ListBackups(ctx context.Context, instanceID string) ([]Backup, error) {
+	var backups []Backup
+	for page := 1; ; page++ {
+		out, err := e.client.ListBackups(ctx, instanceID, page, backupPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range out.Backups {
+			backups = append(backups, Backup{
+				Engine:     KindPostgreSQL,
+				ID:         b.BackupID,
+				Name:       b.BackupName,
+				InstanceID: b.DBInstanceID,
+				Version:    b.DBVersion,
+				Type:       b.BackupType,
+				Status:     string(b.BackupStatus),
+				SizeBytes:  b.BackupSize,
+				CreatedAt:  b.CreatedYmdt,
+			})
+		}
+		if len(out.Backups) < backupPageSize {
+			return backups, nil
+		}
+	}
+}
+
+func (e *PostgreSQLEngine) ListFlavors(ctx context.Context) ([]Flavor, error) {
+	out, err := e.client.ListFlavors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	flavors := make([]Flavor, 0, len(out.DBFlavors))
+	for _, f := range out.DBFlavors {
+		flavors = append(flavors, Flavor{ID: f.DBFlavorID, Name: f.DBFlavorName, VCPUs: f.VCPUs, RAMMB: f.RAM})
+	}
+	return flavors, nil
+}
+
+func (e *PostgreSQLEngine) ListEndpoints(ctx context.Context, instanceID string) ([]Endpoint, error) {
+	inst, err := e.client.GetInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	out, err := e.client.GetNetworkInfo(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	endpoints := make([]Endpoint, 0, len(out.EndPoints))
+	for _, ep := range out.EndPoints {
+		endpoints = append(endpoints, Endpoint{
+			Domain:    ep.Domain,
+			IPAddress: ep.IPAddress,
+			Port:      inst.DBPort,
+			Public:    ep.IsPublicAccess,
+		})
+	}
+	return endpoints, nil
+}
+
+func (e *PostgreSQLEngine) instance(in *postgresql.DBInstance) Instance {
+	return Instance{
+		Engine:             KindPostgreSQL,
+		ID:                 in.DBInstanceID,
+		Name:               in.DBInstanceName,
+		Description:        in.Description,
+		Version:            in.DBVersion,
+		Port:               in.DBPort,
+		FlavorID:           in.DBFlavorID,
+		Status:             NormalizeStatus(string(in.DBInstanceStatus), string(in.ProgressStatus)),
+		RawStatus:          string(in.DBInstanceStatus),
+		RawProgressStatus:  string(in.ProgressStatus),
+		StorageType:        in.StorageType,
+		StorageSizeGB:      in.StorageSize,
+		DeletionProtection: in.UseDeletionProtection,
+		CreatedAt:          in.CreatedYmdt,
+		UpdatedAt:          in.UpdatedYmdt,
+	}
+}
@@ -8,17 +8,18 @@ import (
 	"net/http"
 
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/core"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
 )
 
 // NotificationGroup represents a notification group
 type NotificationGroup struct {
-	NotificationGroupID   string   `json:"notificationGroupId"`
-	NotificationGroupName string   `json:"notificationGroupName"`
-	IsEnabled             bool     `json:"isEnabled"`
-	NotifyEmail           []string `json:"notifyEmail,omitempty"`
-	NotifySms             []string `json:"notifySms,omitempty"`
-	CreatedAt             string   `json:"createdAt,omitempty"`
-	UpdatedAt             string   `json:"updatedAt,omitempty"`
+	NotificationGroupID   string         `json:"notificationGroupId"`
+	NotificationGroupName string         `json:"notificationGroupName"`
+	IsEnabled             bool           `json:"isEnabled"`
+	NotifyEmail           []string       `json:"notifyEmail,omitempty"`
+	NotifySms             []string       `json:"notifySms,omitempty"`
+	CreatedAt             timestamp.Time `json:"createdAt,omitempty"`
+	UpdatedAt             timestamp.Time `json:"updatedAt,omitempty"`
 }
 
 // ListNotificationGroupsResponse is the response for ListNotificationGroups
@@ -211,9 +212,9 @@ func (c *Client) DeleteNotificationGroup(ctx context.Context, groupID string) (*
 
 // LogFile represents a log file
 type LogFile struct {
-	LogFileName string `json:"logFileName"`
-	LogFileSize int64  `json:"logFileSize"`
-	ModifiedAt  string `json:"modifiedAt,omitempty"`
+	LogFileName string         `json:"logFileName"`
+	LogFileSize int64          `json:"logFileSize"`
+	ModifiedAt  timestamp.Time `json:"modifiedAt,omitempty"`
 }
 
 // ListLogFilesResponse is the response for ListLogFiles
@@ -294,8 +295,8 @@ type MetricStatistic struct {
 
 // MetricStatisticValue represents a metric value at a point in time
 type MetricStatisticValue struct {
-	Timestamp string  `json:"timestamp"`
-	Value     float64 `json:"value"`
+	Timestamp timestamp.Time `json:"timestamp"`
+	Value     float64        `json:"value"`
 }
 
 // GetMetricStatisticsResponse is the response for GetMetricStatistics
@@ -1,6 +1,9 @@
 package postgresql
 
-import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/core"
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/core"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
 
 // PostgreSQLResponse is the common response wrapper for PostgreSQL APIs
 type PostgreSQLResponse struct {
@@ -64,8 +67,8 @@ type DatabaseInstance struct {
 	DeletionProtection        bool                    `json:"deletionProtection,omitempty"`
 	NeedToApplyParameterGroup bool                    `json:"needToApplyParameterGroup,omitempty"`
 	NeedMigration             bool                    `json:"needMigration,omitempty"`
-	CreatedAt                 string                  `json:"createdAt"`
-	UpdatedAt                 string                  `json:"updatedAt"`
+	CreatedAt                 timestamp.Time          `json:"createdAt"`
+	UpdatedAt                 timestamp.Time          `json:"updatedAt"`
 }
 
 // DatabaseInstanceNetwork represents network configuration
@@ -8,16 +8,17 @@ import (
 	"net/http"
 
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/core"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
 )
 
 // UserGroup represents a user group
 type UserGroup struct {
-	UserGroupID       string   `json:"userGroupId"`
-	UserGroupName     string   `json:"userGroupName"`
-	UserGroupTypeCode string   `json:"userGroupTypeCode,omitempty"`
-	Members           []Member `json:"members,omitempty"`
-	CreatedYmdt       string   `json:"createdYmdt,omitempty"`
-	UpdatedYmdt       string   `json:"updatedYmdt,omitempty"`
+	UserGroupID       string         `json:"userGroupId"`
+	UserGroupName     string         `json:"userGroupName"`
+	UserGroupTypeCode string         `json:"userGroupTypeCode,omitempty"`
+	Members           []Member       `json:"members,omitempty"`
+	CreatedYmdt       timestamp.Time `json:"createdYmdt,omitempty"`
+	UpdatedYmdt       timestamp.Time `json:"updatedYmdt,omitempty"`
 }
 
 // Member represents a user group member
@@ -9,6 +9,11 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 const DefaultBaseURL = "https://dnsplus.api.nhncloudservice.com"
@@ -19,21 +24,34 @@ type Client struct {
 	appKey     string
 	httpClient *http.Client
 	debug      bool
+	drift      *drift.Detector
 }
 
-// NewClient creates a new DNS Plus client
-func NewClient(appKey string, httpClient *http.Client, debug bool) *Client {
-	if httpClient == nil {
-		httpClient = &http.Client{Timeout: 30 * time.Second}
+// NewClient creates a new DNS Plus client. A nil httpClient uses a client
+// with a 30s timeout.
+func NewClient(appKey string, httpClient *http.Client, debug bool, opts ...option.Option) *Client {
+	settings := option.Apply(opts...)
+	if settings.HTTPClient == nil {
+		settings.HTTPClient = httpClient
 	}
 	return &Client{
 		baseURL:    DefaultBaseURL,
 		appKey:     appKey,
-		httpClient: httpClient,
+		httpClient: client.HTTPClient(settings, &http.Client{Timeout: 30 * time.Second}),
+		drift:      client.Drift(settings),
 		debug:      debug,
 	}
 }
 
+// decode unmarshals a response body into v, checking it for unmapped
+// fields first when drift detection is enabled.
+func (c *Client) decode(body []byte, v interface{}) error {
+	if err := c.drift.Check("dnsplus", "", body, v); err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
 // buildPath constructs the full API path
 func (c *Client) buildPath(path string) string {
 	return fmt.Sprintf("/dnsplus/v1.0/appkeys/%s%s", c.appKey, path)
@@ -49,7 +67,7 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		}
 		reqBody = bytes.NewReader(jsonData)
 		if c.debug {
-			fmt.Printf("[DEBUG] Request body: %s\n", string(jsonData))
+			fmt.Printf("[DEBUG] Request body: %s\n", secret.RedactJSON(jsonData))
 		}
 	}
 
@@ -79,11 +97,11 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 
 	if c.debug {
 		fmt.Printf("[DEBUG] Response status: %d\n", resp.StatusCode)
-		fmt.Printf("[DEBUG] Response body: %s\n", string(respBody))
+		fmt.Printf("[DEBUG] Response body: %s\n", secret.RedactJSON(respBody))
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, secret.RedactJSON(respBody))
 	}
 
 	return respBody, nil
@@ -101,7 +119,7 @@ func (c *Client) ListZones(ctx context.Context) (*ListZonesOutput, error) {
 	}
 
 	var result ListZonesOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -119,7 +137,7 @@ func (c *Client) CreateZone(ctx context.Context, input *CreateZoneInput) (*ZoneO
 	}
 
 	var result ZoneOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -138,7 +156,7 @@ func (c *Client) UpdateZone(ctx context.Context, zoneID string, input *UpdateZon
 	}
 
 	var result ZoneOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -156,7 +174,7 @@ func (c *Client) DeleteZones(ctx context.Context, zoneIDs []string) (*APIRespons
 	}
 
 	var result APIResponse
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -176,7 +194,7 @@ func (c *Client) ListRecordSets(ctx context.Context, zoneID string) (*ListRecord
 	}
 
 	var result ListRecordSetsOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -195,7 +213,7 @@ func (c *Client) CreateRecordSet(ctx context.Context, zoneID string, input *Crea
 	}
 
 	var result RecordSetOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -214,7 +232,7 @@ func (c *Client) UpdateRecordSet(ctx context.Context, zoneID, recordsetID string
 	}
 
 	var result RecordSetOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -233,7 +251,7 @@ func (c *Client) DeleteRecordSets(ctx context.Context, zoneID string, recordsetI
 	}
 
 	var result APIResponse
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -252,7 +270,7 @@ func (c *Client) ListGSLBs(ctx context.Context) (*ListGSLBsOutput, error) {
 	}
 
 	var result ListGSLBsOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -270,7 +288,7 @@ func (c *Client) CreateGSLB(ctx context.Context, input *CreateGSLBInput) (*GSLBO
 	}
 
 	var result GSLBOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -289,7 +307,7 @@ func (c *Client) UpdateGSLB(ctx context.Context, gslbID string, input *UpdateGSL
 	}
 
 	var result GSLBOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -307,7 +325,7 @@ func (c *Client) DeleteGSLBs(ctx context.Context, gslbIDs []string) (*APIRespons
 	}
 
 	var result APIResponse
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -327,7 +345,7 @@ func (c *Client) ListPools(ctx context.Context, gslbID string) (*ListPoolsOutput
 	}
 
 	var result ListPoolsOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -346,7 +364,7 @@ func (c *Client) CreatePool(ctx context.Context, gslbID string, input *CreatePoo
 	}
 
 	var result PoolOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -365,7 +383,7 @@ func (c *Client) UpdatePool(ctx context.Context, gslbID, poolID string, input *U
 	}
 
 	var result PoolOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -384,7 +402,7 @@ func (c *Client) DeletePools(ctx context.Context, gslbID string, poolIDs []strin
 	}
 
 	var result APIResponse
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -404,7 +422,7 @@ func (c *Client) ListEndpoints(ctx context.Context, gslbID, poolID string) (*Lis
 	}
 
 	var result ListEndpointsOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -423,7 +441,7 @@ func (c *Client) CreateEndpoint(ctx context.Context, gslbID, poolID string, inpu
 	}
 
 	var result EndpointOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -442,7 +460,7 @@ func (c *Client) UpdateEndpoint(ctx context.Context, gslbID, poolID, endpointID
 	}
 
 	var result EndpointOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -461,7 +479,7 @@ func (c *Client) DeleteEndpoints(ctx context.Context, gslbID, poolID string, end
 	}
 
 	var result APIResponse
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -480,7 +498,7 @@ func (c *Client) ListHealthChecks(ctx context.Context) (*ListHealthChecksOutput,
 	}
 
 	var result ListHealthChecksOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -498,7 +516,7 @@ func (c *Client) CreateHealthCheck(ctx context.Context, input *CreateHealthCheck
 	}
 
 	var result HealthCheckOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -517,7 +535,7 @@ func (c *Client) UpdateHealthCheck(ctx context.Context, healthCheckID string, in
 	}
 
 	var result HealthCheckOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -535,7 +553,7 @@ func (c *Client) DeleteHealthChecks(ctx context.Context, healthCheckIDs []string
 	}
 
 	var result APIResponse
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
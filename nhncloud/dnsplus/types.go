@@ -1,7 +1,9 @@
 // Package dnsplus provides DNS Plus service types and client
 package dnsplus
 
-import "time"
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
 
 // Header represents the response header
 type Header struct {
@@ -21,14 +23,14 @@ type APIResponse struct {
 
 // Zone represents a DNS zone
 type Zone struct {
-	ZoneID         string    `json:"zoneId"`
-	ZoneName       string    `json:"zoneName"`
-	ZoneStatus     string    `json:"zoneStatus"` // USE, STOP
-	Description    string    `json:"description,omitempty"`
-	RecordSetCount int       `json:"recordsetCount"`
-	CreatedAt      time.Time `json:"createdAt"`
-	UpdatedAt      time.Time `json:"updatedAt"`
-	NameServers    []string  `json:"nameServers,omitempty"`
+	ZoneID         string         `json:"zoneId"`
+	ZoneName       string         `json:"zoneName"`
+	ZoneStatus     string         `json:"zoneStatus"` // USE, STOP
+	Description    string         `json:"description,omitempty"`
+	RecordSetCount int            `json:"recordsetCount"`
+	CreatedAt      timestamp.Time `json:"createdAt"`
+	UpdatedAt      timestamp.Time `json:"updatedAt"`
+	NameServers    []string       `json:"nameServers,omitempty"`
 }
 
 // ListZonesOutput represents the response from listing zones
@@ -62,13 +64,13 @@ type UpdateZoneInput struct {
 
 // RecordSet represents a DNS record set
 type RecordSet struct {
-	RecordSetID   string    `json:"recordsetId"`
-	RecordSetName string    `json:"recordsetName"`
-	RecordSetType string    `json:"recordsetType"` // A, AAAA, CAA, CNAME, MX, NAPTR, PTR, TXT, SRV, NS, SOA
-	TTL           int       `json:"ttl"`
-	RecordList    []Record  `json:"recordList"`
-	CreatedAt     time.Time `json:"createdAt"`
-	UpdatedAt     time.Time `json:"updatedAt"`
+	RecordSetID   string         `json:"recordsetId"`
+	RecordSetName string         `json:"recordsetName"`
+	RecordSetType string         `json:"recordsetType"` // A, AAAA, CAA, CNAME, MX, NAPTR, PTR, TXT, SRV, NS, SOA
+	TTL           int            `json:"ttl"`
+	RecordList    []Record       `json:"recordList"`
+	CreatedAt     timestamp.Time `json:"createdAt"`
+	UpdatedAt     timestamp.Time `json:"updatedAt"`
 }
 
 // Record represents a single DNS record
@@ -110,17 +112,17 @@ type UpdateRecordSetInput struct {
 
 // GSLB represents a Global Server Load Balancing configuration
 type GSLB struct {
-	GslbID        string    `json:"gslbId"`
-	GslbName      string    `json:"gslbName"`
-	GslbDomain    string    `json:"gslbDomain"`
-	GslbStatus    string    `json:"gslbStatus"` // USE, STOP
-	Description   string    `json:"description,omitempty"`
-	RoutingType   string    `json:"routingType"` // FAILOVER, RANDOM, GEOLOCATION
-	TTL           int       `json:"ttl"`
-	PoolCount     int       `json:"poolCount"`
-	HealthCheckID string    `json:"healthCheckId,omitempty"`
-	CreatedAt     time.Time `json:"createdAt"`
-	UpdatedAt     time.Time `json:"updatedAt"`
+	GslbID        string         `json:"gslbId"`
+	GslbName      string         `json:"gslbName"`
+	GslbDomain    string         `json:"gslbDomain"`
+	GslbStatus    string         `json:"gslbStatus"` // USE, STOP
+	Description   string         `json:"description,omitempty"`
+	RoutingType   string         `json:"routingType"` // FAILOVER, RANDOM, GEOLOCATION
+	TTL           int            `json:"ttl"`
+	PoolCount     int            `json:"poolCount"`
+	HealthCheckID string         `json:"healthCheckId,omitempty"`
+	CreatedAt     timestamp.Time `json:"createdAt"`
+	UpdatedAt     timestamp.Time `json:"updatedAt"`
 }
 
 // ListGSLBsOutput represents the response from listing GSLBs
@@ -160,16 +162,16 @@ type UpdateGSLBInput struct {
 
 // Pool represents a GSLB pool
 type Pool struct {
-	PoolID        string    `json:"poolId"`
-	PoolName      string    `json:"poolName"`
-	PoolStatus    string    `json:"poolStatus"` // USE, STOP
-	Description   string    `json:"description,omitempty"`
-	Priority      int       `json:"priority"`
-	Weight        int       `json:"weight"`
-	Region        string    `json:"region,omitempty"`
-	EndpointCount int       `json:"endpointCount"`
-	CreatedAt     time.Time `json:"createdAt"`
-	UpdatedAt     time.Time `json:"updatedAt"`
+	PoolID        string         `json:"poolId"`
+	PoolName      string         `json:"poolName"`
+	PoolStatus    string         `json:"poolStatus"` // USE, STOP
+	Description   string         `json:"description,omitempty"`
+	Priority      int            `json:"priority"`
+	Weight        int            `json:"weight"`
+	Region        string         `json:"region,omitempty"`
+	EndpointCount int            `json:"endpointCount"`
+	CreatedAt     timestamp.Time `json:"createdAt"`
+	UpdatedAt     timestamp.Time `json:"updatedAt"`
 }
 
 // ListPoolsOutput represents the response from listing pools
@@ -210,14 +212,14 @@ type UpdatePoolInput struct {
 
 // Endpoint represents a GSLB pool endpoint
 type Endpoint struct {
-	EndpointID      string    `json:"endpointId"`
-	EndpointAddress string    `json:"endpointAddress"`
-	EndpointStatus  string    `json:"endpointStatus"` // USE, STOP
-	Weight          int       `json:"weight"`
-	Description     string    `json:"description,omitempty"`
-	HealthStatus    string    `json:"healthStatus,omitempty"` // HEALTHY, UNHEALTHY
-	CreatedAt       time.Time `json:"createdAt"`
-	UpdatedAt       time.Time `json:"updatedAt"`
+	EndpointID      string         `json:"endpointId"`
+	EndpointAddress string         `json:"endpointAddress"`
+	EndpointStatus  string         `json:"endpointStatus"` // USE, STOP
+	Weight          int            `json:"weight"`
+	Description     string         `json:"description,omitempty"`
+	HealthStatus    string         `json:"healthStatus,omitempty"` // HEALTHY, UNHEALTHY
+	CreatedAt       timestamp.Time `json:"createdAt"`
+	UpdatedAt       timestamp.Time `json:"updatedAt"`
 }
 
 // ListEndpointsOutput represents the response from listing endpoints
@@ -254,19 +256,19 @@ type UpdateEndpointInput struct {
 
 // HealthCheck represents a health check configuration
 type HealthCheck struct {
-	HealthCheckID   string    `json:"healthCheckId"`
-	HealthCheckName string    `json:"healthCheckName"`
-	Description     string    `json:"description,omitempty"`
-	Protocol        string    `json:"protocol"` // HTTP, HTTPS, TCP
-	Port            int       `json:"port"`
-	Path            string    `json:"path,omitempty"`          // For HTTP/HTTPS
-	Host            string    `json:"host,omitempty"`          // Host header for HTTP/HTTPS
-	Interval        int       `json:"interval"`                // Seconds between checks
-	Timeout         int       `json:"timeout"`                 // Seconds to wait for response
-	Retries         int       `json:"retries"`                 // Number of retries before marking unhealthy
-	ExpectedCodes   string    `json:"expectedCodes,omitempty"` // e.g., "200,201,202"
-	CreatedAt       time.Time `json:"createdAt"`
-	UpdatedAt       time.Time `json:"updatedAt"`
+	HealthCheckID   string         `json:"healthCheckId"`
+	HealthCheckName string         `json:"healthCheckName"`
+	Description     string         `json:"description,omitempty"`
+	Protocol        string         `json:"protocol"` // HTTP, HTTPS, TCP
+	Port            int            `json:"port"`
+	Path            string         `json:"path,omitempty"`          // For HTTP/HTTPS
+	Host            string         `json:"host,omitempty"`          // Host header for HTTP/HTTPS
+	Interval        int            `json:"interval"`                // Seconds between checks
+	Timeout         int            `json:"timeout"`                 // Seconds to wait for response
+	Retries         int            `json:"retries"`                 // Number of retries before marking unhealthy
+	ExpectedCodes   string         `json:"expectedCodes,omitempty"` // e.g., "200,201,202"
+	CreatedAt       timestamp.Time `json:"createdAt"`
+	UpdatedAt       timestamp.Time `json:"updatedAt"`
 }
 
 // ListHealthChecksOutput represents the response from listing health checks
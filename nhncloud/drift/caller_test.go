@@ -0,0 +1,40 @@
+package drift_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/cloudtest"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/mysql"
+)
+
+func TestCallerOperation(t *testing.T) {
+	srv := cloudtest.New(t)
+	srv.HandleJSON("kr1-rds-mysql.api.nhncloudservice.com/", `{"header": {"isSuccessful": true}, "brandNew": 1}`)
+
+	var reports []drift.Report
+	d := drift.New(drift.Config{OnDrift: func(r drift.Report) { reports = append(reports, r) }})
+	c := mysql.NewClient("kr1", "app-key", credentials.NewStatic("access-key", "secret-key"), false,
+		option.WithHTTPClient(srv.Client()), option.WithDrift(d))
+	ctx := context.Background()
+
+	// ListInstances and ListFlavors come from rdscore operation groups,
+	// DeleteInstance from rdscore.Ops and ListSchemas from mysql itself.
+	c.ListInstances(ctx)
+	c.ListFlavors(ctx)
+	c.DeleteInstance(ctx, "db-1")
+	c.ListSchemas(ctx, "db-1")
+
+	want := []string{"ListInstances", "ListFlavors", "DeleteInstance", "ListSchemas"}
+	if len(reports) != len(want) {
+		t.Fatalf("got %d reports, want %d: %+v", len(reports), len(want), reports)
+	}
+	for i, r := range reports {
+		if r.Operation != want[i] || r.Service != "rds-mysql" {
+			t.Errorf("report %d = %+v, want rds-mysql %s", i, r, want[i])
+		}
+	}
+}
@@ -0,0 +1,327 @@
+// Package drift detects API response fields that the SDK's types do not map.
+//
+// encoding/json silently drops unknown fields, so when NHN Cloud adds or
+// renames a field the SDK keeps working but loses data. A Detector compares
+// every response body with the type it is decoded into and reports each
+// unmapped field by service, operation and JSON path. Reporting never fails
+// the call unless Strict is set, which is meant for tests.
+//
+//	cfg.Drift = drift.New(drift.Config{
+//	    OnDrift: func(r drift.Report) {
+//	        log.Printf("%s %s: unmapped %v", r.Service, r.Operation, r.Paths)
+//	    },
+//	})
+//
+// Setting NHN_SDK_STRICT_DECODE enables detection for every client that has
+// no Detector configured: "log" (or any non-empty value) logs through
+// slog.Default, "fail" additionally returns *errors.DriftError from the call.
+//
+//	NHN_SDK_STRICT_DECODE=fail go test ./...
+//
+// Coverage: every JSON response of the service clients that nhncloud.Client
+// builds, or that are built with option.WithDrift, is checked once when it
+// is received; cached catalog responses are not checked again. Not checked
+// are Object Storage object listings, which are XML, responses Raw decodes
+// into the caller's own types, authentication token responses, and the
+// inside of any type with its own UnmarshalJSON, which accepts any shape.
+// The clients under nhncloud/database/{mysql,mariadb,postgresql} take no
+// Detector and follow NHN_SDK_STRICT_DECODE only.
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+)
+
+// EnvVar is the environment variable that enables detection by default.
+const EnvVar = "NHN_SDK_STRICT_DECODE"
+
+// Report lists the unmapped fields of one response.
+type Report struct {
+	Service   string
+	Operation string
+	// Paths are JSON paths such as "dbInstances[].newField". Array indexes
+	// are collapsed to [] so a field missing on every element is listed once.
+	Paths []string
+}
+
+// Config configures a Detector.
+type Config struct {
+	// OnDrift, if set, is called synchronously for every response with at
+	// least one unmapped field.
+	OnDrift func(Report)
+	// Logger, if set, receives one warning per such response.
+	Logger *slog.Logger
+	// Strict makes Check return *errors.DriftError, failing the call.
+	Strict bool
+}
+
+// Detector checks decoded responses for unmapped fields. It is safe for
+// concurrent use.
+type Detector struct {
+	cfg Config
+}
+
+// New creates a Detector with the given configuration.
+func New(cfg Config) *Detector {
+	return &Detector{cfg: cfg}
+}
+
+var (
+	envOnce     sync.Once
+	envDetector *Detector
+)
+
+// FromEnv returns the Detector selected by NHN_SDK_STRICT_DECODE, or nil
+// when it is unset. The value is read once per process.
+func FromEnv() *Detector {
+	envOnce.Do(func() {
+		switch v := os.Getenv(EnvVar); v {
+		case "", "0", "false", "off":
+		case "fail":
+			envDetector = New(Config{Logger: slog.Default(), Strict: true})
+		default:
+			envDetector = New(Config{Logger: slog.Default()})
+		}
+	})
+	return envDetector
+}
+
+// Check reports the fields of body that v's type does not map. An empty
+// operation is derived from the calling SDK method. Check returns an error
+// only in Strict mode; malformed JSON is left to the decoder to report.
+func (d *Detector) Check(service, operation string, body []byte, v interface{}) error {
+	if d == nil || v == nil || len(body) == 0 {
+		return nil
+	}
+	paths, err := UnknownFields(body, v)
+	if err != nil || len(paths) == 0 {
+		return nil
+	}
+
+	if operation == "" || service == "" {
+		pkg, method := callerOperation()
+		if operation == "" {
+			operation = method
+		}
+		if service == "" {
+			service = pkg
+		}
+	}
+	r := Report{Service: service, Operation: operation, Paths: paths}
+
+	if d.cfg.Logger != nil {
+		d.cfg.Logger.LogAttrs(context.Background(), slog.LevelWarn, "nhncloud: unmapped response fields",
+			slog.String("service", r.Service),
+			slog.String("operation", r.Operation),
+			slog.Any("paths", r.Paths),
+		)
+	}
+	if d.cfg.OnDrift != nil {
+		d.cfg.OnDrift(r)
+	}
+	if d.cfg.Strict {
+		return &errors.DriftError{Service: r.Service, Operation: r.Operation, Paths: r.Paths}
+	}
+	return nil
+}
+
+// UnknownFields returns the sorted JSON paths of body that have no
+// destination in v, which must be what body is decoded into (usually a
+// pointer to a struct). Field names are matched like encoding/json does,
+// including case-insensitive matches and embedded structs.
+func UnknownFields(body []byte, v interface{}) ([]string, error) {
+	var doc interface{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	walk(doc, reflect.TypeOf(v), "", seen)
+
+	paths := make([]string, 0, len(seen))
+	for p := range seen {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+var (
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	rawMessageType  = reflect.TypeOf(json.RawMessage(nil))
+)
+
+func walk(doc interface{}, t reflect.Type, path string, out map[string]bool) {
+	if t == nil {
+		return
+	}
+	for t.Kind() == reflect.Ptr {
+		if t.Implements(unmarshalerType) {
+			return
+		}
+		t = t.Elem()
+	}
+	// Types with their own decoding (time.Time, custom enums, RawMessage)
+	// and interface{} destinations accept any shape.
+	if t == rawMessageType || t.Kind() == reflect.Interface || reflect.PointerTo(t).Implements(unmarshalerType) {
+		return
+	}
+
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Map:
+			for _, child := range node {
+				walk(child, t.Elem(), path+"{}", out)
+			}
+		case reflect.Struct:
+			fields := structFields(t)
+			for key, child := range node {
+				f, ok := lookupField(fields, key)
+				if !ok {
+					out[join(path, key)] = true
+					continue
+				}
+				walk(child, f.typ, join(path, key), out)
+			}
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for _, child := range node {
+				walk(child, t.Elem(), path+"[]", out)
+			}
+		}
+	}
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+type field struct {
+	name string
+	typ  reflect.Type
+}
+
+var fieldCache sync.Map // reflect.Type -> []field
+
+// structFields lists the JSON fields of t, flattening embedded structs the
+// way encoding/json does (outer fields shadow embedded ones).
+func structFields(t reflect.Type) []field {
+	if cached, ok := fieldCache.Load(t); ok {
+		return cached.([]field)
+	}
+	var fields []field
+	names := make(map[string]bool)
+	var collect func(t reflect.Type, depth int)
+	collect = func(t reflect.Type, depth int) {
+		var embedded []reflect.Type
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag := sf.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name, _, _ := strings.Cut(tag, ",")
+			ft := sf.Type
+			if sf.Anonymous && name == "" {
+				for ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct {
+					embedded = append(embedded, ft)
+					continue
+				}
+			}
+			if !sf.IsExported() {
+				continue
+			}
+			if name == "" {
+				name = sf.Name
+			}
+			if !names[name] {
+				names[name] = true
+				fields = append(fields, field{name: name, typ: sf.Type})
+			}
+		}
+		if depth < 8 {
+			for _, et := range embedded {
+				collect(et, depth+1)
+			}
+		}
+	}
+	collect(t, 0)
+	fieldCache.Store(t, fields)
+	return fields
+}
+
+func lookupField(fields []field, key string) (field, bool) {
+	for _, f := range fields {
+		if f.name == key {
+			return f, true
+		}
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.name, key) {
+			return f, true
+		}
+	}
+	return field{}, false
+}
+
+const modulePath = "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/"
+
+// callerOperation returns the package and method name of the innermost SDK
+// service method on the stack, skipping the shared HTTP plumbing and the
+// unexported request and decode helpers of the service clients.
+func callerOperation() (pkg, method string) {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		fr, more := frames.Next()
+		name := fr.Function
+		if strings.HasPrefix(name, modulePath) && !isPlumbing(name) {
+			name = strings.TrimPrefix(name, modulePath)
+			// "rds/mysql.(*Client).ListInstances" -> "mysql", "ListInstances"
+			if i := strings.LastIndex(name, "/"); i >= 0 {
+				name = name[i+1:]
+			}
+			pkg, _, _ = strings.Cut(name, ".")
+			method = name[strings.LastIndex(name, ".")+1:]
+			return pkg, method
+		}
+		if !more {
+			return "", ""
+		}
+	}
+}
+
+func isPlumbing(fn string) bool {
+	if m := fn[strings.LastIndex(fn, ".")+1:]; m != "" && unicode.IsLower(rune(m[0])) {
+		return true
+	}
+	for _, p := range []string{"drift.", "core.", "internal/transport.", "internal/client."} {
+		if strings.HasPrefix(fn, modulePath+p) {
+			return true
+		}
+	}
+	// rdscore's package-level request helpers (Get, CachedGet, ...) are
+	// plumbing; the exported methods of Ops and its operation groups are the
+	// operations the engine clients promote.
+	rest, ok := strings.CutPrefix(fn, modulePath+"internal/rdscore.")
+	return ok && !strings.HasPrefix(rest, "(")
+}
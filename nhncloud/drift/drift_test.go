@@ -0,0 +1,90 @@
+package drift
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+)
+
+type header struct {
+	IsSuccessful bool `json:"isSuccessful"`
+}
+
+type instance struct {
+	ID        string            `json:"dbInstanceId"`
+	Tags      map[string]string `json:"tags"`
+	CreatedAt time.Time         `json:"createdYmdt"`
+	Extra     interface{}       `json:"extra"`
+	Ignored   string            `json:"-"`
+}
+
+type listResponse struct {
+	Header    *header    `json:"header"`
+	Instances []instance `json:"dbInstances"`
+}
+
+type getResponse struct {
+	Header *header `json:"header"`
+	instance
+}
+
+func TestUnknownFields(t *testing.T) {
+	body := []byte(`{
+		"header": {"isSuccessful": true, "resultCode": 0},
+		"dbInstances": [
+			{"dbInstanceId": "a", "tags": {"k": "v"}, "createdYmdt": "2026-01-01T00:00:00Z", "extra": {"x": 1}, "newField": 1},
+			{"DBINSTANCEID": "b", "newField": 2, "Ignored": "x"}
+		],
+		"totalCounts": 2
+	}`)
+
+	got, err := UnknownFields(body, &listResponse{})
+	if err != nil {
+		t.Fatalf("UnknownFields() error: %v", err)
+	}
+	want := []string{"dbInstances[].Ignored", "dbInstances[].newField", "header.resultCode", "totalCounts"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UnknownFields() = %v, want %v", got, want)
+	}
+}
+
+func TestUnknownFieldsEmbedded(t *testing.T) {
+	body := []byte(`{"header": {"isSuccessful": true}, "dbInstanceId": "a", "storageSize": 20}`)
+
+	got, err := UnknownFields(body, &getResponse{})
+	if err != nil {
+		t.Fatalf("UnknownFields() error: %v", err)
+	}
+	if want := []string{"storageSize"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("UnknownFields() = %v, want %v", got, want)
+	}
+}
+
+func TestDetectorCheck(t *testing.T) {
+	body := []byte(`{"dbInstanceId": "a", "newField": 1}`)
+
+	var reports []Report
+	d := New(Config{OnDrift: func(r Report) { reports = append(reports, r) }})
+	if err := d.Check("rds-mysql", "GetInstance", body, &instance{}); err != nil {
+		t.Fatalf("non-strict Check() returned %v", err)
+	}
+	if len(reports) != 1 || reports[0].Service != "rds-mysql" || reports[0].Operation != "GetInstance" ||
+		!reflect.DeepEqual(reports[0].Paths, []string{"newField"}) {
+		t.Errorf("unexpected reports: %+v", reports)
+	}
+
+	strict := New(Config{Strict: true})
+	if err := strict.Check("rds-mysql", "GetInstance", body, &instance{}); !errors.IsDrift(err) {
+		t.Errorf("strict Check() = %v, want DriftError", err)
+	}
+	if err := strict.Check("rds-mysql", "GetInstance", []byte(`{"dbInstanceId": "a"}`), &instance{}); err != nil {
+		t.Errorf("strict Check() on a mapped body = %v, want nil", err)
+	}
+
+	var nilDetector *Detector
+	if err := nilDetector.Check("s", "op", body, &instance{}); err != nil {
+		t.Errorf("nil Detector should be a no-op, got %v", err)
+	}
+}
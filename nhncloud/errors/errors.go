@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 )
 
 // APIError represents an error returned by the NHN Cloud API.
@@ -96,6 +98,45 @@ func (e *TimeoutError) Unwrap() error {
 	return e.Cause
 }
 
+// CircuitOpenError indicates a request was rejected without being sent
+// because the circuit breaker for the endpoint host is open.
+type CircuitOpenError struct {
+	Host       string
+	RetryAfter time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("nhncloud: circuit open for %s, retry after %s", e.Host, e.RetryAfter)
+}
+
+// DriftError indicates a response contained fields the SDK's types do not
+// map. It is only returned when strict decoding is enabled.
+type DriftError struct {
+	Service   string
+	Operation string
+	Paths     []string
+}
+
+func (e *DriftError) Error() string {
+	return fmt.Sprintf("nhncloud: %s %s: unmapped response fields: %s", e.Service, e.Operation, strings.Join(e.Paths, ", "))
+}
+
+// FailedStateError indicates a waiter saw a resource settle in a failed
+// state instead of the one it was waiting for.
+type FailedStateError struct {
+	Resource string
+	ID       string
+	Status   string
+	Reason   string
+}
+
+func (e *FailedStateError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("nhncloud: %s '%s' is %s: %s", e.Resource, e.ID, e.Status, e.Reason)
+	}
+	return fmt.Sprintf("nhncloud: %s '%s' is %s", e.Resource, e.ID, e.Status)
+}
+
 // --- Helper functions for error checking ---
 
 // IsNotFound returns true if the error indicates a resource was not found.
@@ -163,6 +204,24 @@ func IsTimeout(err error) bool {
 	return errors.As(err, &timeoutErr)
 }
 
+// IsCircuitOpen returns true if the error was returned by an open circuit breaker.
+func IsCircuitOpen(err error) bool {
+	var circuitErr *CircuitOpenError
+	return errors.As(err, &circuitErr)
+}
+
+// IsDrift returns true if the error reports unmapped response fields.
+func IsDrift(err error) bool {
+	var driftErr *DriftError
+	return errors.As(err, &driftErr)
+}
+
+// IsFailedState returns true if a waiter stopped because the resource failed.
+func IsFailedState(err error) bool {
+	var stateErr *FailedStateError
+	return errors.As(err, &stateErr)
+}
+
 // --- Error construction from HTTP response ---
 
 // FromHTTPResponse creates an appropriate error from an HTTP response.
@@ -8,6 +8,7 @@ import (
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/endpoint"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
 )
 
 type Client struct {
@@ -16,13 +17,20 @@ type Client struct {
 	httpClient    *client.Client
 	tokenProvider *client.OAuthTokenProvider
 	debug         bool
+	options       []client.ClientOption
 }
 
-func NewClient(region string, creds credentials.Credentials, hc *http.Client, debug bool) *Client {
+func NewClient(region string, creds credentials.Credentials, hc *http.Client, debug bool, opts ...option.Option) *Client {
+	settings := option.Apply(opts...)
+	if settings.HTTPClient == nil {
+		settings.HTTPClient = hc
+	}
+
 	c := &Client{
 		region:      region,
 		credentials: creds,
 		debug:       debug,
+		options:     client.Options(settings, string(endpoint.ServiceIAM)),
 	}
 
 	if creds != nil {
@@ -30,6 +38,7 @@ func NewClient(region string, creds credentials.Credentials, hc *http.Client, de
 			creds.GetAccessKeyID(),
 			creds.GetSecretAccessKey(),
 		)
+		c.tokenProvider.SetHTTPClient(settings.HTTPClient)
 		c.initHTTPClient()
 	}
 
@@ -41,6 +50,7 @@ func (c *Client) initHTTPClient() {
 	opts := []client.ClientOption{
 		client.WithDebug(c.debug),
 	}
+	opts = append(opts, c.options...)
 	c.httpClient = client.NewClient(baseURL, c.tokenProvider, opts...)
 }
 
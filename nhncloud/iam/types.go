@@ -1,12 +1,17 @@
 package iam
 
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
 type Organization struct {
-	ID          string `json:"orgId"`
-	Name        string `json:"orgName"`
-	Description string `json:"description,omitempty"`
-	Status      string `json:"orgStatusCode"`
-	CreatedAt   string `json:"regDateTime,omitempty"`
-	UpdatedAt   string `json:"modDateTime,omitempty"`
+	ID          string         `json:"orgId"`
+	Name        string         `json:"orgName"`
+	Description string         `json:"description,omitempty"`
+	Status      string         `json:"orgStatusCode"`
+	CreatedAt   timestamp.Time `json:"regDateTime,omitempty"`
+	UpdatedAt   timestamp.Time `json:"modDateTime,omitempty"`
 }
 
 type OrganizationWrapper struct {
@@ -14,23 +19,23 @@ type OrganizationWrapper struct {
 }
 
 type Project struct {
-	ID             string `json:"projectId"`
-	Name           string `json:"projectName"`
-	Description    string `json:"description,omitempty"`
-	OrganizationID string `json:"orgId"`
-	Status         string `json:"projectStatusCode"`
-	CreatedAt      string `json:"createdDateTime,omitempty"`
-	UpdatedAt      string `json:"modifiedDateTime,omitempty"`
+	ID             string         `json:"projectId"`
+	Name           string         `json:"projectName"`
+	Description    string         `json:"description,omitempty"`
+	OrganizationID string         `json:"orgId"`
+	Status         string         `json:"projectStatusCode"`
+	CreatedAt      timestamp.Time `json:"createdDateTime,omitempty"`
+	UpdatedAt      timestamp.Time `json:"modifiedDateTime,omitempty"`
 }
 
 type Member struct {
-	ID        string   `json:"uuid"`
-	Email     string   `json:"emailAddress"`
-	Name      string   `json:"memberName"`
-	Status    string   `json:"memberStatus"`
-	Roles     []string `json:"roles,omitempty"`
-	CreatedAt string   `json:"createdDateTime,omitempty"`
-	UpdatedAt string   `json:"modifiedDateTime,omitempty"`
+	ID        string         `json:"uuid"`
+	Email     string         `json:"emailAddress"`
+	Name      string         `json:"memberName"`
+	Status    string         `json:"memberStatus"`
+	Roles     []string       `json:"roles,omitempty"`
+	CreatedAt timestamp.Time `json:"createdDateTime,omitempty"`
+	UpdatedAt timestamp.Time `json:"modifiedDateTime,omitempty"`
 }
 
 type ListOrganizationsOutput struct {
@@ -99,15 +104,15 @@ type UpdateMemberOutput struct {
 
 // Role represents a role in the organization or project
 type Role struct {
-	RoleID          string `json:"roleId"`
-	RoleName        string `json:"roleName"`
-	RoleGroupID     string `json:"roleGroupId,omitempty"`
-	Description     string `json:"description,omitempty"`
-	CategoryKey     string `json:"categoryKey,omitempty"`
-	ExposureOrder   int    `json:"exposureOrder,omitempty"`
-	RegDateTime     string `json:"regDateTime,omitempty"`
-	Assignable      bool   `json:"assignable,omitempty"`
-	RoleApplyPolicy string `json:"roleApplyPolicyCode,omitempty"`
+	RoleID          string         `json:"roleId"`
+	RoleName        string         `json:"roleName"`
+	RoleGroupID     string         `json:"roleGroupId,omitempty"`
+	Description     string         `json:"description,omitempty"`
+	CategoryKey     string         `json:"categoryKey,omitempty"`
+	ExposureOrder   int            `json:"exposureOrder,omitempty"`
+	RegDateTime     timestamp.Time `json:"regDateTime,omitempty"`
+	Assignable      bool           `json:"assignable,omitempty"`
+	RoleApplyPolicy string         `json:"roleApplyPolicyCode,omitempty"`
 }
 
 // ListRolesOutput defines the output for listing roles
@@ -117,13 +122,13 @@ type ListRolesOutput struct {
 
 // RoleGroup represents a role group
 type RoleGroup struct {
-	RoleGroupID   string `json:"roleGroupId"`
-	RoleGroupName string `json:"roleGroupName"`
-	Description   string `json:"description,omitempty"`
-	RoleGroupType string `json:"roleGroupType,omitempty"`
-	CreatedAt     string `json:"regDateTime,omitempty"`
-	UpdatedAt     string `json:"modDateTime,omitempty"`
-	Roles         []Role `json:"roles,omitempty"`
+	RoleGroupID   string         `json:"roleGroupId"`
+	RoleGroupName string         `json:"roleGroupName"`
+	Description   string         `json:"description,omitempty"`
+	RoleGroupType string         `json:"roleGroupType,omitempty"`
+	CreatedAt     timestamp.Time `json:"regDateTime,omitempty"`
+	UpdatedAt     timestamp.Time `json:"modDateTime,omitempty"`
+	Roles         []Role         `json:"roles,omitempty"`
 }
 
 // ListRoleGroupsOutput defines the output for listing role groups
@@ -199,11 +204,11 @@ type ListProjectMembersOutput struct {
 
 // UserAccessKey represents a user access key
 type UserAccessKey struct {
-	UserAccessKeyID string `json:"userAccessKeyId"`
-	SecretAccessKey string `json:"secretAccessKey,omitempty"` // Only returned on create
-	Status          string `json:"userAccessKeyStatusCode"`
-	CreatedAt       string `json:"regDateTime,omitempty"`
-	UpdatedAt       string `json:"modDateTime,omitempty"`
+	UserAccessKeyID string         `json:"userAccessKeyId"`
+	SecretAccessKey secret.String  `json:"secretAccessKey,omitempty"` // Only returned on create
+	Status          string         `json:"userAccessKeyStatusCode"`
+	CreatedAt       timestamp.Time `json:"regDateTime,omitempty"`
+	UpdatedAt       timestamp.Time `json:"modDateTime,omitempty"`
 }
 
 // ListUserAccessKeysOutput defines the output for listing user access keys
@@ -223,10 +228,10 @@ type UpdateUserAccessKeyInput struct {
 
 // ProjectAppKey represents a project app key
 type ProjectAppKey struct {
-	AppKey      string `json:"appKey"`
-	AppKeyName  string `json:"appKeyName,omitempty"`
-	Description string `json:"description,omitempty"`
-	CreatedAt   string `json:"regDateTime,omitempty"`
+	AppKey      string         `json:"appKey"`
+	AppKeyName  string         `json:"appKeyName,omitempty"`
+	Description string         `json:"description,omitempty"`
+	CreatedAt   timestamp.Time `json:"regDateTime,omitempty"`
 }
 
 // ListProjectAppKeysOutput defines the output for listing project app keys
@@ -247,11 +252,11 @@ type CreateProjectAppKeyOutput struct {
 
 // Product represents a service/product
 type Product struct {
-	ProductID   string `json:"productId"`
-	ProductName string `json:"productName"`
-	StatusCode  string `json:"statusCode"`
-	AppKey      string `json:"appKey,omitempty"`
-	SecretKey   string `json:"secretKey,omitempty"`
+	ProductID   string        `json:"productId"`
+	ProductName string        `json:"productName"`
+	StatusCode  string        `json:"statusCode"`
+	AppKey      string        `json:"appKey,omitempty"`
+	SecretKey   secret.String `json:"secretKey,omitempty"`
 }
 
 // ListProductsOutput defines the output for listing products
@@ -261,9 +266,9 @@ type ListProductsOutput struct {
 
 // EnableProductOutput defines the output for enabling a product
 type EnableProductOutput struct {
-	AppKey        string   `json:"appKey"`
-	SecretKey     string   `json:"secretKey,omitempty"`
-	ParentProduct *Product `json:"parentProduct,omitempty"`
+	AppKey        string        `json:"appKey"`
+	SecretKey     secret.String `json:"secretKey,omitempty"`
+	ParentProduct *Product      `json:"parentProduct,omitempty"`
 }
 
 // Governance represents governance settings
@@ -293,11 +298,11 @@ type ListDomainsOutput struct {
 
 // IPACL represents an IP ACL entry
 type IPACL struct {
-	ACLID       string `json:"aclId"`
-	IPAddress   string `json:"ipAddress"`
-	Description string `json:"description,omitempty"`
-	TypeCode    string `json:"typeCode"` // ALLOW, DENY
-	CreatedAt   string `json:"regDateTime,omitempty"`
+	ACLID       string         `json:"aclId"`
+	IPAddress   string         `json:"ipAddress"`
+	Description string         `json:"description,omitempty"`
+	TypeCode    string         `json:"typeCode"` // ALLOW, DENY
+	CreatedAt   timestamp.Time `json:"regDateTime,omitempty"`
 }
 
 // ListIPACLOutput defines the output for listing IP ACL
@@ -358,16 +363,16 @@ type GetIAMPasswordRuleOutput struct {
 
 // IAMMember represents an IAM account member
 type IAMMember struct {
-	UUID         string `json:"uuid"`
-	MemberID     string `json:"memberId"`
-	MemberName   string `json:"memberName"`
-	Email        string `json:"emailAddress,omitempty"`
-	Status       string `json:"memberStatusCode"`
-	CountryCode  string `json:"countryCode,omitempty"`
-	LanguageCode string `json:"languageCode,omitempty"`
-	Description  string `json:"description,omitempty"`
-	CreatedAt    string `json:"regDateTime,omitempty"`
-	LastLoginAt  string `json:"lastLoginDateTime,omitempty"`
+	UUID         string         `json:"uuid"`
+	MemberID     string         `json:"memberId"`
+	MemberName   string         `json:"memberName"`
+	Email        string         `json:"emailAddress,omitempty"`
+	Status       string         `json:"memberStatusCode"`
+	CountryCode  string         `json:"countryCode,omitempty"`
+	LanguageCode string         `json:"languageCode,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	CreatedAt    timestamp.Time `json:"regDateTime,omitempty"`
+	LastLoginAt  timestamp.Time `json:"lastLoginDateTime,omitempty"`
 }
 
 // ListIAMMembersOutput defines the output for listing IAM members
@@ -386,7 +391,7 @@ type CreateIAMMemberInput struct {
 	MemberID     string           `json:"memberId"`
 	MemberName   string           `json:"memberName,omitempty"`
 	Email        string           `json:"emailAddress,omitempty"`
-	Password     string           `json:"password,omitempty"`
+	Password     secret.String    `json:"password,omitempty"`
 	CountryCode  string           `json:"countryCode,omitempty"`
 	LanguageCode string           `json:"languageCode,omitempty"`
 	Description  string           `json:"description,omitempty"`
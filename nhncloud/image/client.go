@@ -9,6 +9,7 @@ import (
 
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
 )
 
 type Client struct {
@@ -17,13 +18,20 @@ type Client struct {
 	httpClient    *client.Client
 	tokenProvider *client.IdentityTokenProvider
 	debug         bool
+	options       []client.ClientOption
 }
 
-func NewClient(region string, creds credentials.IdentityCredentials, hc *http.Client, debug bool) *Client {
+func NewClient(region string, creds credentials.IdentityCredentials, hc *http.Client, debug bool, opts ...option.Option) *Client {
+	settings := option.Apply(opts...)
+	if settings.HTTPClient == nil {
+		settings.HTTPClient = hc
+	}
+
 	c := &Client{
 		region:      region,
 		credentials: creds,
 		debug:       debug,
+		options:     client.Options(settings, "image"),
 	}
 
 	if creds != nil {
@@ -32,6 +40,7 @@ func NewClient(region string, creds credentials.IdentityCredentials, hc *http.Cl
 			creds.GetUsername(),
 			creds.GetPassword(),
 		)
+		c.tokenProvider.SetHTTPClient(settings.HTTPClient)
 	}
 
 	return c
@@ -58,6 +67,7 @@ func (c *Client) ensureClient(ctx context.Context) error {
 	opts := []client.ClientOption{
 		client.WithDebug(c.debug),
 	}
+	opts = append(opts, c.options...)
 	c.httpClient = client.NewClient(baseURL, c.tokenProvider, opts...)
 
 	return nil
@@ -1,6 +1,6 @@
 package image
 
-import "time"
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
 
 type Image struct {
 	ID              string    `json:"id"`
@@ -27,8 +27,8 @@ type Image struct {
 	Self            string    `json:"self"`
 	File            string    `json:"file"`
 	Schema          string    `json:"schema"`
-	CreatedAt       time.Time              `json:"created_at"`
-	UpdatedAt       time.Time              `json:"updated_at"`
+	CreatedAt       timestamp.Time         `json:"created_at"`
+	UpdatedAt       timestamp.Time         `json:"updated_at"`
 	Properties      map[string]interface{} `json:"properties,omitempty"`
 }
 
@@ -83,12 +83,12 @@ type UpdateImageOp struct {
 }
 
 type ImageMember struct {
-	ImageID   string    `json:"image_id"`
-	MemberID  string    `json:"member_id"`
-	Status    string    `json:"status"`
-	Schema    string    `json:"schema"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ImageID   string         `json:"image_id"`
+	MemberID  string         `json:"member_id"`
+	Status    string         `json:"status"`
+	Schema    string         `json:"schema"`
+	CreatedAt timestamp.Time `json:"created_at"`
+	UpdatedAt timestamp.Time `json:"updated_at"`
 }
 
 type ListImageMembersOutput struct {
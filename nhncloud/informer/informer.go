@@ -0,0 +1,326 @@
+// Package informer watches resources by polling their List or Get calls and
+// emits an event for every change, in the manner of Kubernetes informers.
+//
+// An Informer lists its Source every Interval, compares each resource with
+// the one it saw last, by key, and sends Added, Modified and Deleted events
+// on its channel. Its store always holds the latest observation, so
+// handlers can look up related resources without another call.
+//
+//	inf := informer.New(informer.MySQLInstances(client.MySQL()), informer.Options{
+//	    Interval:     30 * time.Second,
+//	    ResyncPeriod: 10 * time.Minute,
+//	})
+//	go inf.Run(ctx)
+//	for e := range inf.Events() {
+//	    if e.Type == informer.Modified && e.Old.DBInstanceStatus == mysql.InstanceStatusAvailable &&
+//	        e.Object.DBInstanceStatus != mysql.InstanceStatusAvailable {
+//	        log.Printf("%s left AVAILABLE: %s", e.Object.DBInstanceName, e.Object.DBInstanceStatus)
+//	    }
+//	}
+//
+// A failed list leaves the store as it was, so an outage never reads as
+// every resource being deleted; the error goes to Options.OnError and the
+// next poll tries again.
+package informer
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+// EventType is the kind of change an Event reports.
+type EventType string
+
+const (
+	Added    EventType = "Added"
+	Modified EventType = "Modified"
+	Deleted  EventType = "Deleted"
+)
+
+// Event is a change to one resource.
+type Event[T any] struct {
+	Type EventType
+	Key  string
+	// Object is the resource as last observed; for Deleted, as it was
+	// before it disappeared.
+	Object T
+	// Old is the previous observation of a Modified resource.
+	Old T
+	// Resync marks a Modified event sent by a resync rather than a change;
+	// Old and Object are then the same.
+	Resync bool
+}
+
+// Source lists the resources to watch and keys them.
+type Source[T any] struct {
+	List func(ctx context.Context) ([]T, error)
+	// Key returns the resource's unique key, usually its ID.
+	Key func(T) string
+}
+
+// Defaults for Options.
+const (
+	DefaultInterval = 30 * time.Second
+	DefaultBuffer   = 100
+)
+
+// Options configures an Informer.
+type Options struct {
+	// Interval is the time between lists. Zero means DefaultInterval.
+	Interval time.Duration
+	// Jitter lengthens each interval by a random fraction of it, up to
+	// Jitter (0 to 1), so that informers started together spread their
+	// calls.
+	Jitter float64
+	// ResyncPeriod, if set, re-sends every stored resource as a Modified
+	// event with Resync set that often, so handlers can reconcile state
+	// they may have missed or failed to act on.
+	ResyncPeriod time.Duration
+	// Buffer is the capacity of the event channel. Zero means
+	// DefaultBuffer. Run blocks while the channel is full, so a slow
+	// consumer delays the next poll rather than losing events.
+	Buffer int
+	// Equal, if set, decides whether two observations of a resource are
+	// the same. The default is reflect.DeepEqual.
+	Equal func(a, b interface{}) bool
+	// OnError, if set, is called with every failed list.
+	OnError func(error)
+}
+
+// Informer watches one Source. Create it with New.
+type Informer[T any] struct {
+	src    Source[T]
+	opts   Options
+	events chan Event[T]
+
+	mu      sync.RWMutex
+	store   map[string]T
+	synced  bool
+	lastErr error
+	running bool
+}
+
+// New returns an Informer for src. Start it with Run.
+func New[T any](src Source[T], opts Options) *Informer[T] {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+	if opts.Buffer <= 0 {
+		opts.Buffer = DefaultBuffer
+	}
+	if opts.Equal == nil {
+		opts.Equal = reflect.DeepEqual
+	}
+	return &Informer[T]{
+		src:    src,
+		opts:   opts,
+		events: make(chan Event[T], opts.Buffer),
+		store:  map[string]T{},
+	}
+}
+
+// Events returns the channel events are sent on. It is closed when Run
+// returns.
+func (i *Informer[T]) Events() <-chan Event[T] {
+	return i.events
+}
+
+// errRunning is returned by a second call to Run.
+var errRunning = errors.New("informer: Run called twice")
+
+// Run lists the source immediately and then every interval until ctx ends,
+// sending events for the changes. It returns ctx.Err(). The first
+// successful list sends an Added event for every resource.
+func (i *Informer[T]) Run(ctx context.Context) error {
+	i.mu.Lock()
+	if i.running {
+		i.mu.Unlock()
+		return errRunning
+	}
+	i.running = true
+	i.mu.Unlock()
+	defer close(i.events)
+
+	var resync <-chan time.Time
+	if i.opts.ResyncPeriod > 0 {
+		t := time.NewTicker(i.opts.ResyncPeriod)
+		defer t.Stop()
+		resync = t.C
+	}
+	poll := time.NewTimer(0)
+	defer poll.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-poll.C:
+			if err := i.observe(ctx); err != nil && ctx.Err() == nil {
+				if i.opts.OnError != nil {
+					i.opts.OnError(err)
+				}
+			}
+			poll.Reset(i.next())
+		case <-resync:
+			i.resync(ctx)
+		}
+	}
+}
+
+// next returns the wait before the next list.
+func (i *Informer[T]) next() time.Duration {
+	d := i.opts.Interval
+	if i.opts.Jitter > 0 {
+		d += time.Duration(rand.Float64() * i.opts.Jitter * float64(d))
+	}
+	return d
+}
+
+// observe lists the source, updates the store and sends the changes.
+func (i *Informer[T]) observe(ctx context.Context) error {
+	items, err := i.src.List(ctx)
+	i.mu.Lock()
+	i.lastErr = err
+	if err != nil {
+		i.mu.Unlock()
+		return err
+	}
+	seen := make(map[string]T, len(items))
+	var events []Event[T]
+	for _, item := range items {
+		key := i.src.Key(item)
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = item
+		old, ok := i.store[key]
+		switch {
+		case !ok:
+			events = append(events, Event[T]{Type: Added, Key: key, Object: item})
+		case !i.opts.Equal(old, item):
+			events = append(events, Event[T]{Type: Modified, Key: key, Object: item, Old: old})
+		}
+	}
+	var gone []string
+	for key := range i.store {
+		if _, ok := seen[key]; !ok {
+			gone = append(gone, key)
+		}
+	}
+	sort.Strings(gone)
+	for _, key := range gone {
+		events = append(events, Event[T]{Type: Deleted, Key: key, Object: i.store[key]})
+	}
+	i.store = seen
+	i.synced = true
+	i.mu.Unlock()
+	return i.send(ctx, events)
+}
+
+// resync re-sends every stored resource.
+func (i *Informer[T]) resync(ctx context.Context) {
+	i.mu.RLock()
+	keys := make([]string, 0, len(i.store))
+	for key := range i.store {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	events := make([]Event[T], len(keys))
+	for n, key := range keys {
+		obj := i.store[key]
+		events[n] = Event[T]{Type: Modified, Key: key, Object: obj, Old: obj, Resync: true}
+	}
+	i.mu.RUnlock()
+	i.send(ctx, events)
+}
+
+func (i *Informer[T]) send(ctx context.Context, events []Event[T]) error {
+	for _, e := range events {
+		select {
+		case i.events <- e:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// HasSynced reports whether the first list has succeeded, so that the
+// store reflects the source.
+func (i *Informer[T]) HasSynced() bool {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.synced
+}
+
+// LastError returns the error of the latest list, or nil if it succeeded.
+func (i *Informer[T]) LastError() error {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.lastErr
+}
+
+// Get returns the stored resource with key.
+func (i *Informer[T]) Get(key string) (T, bool) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	obj, ok := i.store[key]
+	return obj, ok
+}
+
+// List returns the stored resources, ordered by key.
+func (i *Informer[T]) List() []T {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	keys := make([]string, 0, len(i.store))
+	for key := range i.store {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	out := make([]T, len(keys))
+	for n, key := range keys {
+		out[n] = i.store[key]
+	}
+	return out
+}
+
+// Handlers dispatches events to callbacks, for controllers that prefer
+// them to a switch. Nil callbacks are skipped.
+//
+//	h := informer.Handlers[compute.Server]{
+//	    OnUpdate: func(old, s compute.Server) {
+//	        if s.Status == compute.ServerStatusError && old.Status != s.Status {
+//	            alert(s)
+//	        }
+//	    },
+//	}
+//	for e := range inf.Events() {
+//	    h.Handle(e)
+//	}
+type Handlers[T any] struct {
+	OnAdd    func(obj T)
+	OnUpdate func(old, obj T)
+	OnDelete func(obj T)
+}
+
+// Handle calls the callback for e's type.
+func (h Handlers[T]) Handle(e Event[T]) {
+	switch e.Type {
+	case Added:
+		if h.OnAdd != nil {
+			h.OnAdd(e.Object)
+		}
+	case Modified:
+		if h.OnUpdate != nil {
+			h.OnUpdate(e.Old, e.Object)
+		}
+	case Deleted:
+		if h.OnDelete != nil {
+			h.OnDelete(e.Object)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package informer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+type thing struct {
+	ID     string
+	Status string
+}
+
+// scripted returns a source that returns each observation in turn and
+// then the last one forever; an empty ID in an observation's first item
+// makes that list fail.
+func scripted(observations ...[]thing) Source[thing] {
+	var mu sync.Mutex
+	n := 0
+	return Source[thing]{
+		List: func(ctx context.Context) ([]thing, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			obs := observations[n]
+			if n < len(observations)-1 {
+				n++
+			}
+			if len(obs) > 0 && obs[0].ID == "" {
+				return nil, errors.New("service unavailable")
+			}
+			return obs, nil
+		},
+		Key: func(t thing) string { return t.ID },
+	}
+}
+
+func collect(t *testing.T, inf *Informer[thing], n int) []string {
+	t.Helper()
+	var got []string
+	timeout := time.After(5 * time.Second)
+	for len(got) < n {
+		select {
+		case e := <-inf.Events():
+			s := fmt.Sprintf("%s %s %s", e.Type, e.Key, e.Object.Status)
+			if e.Type == Modified {
+				s += " from " + e.Old.Status
+			}
+			if e.Resync {
+				s += " (resync)"
+			}
+			got = append(got, s)
+		case <-timeout:
+			t.Fatalf("timed out after %d events: %v", len(got), got)
+		}
+	}
+	return got
+}
+
+func TestInformerEmitsChanges(t *testing.T) {
+	var errs []error
+	var mu sync.Mutex
+	inf := New(scripted(
+		[]thing{{"a", "BUILD"}, {"b", "ACTIVE"}},
+		[]thing{{"a", "BUILD"}, {"b", "ACTIVE"}},
+		[]thing{{}},
+		[]thing{{"a", "ACTIVE"}, {"c", "BUILD"}},
+	), Options{Interval: time.Millisecond, Jitter: 0.5, OnError: func(err error) {
+		mu.Lock()
+		errs = append(errs, err)
+		mu.Unlock()
+	}})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error)
+	go func() { done <- inf.Run(ctx) }()
+
+	got := collect(t, inf, 5)
+	want := []string{
+		"Added a BUILD",
+		"Added b ACTIVE",
+		// The failed list in between does not delete anything.
+		"Modified a ACTIVE from BUILD",
+		"Added c BUILD",
+		"Deleted b ACTIVE",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %q, want %q", got, want)
+	}
+	if !inf.HasSynced() {
+		t.Error("not synced")
+	}
+	if obj, ok := inf.Get("a"); !ok || obj.Status != "ACTIVE" {
+		t.Errorf("Get(a) = %v, %v", obj, ok)
+	}
+	if list := inf.List(); len(list) != 2 || list[1].ID != "c" {
+		t.Errorf("List() = %v", list)
+	}
+	mu.Lock()
+	if len(errs) != 1 {
+		t.Errorf("errors = %v", errs)
+	}
+	mu.Unlock()
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Run = %v", err)
+	}
+	if _, ok := <-inf.Events(); ok {
+		t.Error("events channel still open")
+	}
+	if err := inf.Run(context.Background()); err == nil {
+		t.Error("expected an error from a second Run")
+	}
+}
+
+func TestInformerResync(t *testing.T) {
+	inf := New(scripted([]thing{{"a", "ACTIVE"}}), Options{Interval: time.Hour, ResyncPeriod: time.Millisecond})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go inf.Run(ctx)
+
+	got := collect(t, inf, 2)
+	want := []string{"Added a ACTIVE", "Modified a ACTIVE from ACTIVE (resync)"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %q, want %q", got, want)
+	}
+
+	var updated []string
+	Handlers[thing]{OnUpdate: func(old, obj thing) { updated = append(updated, obj.ID) }}.Handle(<-inf.Events())
+	if !reflect.DeepEqual(updated, []string{"a"}) {
+		t.Errorf("OnUpdate calls = %v", updated)
+	}
+}
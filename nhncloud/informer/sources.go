@@ -0,0 +1,94 @@
+package informer
+
+import (
+	"context"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/compute"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/container/nks"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/waiter"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/mysql"
+)
+
+// Servers watches the compute instances, keyed by ID.
+func Servers(c *compute.Client) Source[compute.Server] {
+	return Source[compute.Server]{
+		List: func(ctx context.Context) ([]compute.Server, error) {
+			out, err := c.ListServers(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return out.Servers, nil
+		},
+		Key: func(s compute.Server) string { return s.ID },
+	}
+}
+
+// MySQLInstances watches the RDS for MySQL instances, keyed by ID.
+func MySQLInstances(c *mysql.Client) Source[mysql.DatabaseInstance] {
+	return Source[mysql.DatabaseInstance]{
+		List: func(ctx context.Context) ([]mysql.DatabaseInstance, error) {
+			out, err := c.ListInstances(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return out.DBInstances, nil
+		},
+		Key: func(db mysql.DatabaseInstance) string { return db.DBInstanceID },
+	}
+}
+
+// Clusters watches the NKS clusters, keyed by ID.
+func Clusters(c *nks.Client) Source[nks.Cluster] {
+	return Source[nks.Cluster]{
+		List: func(ctx context.Context) ([]nks.Cluster, error) {
+			out, err := c.ListClusters(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return out.Clusters, nil
+		},
+		Key: func(cl nks.Cluster) string { return cl.ID },
+	}
+}
+
+// NodeGroups watches the node groups of an NKS cluster, keyed by ID. A
+// node group has finished scaling when a Modified event shows its status
+// settle with a new node count.
+func NodeGroups(c *nks.Client, clusterID string) Source[nks.NodeGroup] {
+	return Source[nks.NodeGroup]{
+		List: func(ctx context.Context) ([]nks.NodeGroup, error) {
+			out, err := c.ListNodeGroups(ctx, clusterID)
+			if err != nil {
+				return nil, err
+			}
+			return out.NodeGroups, nil
+		},
+		Key: func(ng nks.NodeGroup) string { return ng.ID },
+	}
+}
+
+// FromGet watches a single resource through its Get call, keyed by key. A 404
+// reads as the resource being deleted.
+//
+//	src := informer.FromGet("db-1", func(ctx context.Context) (mysql.DatabaseInstance, error) {
+//	    out, err := client.MySQL().GetInstance(ctx, "db-1")
+//	    if err != nil {
+//	        return mysql.DatabaseInstance{}, err
+//	    }
+//	    return out.DatabaseInstance, nil
+//	})
+func FromGet[T any](key string, get func(ctx context.Context) (T, error)) Source[T] {
+	return Source[T]{
+		List: func(ctx context.Context) ([]T, error) {
+			obj, err := get(ctx)
+			if waiter.IsNotFound(err) {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			return []T{obj}, nil
+		},
+		Key: func(T) string { return key },
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 // EnvVar is the environment variable that, when non-empty, enables capture.
@@ -87,7 +89,8 @@ func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 	}
 	name := fmt.Sprintf("%s_%s_%d.json", req.Method, slug, time.Now().UnixNano())
 	if mkErr := os.MkdirAll(dir, 0o755); mkErr == nil {
-		_ = os.WriteFile(filepath.Join(dir, name), body, 0o644)
+		// Token and secret responses pass through here too.
+		_ = os.WriteFile(filepath.Join(dir, name), secret.RedactJSON(body), 0o644)
 	}
 	return resp, nil
 }
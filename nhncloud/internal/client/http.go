@@ -11,6 +11,12 @@ import (
 	"path"
 	"strings"
 	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cache"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/circuitbreaker"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 type APIError struct {
@@ -32,6 +38,18 @@ type Client struct {
 	TokenProvider TokenProvider
 	Debug         bool
 	UserAgent     string
+
+	// Cache and Service enable CachedGET; successful mutating requests
+	// drop every cached entry of Service.
+	Cache   *cache.Cache
+	Service string
+
+	// Drift, if set, reports response fields the decoded types do not map.
+	// It defaults to the detector selected by NHN_SDK_STRICT_DECODE.
+	Drift *drift.Detector
+
+	// Breaker, if set, guards every request, keyed by the endpoint host.
+	Breaker *circuitbreaker.Breaker
 }
 
 type ClientOption func(*Client)
@@ -54,11 +72,79 @@ func WithUserAgent(ua string) ClientOption {
 	}
 }
 
+func WithCache(cc *cache.Cache, service string) ClientOption {
+	return func(c *Client) {
+		c.Cache = cc
+		c.Service = service
+	}
+}
+
+func WithDrift(d *drift.Detector, service string) ClientOption {
+	return func(c *Client) {
+		c.Drift = d
+		c.Service = service
+	}
+}
+
+// WithCircuitBreaker guards every request with b. While the circuit of the
+// endpoint host is open, Request fails with *errors.CircuitOpenError
+// without sending anything.
+func WithCircuitBreaker(b *circuitbreaker.Breaker) ClientOption {
+	return func(c *Client) {
+		c.Breaker = b
+	}
+}
+
+// Options translates the public settings into client options for service.
+func Options(s option.Settings, service string) []ClientOption {
+	var opts []ClientOption
+	if s.HTTPClient != nil {
+		opts = append(opts, WithHTTPClient(s.HTTPClient))
+	}
+	if s.CircuitBreaker != nil {
+		opts = append(opts, WithCircuitBreaker(s.CircuitBreaker))
+	}
+	if s.Cache != nil {
+		opts = append(opts, WithCache(s.Cache, service))
+	}
+	if s.Drift != nil {
+		opts = append(opts, WithDrift(s.Drift, service))
+	}
+	return opts
+}
+
+// HTTPClient returns the http.Client for a service that sends requests
+// itself instead of through Client: s.HTTPClient, or fallback when it is
+// nil, guarded by s.CircuitBreaker when one is set.
+func HTTPClient(s option.Settings, fallback *http.Client) *http.Client {
+	hc := s.HTTPClient
+	if hc == nil {
+		hc = fallback
+	}
+	if s.CircuitBreaker == nil {
+		return hc
+	}
+	guarded := *hc
+	guarded.Transport = s.CircuitBreaker.Transport(hc.Transport)
+	return &guarded
+}
+
+// Drift returns the drift detector for a service that decodes responses
+// itself instead of through Client: s.Drift, or the default that
+// NHN_SDK_STRICT_DECODE selects.
+func Drift(s option.Settings) *drift.Detector {
+	if s.Drift != nil {
+		return s.Drift
+	}
+	return drift.FromEnv()
+}
+
 func NewClient(baseURL string, tokenProvider TokenProvider, opts ...ClientOption) *Client {
 	c := &Client{
 		BaseURL:       strings.TrimSuffix(baseURL, "/"),
 		TokenProvider: tokenProvider,
 		UserAgent:     "nhn-cloud-sdk-go/0.1.0",
+		Drift:         drift.FromEnv(),
 		HTTPClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
@@ -135,16 +221,27 @@ func (c *Client) Request(ctx context.Context, method, endpoint string, body inte
 		c.debugRequest(req, body)
 	}
 
+	if c.Breaker != nil {
+		if err := c.Breaker.Allow(u.Host); err != nil {
+			return err
+		}
+	}
+
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
+		c.record(ctx, u.Host, false)
 		return fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
+		c.record(ctx, u.Host, false)
 		return fmt.Errorf("failed to read response body: %w", err)
 	}
+	// Only failures that say the endpoint itself is unhealthy count against
+	// the circuit; 4xx means it answered fine.
+	c.record(ctx, u.Host, resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests)
 
 	if c.Debug {
 		c.debugResponse(resp, respBody)
@@ -179,7 +276,14 @@ func (c *Client) Request(ctx context.Context, method, endpoint string, body inte
 		return apiError
 	}
 
+	if c.Cache != nil && method != http.MethodGet {
+		c.Cache.InvalidateService(c.Service)
+	}
+
 	if result != nil && len(respBody) > 0 {
+		if err := c.Drift.Check(c.Service, "", respBody, result); err != nil {
+			return err
+		}
 		if err := json.Unmarshal(respBody, result); err != nil {
 			return fmt.Errorf("failed to unmarshal response: %w", err)
 		}
@@ -188,6 +292,52 @@ func (c *Client) Request(ctx context.Context, method, endpoint string, body inte
 	return nil
 }
 
+// record reports the outcome of a request to the breaker. A request the
+// caller gave up on says nothing about the endpoint and only releases its
+// probe slot.
+func (c *Client) record(ctx context.Context, host string, success bool) {
+	switch {
+	case c.Breaker == nil:
+	case ctx.Err() != nil:
+		c.Breaker.Release(host)
+	default:
+		c.Breaker.Record(host, success)
+	}
+}
+
+// CachedGET performs a GET for a catalog operation, serving the response
+// from c.Cache when possible. Without a cache it is equivalent to GET.
+func (c *Client) CachedGET(ctx context.Context, operation, endpoint string, result interface{}) error {
+	if c.Cache == nil || c.Cache.TTL(c.Service, operation) <= 0 {
+		return c.GET(ctx, endpoint, result)
+	}
+	var identity string
+	if p, ok := c.TokenProvider.(interface{ Identity() string }); ok {
+		identity = p.Identity()
+	}
+	key := cache.Key(identity, c.BaseURL+endpoint)
+	if body, ok := c.Cache.Get(c.Service, operation, key); ok {
+		return json.Unmarshal(body, result)
+	}
+	gen := c.Cache.Generation(c.Service)
+
+	var raw json.RawMessage
+	if err := c.GET(ctx, endpoint, &raw); err != nil {
+		return err
+	}
+	if len(raw) == 0 {
+		return nil
+	}
+	if err := c.Drift.Check(c.Service, operation, raw, result); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw, result); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	c.Cache.SetIfCurrent(c.Service, operation, key, raw, gen)
+	return nil
+}
+
 func (c *Client) GET(ctx context.Context, endpoint string, result interface{}) error {
 	return c.Request(ctx, http.MethodGet, endpoint, nil, result)
 }
@@ -231,12 +381,12 @@ func (c *Client) debugRequest(req *http.Request, body interface{}) {
 	if body != nil {
 		switch v := body.(type) {
 		case string:
-			fmt.Printf("Body: %s\n", v)
+			fmt.Printf("Body: %s\n", secret.RedactJSON([]byte(v)))
 		case url.Values:
-			fmt.Printf("Body: %s\n", v.Encode())
+			fmt.Printf("Body: %s\n", secret.RedactForm(v).Encode())
 		default:
 			if jsonData, err := json.MarshalIndent(body, "", "  "); err == nil {
-				fmt.Printf("Body: %s\n", string(jsonData))
+				fmt.Printf("Body: %s\n", secret.RedactJSON(jsonData))
 			} else {
 				fmt.Printf("Body: %+v\n", body)
 			}
@@ -257,6 +407,7 @@ func (c *Client) debugResponse(resp *http.Response, body []byte) {
 	}
 
 	if len(body) > 0 {
+		body = secret.RedactJSON(body)
 		var jsonData interface{}
 		if json.Unmarshal(body, &jsonData) == nil {
 			if prettyJSON, err := json.MarshalIndent(jsonData, "", "  "); err == nil {
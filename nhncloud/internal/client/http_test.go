@@ -5,7 +5,13 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cache"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/circuitbreaker"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
 )
 
 type mockTokenProvider struct {
@@ -243,3 +249,199 @@ func TestAPIErrorString(t *testing.T) {
 		})
 	}
 }
+
+func TestClientCachedGET(t *testing.T) {
+	var gets int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			gets++
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"name": "m2.c1m2"})
+	}))
+	defer server.Close()
+
+	cc := cache.New(cache.Config{})
+	client := NewClient(server.URL, &mockTokenProvider{token: "test-token"}, WithCache(cc, "compute"))
+
+	for i := 0; i < 2; i++ {
+		var result map[string]string
+		if err := client.CachedGET(context.Background(), cache.OpListFlavors, "/flavors/detail", &result); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result["name"] != "m2.c1m2" {
+			t.Errorf("expected m2.c1m2, got %s", result["name"])
+		}
+	}
+	if gets != 1 {
+		t.Errorf("expected 1 GET, got %d", gets)
+	}
+
+	if err := client.POST(context.Background(), "/flavors", map[string]string{}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result map[string]string
+	if err := client.CachedGET(context.Background(), cache.OpListFlavors, "/flavors/detail", &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gets != 2 {
+		t.Errorf("expected mutation to invalidate the cache, got %d GETs", gets)
+	}
+}
+
+func TestClientCachedGETDiscardsRacedResponse(t *testing.T) {
+	cc := cache.New(cache.Config{})
+	var images *Client
+	var gets int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			gets++
+			if gets == 1 {
+				// An image is deleted through the image service while
+				// compute's listing is in flight.
+				if err := images.DELETE(r.Context(), "/v2/images/img-1", nil); err != nil {
+					t.Errorf("DELETE: %v", err)
+				}
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"name": "ubuntu"})
+	}))
+	defer server.Close()
+
+	compute := NewClient(server.URL, &mockTokenProvider{token: "test-token"}, WithCache(cc, "compute"))
+	images = NewClient(server.URL, &mockTokenProvider{token: "test-token"}, WithCache(cc, "image"))
+
+	for i := 0; i < 3; i++ {
+		var result map[string]string
+		if err := compute.CachedGET(context.Background(), cache.OpListImages, "/images", &result); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if gets != 2 {
+		t.Errorf("expected the raced response to be discarded and the next one cached, got %d GETs", gets)
+	}
+}
+
+type tenantTokenProvider struct {
+	mockTokenProvider
+	tenant string
+}
+
+func (p *tenantTokenProvider) Identity() string { return p.tenant }
+
+func TestClientCachedGETScopedByTenant(t *testing.T) {
+	var gets int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gets++
+		json.NewEncoder(w).Encode(map[string]string{"name": r.Header.Get("Authorization")})
+	}))
+	defer server.Close()
+
+	cc := cache.New(cache.Config{})
+	a := NewClient(server.URL, &tenantTokenProvider{mockTokenProvider{"a"}, "tenant-a"}, WithCache(cc, "compute"))
+	b := NewClient(server.URL, &tenantTokenProvider{mockTokenProvider{"b"}, "tenant-b"}, WithCache(cc, "compute"))
+
+	for _, c := range []*Client{a, b, a, b} {
+		var result map[string]string
+		if err := c.CachedGET(context.Background(), cache.OpListImages, "/images", &result); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := "Bearer " + c.TokenProvider.(*tenantTokenProvider).token; result["name"] != want {
+			t.Errorf("tenant %s got the images of %s", want, result["name"])
+		}
+	}
+	if gets != 2 {
+		t.Errorf("expected one GET per tenant, got %d", gets)
+	}
+}
+
+func TestClientDrift(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name": "vm", "newField": true}`))
+	}))
+	defer server.Close()
+
+	var result struct {
+		Name string `json:"name"`
+	}
+
+	var reports []drift.Report
+	client := NewClient(server.URL, nil, WithDrift(drift.New(drift.Config{
+		OnDrift: func(r drift.Report) { reports = append(reports, r) },
+	}), "compute"))
+	if err := client.GET(context.Background(), "/servers/1", &result); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Name != "vm" {
+		t.Errorf("expected vm, got %s", result.Name)
+	}
+	if len(reports) != 1 || reports[0].Service != "compute" || len(reports[0].Paths) != 1 || reports[0].Paths[0] != "newField" {
+		t.Errorf("unexpected drift reports: %+v", reports)
+	}
+
+	strict := NewClient(server.URL, nil, WithDrift(drift.New(drift.Config{Strict: true}), "compute"))
+	if err := strict.GET(context.Background(), "/servers/1", &result); !errors.IsDrift(err) {
+		t.Errorf("expected DriftError, got %v", err)
+	}
+}
+
+func TestClientCircuitBreaker(t *testing.T) {
+	status := http.StatusServiceUnavailable
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(status)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	breaker := circuitbreaker.New(circuitbreaker.Config{FailureThreshold: 2})
+	client := NewClient(server.URL, nil, WithCircuitBreaker(breaker))
+	ctx := context.Background()
+
+	// A 404 says the endpoint is healthy.
+	status = http.StatusNotFound
+	for i := 0; i < 3; i++ {
+		client.GET(ctx, "/servers/1", nil)
+	}
+	host := strings.TrimPrefix(server.URL, "http://")
+	if got := breaker.State(host); got != circuitbreaker.StateClosed {
+		t.Fatalf("after 4xx the circuit is %s, want closed", got)
+	}
+
+	status = http.StatusServiceUnavailable
+	for i := 0; i < 2; i++ {
+		if err := client.GET(ctx, "/servers", nil); err == nil {
+			t.Fatal("expected an error from a 503")
+		}
+	}
+	sent := requests
+	if err := client.GET(ctx, "/servers", nil); !errors.IsCircuitOpen(err) {
+		t.Errorf("expected CircuitOpenError, got %v", err)
+	}
+	if requests != sent {
+		t.Error("the open circuit let a request through")
+	}
+}
+
+func TestIdentityErrorRedactsCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Echo the request, as some gateways do on a rejected login.
+		var req map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&req)
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(map[string]interface{}{"error": map[string]interface{}{"message": "invalid credentials"}, "request": req})
+	}))
+	defer server.Close()
+
+	p := NewIdentityTokenProviderWithURL(server.URL, "tenant", "user@example.com", "p4ssw0rd!")
+	_, err := p.GetToken(context.Background())
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if msg := err.Error(); strings.Contains(msg, "p4ssw0rd!") || !strings.Contains(msg, "invalid credentials") {
+		t.Errorf("error = %s", msg)
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 const defaultIdentityURL = "https://api-identity-infrastructure.nhncloudservice.com"
@@ -84,6 +86,13 @@ func NewIdentityTokenProviderWithURL(identityURL, tenantID, username, password s
 	return p
 }
 
+// SetHTTPClient sends token requests with hc. A nil hc keeps the default.
+func (p *IdentityTokenProvider) SetHTTPClient(hc *http.Client) {
+	if hc != nil {
+		p.httpClient = hc
+	}
+}
+
 func (p *IdentityTokenProvider) GetToken(ctx context.Context) (string, error) {
 	p.mu.RLock()
 	if p.token != "" && time.Now().Add(tokenBufferDuration).Before(p.expiresAt) {
@@ -96,6 +105,11 @@ func (p *IdentityTokenProvider) GetToken(ctx context.Context) (string, error) {
 	return p.refreshToken(ctx)
 }
 
+// Identity returns the tenant ID the tokens are issued for.
+func (p *IdentityTokenProvider) Identity() string {
+	return p.tenantID
+}
+
 func (p *IdentityTokenProvider) SetAuthHeader(req *http.Request, token string) {
 	req.Header.Set("X-Auth-Token", token)
 }
@@ -145,7 +159,7 @@ func (p *IdentityTokenProvider) refreshToken(ctx context.Context) (string, error
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("identity token request failed with status %d: %s", resp.StatusCode, string(body))
+		return "", fmt.Errorf("identity token request failed with status %d: %s", resp.StatusCode, secret.RedactJSON(body))
 	}
 
 	var tokenResp identityTokenResponse
@@ -11,6 +11,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 const (
@@ -52,6 +54,18 @@ func NewOAuthTokenProviderWithBaseURL(baseURL, accessKeyID, secretAccessKey stri
 	return p
 }
 
+// SetHTTPClient sends token requests with hc. A nil hc keeps the default.
+func (p *OAuthTokenProvider) SetHTTPClient(hc *http.Client) {
+	if hc != nil {
+		p.httpClient = hc
+	}
+}
+
+// Identity returns the User Access Key ID the tokens are issued for.
+func (p *OAuthTokenProvider) Identity() string {
+	return p.accessKeyID
+}
+
 func (p *OAuthTokenProvider) GetToken(ctx context.Context) (string, error) {
 	p.mu.RLock()
 	if p.token != "" && time.Now().Add(tokenBufferDuration).Before(p.expiresAt) {
@@ -101,7 +115,7 @@ func (p *OAuthTokenProvider) refreshToken(ctx context.Context) (string, error) {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, string(body))
+		return "", fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, secret.RedactJSON(body))
 	}
 
 	var tokenResp oauthTokenResponse
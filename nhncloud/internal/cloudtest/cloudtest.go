@@ -0,0 +1,123 @@
+// Package cloudtest serves the NHN Cloud APIs a test needs from one
+// httptest server.
+//
+// The service clients call fixed public hosts, so Server.Client returns an
+// http.Client that sends every request to the test server and keeps the
+// original host in Request.Host. Handlers register on Mux with "host/path"
+// patterns when the path alone is ambiguous:
+//
+//	srv := cloudtest.New(t)
+//	srv.HandleJSON("network.test/v2.0/vpcs", `{"vpcs": []}`)
+//	c, _ := nhncloud.New(&nhncloud.Config{..., HTTPClient: srv.Client()})
+//
+// The Identity token and OAuth token endpoints are already registered.
+// Identity tokens carry a catalog that places each service type at
+// "https://<type>.test", for example "https://compute.test".
+package cloudtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	// IdentityToken is the X-Auth-Token issued to Identity credentials.
+	IdentityToken = "identity-token"
+	// AccessToken is the Bearer token issued to User Access Keys.
+	AccessToken = "oauth-access-token"
+)
+
+// CatalogTypes are the service types in the Identity catalog.
+var CatalogTypes = []string{"compute", "network", "image", "volumev2", "container-infra", "object-store"}
+
+// Server is a test server for the NHN Cloud APIs.
+type Server struct {
+	*httptest.Server
+	Mux *http.ServeMux
+
+	mu       sync.Mutex
+	requests []string
+}
+
+// New starts a Server that is closed when the test ends.
+func New(t testing.TB) *Server {
+	s := &Server{Mux: http.NewServeMux()}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, r.Method+" "+r.Host+r.URL.RequestURI())
+		s.mu.Unlock()
+		s.Mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(s.Close)
+
+	s.Mux.HandleFunc("/v2.0/tokens", func(w http.ResponseWriter, r *http.Request) {
+		var catalog []map[string]interface{}
+		for _, typ := range CatalogTypes {
+			catalog = append(catalog, map[string]interface{}{
+				"name": typ,
+				"type": typ,
+				"endpoints": []map[string]string{
+					{"region": "KR1", "publicURL": "https://" + typ + ".test"},
+				},
+			})
+		}
+		WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"access": map[string]interface{}{
+				"token":          map[string]interface{}{"id": IdentityToken, "expires": time.Now().Add(time.Hour)},
+				"serviceCatalog": catalog,
+			},
+		})
+	})
+	s.Mux.HandleFunc("/oauth2/token/create", func(w http.ResponseWriter, r *http.Request) {
+		WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"access_token": AccessToken,
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	})
+	return s
+}
+
+// Client returns an http.Client that sends every request to s.
+func (s *Server) Client() *http.Client {
+	return &http.Client{Transport: &rewriter{target: s.Listener.Addr().String(), next: s.Server.Client().Transport}}
+}
+
+// HandleJSON answers requests matching pattern with body.
+func (s *Server) HandleJSON(pattern, body string) {
+	s.Mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	})
+}
+
+// Requests returns every request served so far as "METHOD host/path?query".
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// WriteJSON writes v as a JSON response with the given status.
+func WriteJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+type rewriter struct {
+	target string
+	next   http.RoundTripper
+}
+
+func (rt *rewriter) RoundTrip(req *http.Request) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	out.Host = req.URL.Host
+	out.URL.Scheme = "http"
+	out.URL.Host = rt.target
+	return rt.next.RoundTrip(out)
+}
@@ -54,7 +54,7 @@ func Resolve(service Service, region string) string {
 	case ServiceNKS:
 		return fmt.Sprintf("https://%s-api-kubernetes-infrastructure.nhncloudservice.com", region)
 	case ServiceNCR:
-		return "https://kr1-ncr.api.nhncloudservice.com"
+		return fmt.Sprintf("https://%s-ncr.api.nhncloudservice.com/ncr/v2.0", region)
 	case ServiceNCS:
 		return fmt.Sprintf("https://%s-ncs.api.nhncloudservice.com/ncs/v1.0", region)
 	default:
@@ -76,3 +76,21 @@ func ResolveWithAppKey(service Service, region, appKey string) string {
 		return base
 	}
 }
+
+// ResolveVersion is like Resolve but replaces the API version suffix of the
+// versioned RDS endpoints (for example "v4.0" instead of the default "v3.0"
+// for RDS for MySQL). An empty version, or a service without a version in
+// its base URL, returns the same result as Resolve.
+func ResolveVersion(service Service, region, version string) string {
+	base := Resolve(service, region)
+	if version == "" {
+		return base
+	}
+	switch service {
+	case ServiceRDSMySQL, ServiceRDSMariaDB, ServiceRDSPostgreSQL:
+		if i := strings.LastIndex(base, "/"); i > len("https://") {
+			return base[:i+1] + version
+		}
+	}
+	return base
+}
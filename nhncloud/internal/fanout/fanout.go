@@ -0,0 +1,92 @@
+// Package fanout runs the per-region jobs of the multi-region reports
+// (inventory snapshots, orphan checks) a few at a time, and holds the
+// sorting and text helpers those reports share.
+package fanout
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+)
+
+// Job is one item to run in one region.
+type Job[T any] struct {
+	Item   T
+	Region string
+}
+
+// Jobs pairs every item with the regions of m where its service is
+// available.
+func Jobs[T any](m *nhncloud.MultiRegion, items []T, service func(T) string) []Job[T] {
+	var jobs []Job[T]
+	for _, item := range items {
+		for _, region := range m.RegionsFor(service(item)) {
+			jobs = append(jobs, Job[T]{Item: item, Region: region})
+		}
+	}
+	return jobs
+}
+
+// Result is the outcome of a job.
+type Result[R any] struct {
+	Value R
+	Err   error
+}
+
+// Run calls do for every job, with at most limit calls in flight, and
+// returns the results in the order of jobs. A job that has not started
+// when ctx ends fails with ctx.Err().
+func Run[T, R any](ctx context.Context, jobs []Job[T], limit int, do func(context.Context, Job[T]) (R, error)) []Result[R] {
+	results := make([]Result[R], len(jobs))
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i, j := range jobs {
+		wg.Add(1)
+		go func(i int, j Job[T]) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				results[i].Err = ctx.Err()
+				return
+			}
+			defer func() { <-sem }()
+			results[i].Value, results[i].Err = do(ctx, j)
+		}(i, j)
+	}
+	wg.Wait()
+	return results
+}
+
+// Sort sorts s by the fields key returns, compared in order.
+func Sort[T any](s []T, key func(*T) []string) {
+	sort.SliceStable(s, func(i, j int) bool {
+		a, b := key(&s[i]), key(&s[j])
+		for k := range a {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return false
+	})
+}
+
+// Label is id followed by name in parentheses, when name says more.
+func Label(id, name string) string {
+	if name == "" || name == id {
+		return id
+	}
+	return id + " (" + name + ")"
+}
+
+// WriteText builds a text report with write and writes it to w in one call.
+func WriteText(w io.Writer, write func(b *strings.Builder)) error {
+	var b strings.Builder
+	write(&b)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
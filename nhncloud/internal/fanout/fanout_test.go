@@ -0,0 +1,61 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRunKeepsOrderAndLimit(t *testing.T) {
+	var jobs []Job[int]
+	for i := 0; i < 20; i++ {
+		jobs = append(jobs, Job[int]{Item: i, Region: "kr1"})
+	}
+	var inFlight, peak int32
+	results := Run(context.Background(), jobs, 3, func(ctx context.Context, j Job[int]) (int, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		if j.Item == 7 {
+			return 0, errors.New("boom")
+		}
+		return j.Item * 2, nil
+	})
+	if peak > 3 {
+		t.Errorf("%d jobs ran at once, limit 3", peak)
+	}
+	for i, r := range results {
+		if i == 7 {
+			if r.Err == nil {
+				t.Error("job 7 lost its error")
+			}
+			continue
+		}
+		if r.Err != nil || r.Value != i*2 {
+			t.Errorf("result %d = %+v", i, r)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, r := range Run(ctx, jobs[:2], 1, func(ctx context.Context, j Job[int]) (int, error) { return 1, ctx.Err() }) {
+		if !errors.Is(r.Err, context.Canceled) {
+			t.Errorf("canceled run = %+v", r)
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	type item struct{ region, id string }
+	s := []item{{"kr2", "a"}, {"kr1", "b"}, {"kr1", "a"}}
+	Sort(s, func(it *item) []string { return []string{it.region, it.id} })
+	if s[0] != (item{"kr1", "a"}) || s[1] != (item{"kr1", "b"}) || s[2] != (item{"kr2", "a"}) {
+		t.Errorf("sorted = %v", s)
+	}
+}
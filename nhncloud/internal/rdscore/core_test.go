@@ -0,0 +1,201 @@
+package rdscore
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/cloudtest"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/endpoint"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+)
+
+const host = "kr1-rds-mysql.api.nhncloudservice.com"
+
+type testInstance struct {
+	ID       string         `json:"dbInstanceId"`
+	State    InstanceStatus `json:"dbInstanceStatus"`
+	Progress ProgressStatus `json:"progressStatus"`
+}
+
+func (i testInstance) Status() (InstanceStatus, ProgressStatus) { return i.State, i.Progress }
+
+type testList struct {
+	Header *ResponseHeader  `json:"header"`
+	Items  []map[string]int `json:"items"`
+}
+
+type testInput struct {
+	Name string `json:"name"`
+}
+
+// testClient embeds Ops and the operation groups the way the engine clients
+// do.
+type testClient struct {
+	*Ops
+	Instances[testList, testInstance, testList, testInput, testInput]
+	InstanceGroups[testList, testList]
+	Catalog[testList, testList, testList]
+	SecurityGroups[testList, testList, testInput, JobIDResponse, testInput]
+	SecurityGroupRules[testInput, JobIDResponse, testInput]
+	ParameterGroups[testList, testList, testInput]
+	Backups[testInput]
+	DBUsers[testList, testInput, testInput]
+	NotificationGroups[testList, testList, JobIDResponse, testInput]
+	UserGroups[testList, testList, testInput]
+
+	core *Core
+}
+
+func newTestClient(t *testing.T, srv *cloudtest.Server) *testClient {
+	t.Helper()
+	core := New(Config{
+		Service:     endpoint.ServiceRDSMySQL,
+		Region:      "kr1",
+		AppKey:      "app-key",
+		Credentials: credentials.NewStatic("access-key", "secret-key"),
+		Versions: []Version{
+			{Name: "v3.0", Auth: AuthAppKey},
+			{Name: "v4.0", Auth: AuthBearer},
+		},
+		Settings: option.Apply(option.WithHTTPClient(srv.Client())),
+	})
+	c := &testClient{Ops: core.Ops(), core: core}
+	core.Bind(&c.Instances, &c.InstanceGroups, &c.Catalog, &c.SecurityGroups, &c.SecurityGroupRules,
+		&c.ParameterGroups, &c.Backups, &c.DBUsers, &c.NotificationGroups, &c.UserGroups)
+	return c
+}
+
+func TestOperations(t *testing.T) {
+	srv := cloudtest.New(t)
+	var bodies []string
+	srv.Mux.HandleFunc(host+"/", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, strings.TrimSpace(string(body)))
+		w.Write([]byte(`{"header":{"isSuccessful":true},"jobId":"job-1","dbInstanceId":"db-1","items":[{"n":1}],` +
+			`"parameterGroupId":"pg-1","userGroupId":"ug-1","logFiles":[{"logFileName":"error.log"}]}`))
+	})
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+	in := &testInput{Name: "x"}
+
+	calls := []struct {
+		want string
+		body string
+		call func() (interface{}, error)
+	}{
+		// Ops
+		{"DELETE /v3.0/db-instances/db-1", "", func() (interface{}, error) { return c.DeleteInstance(ctx, "db-1") }},
+		{"POST /v3.0/db-instances/db-1/restart", `{"useOnlineFailover":true}`, func() (interface{}, error) {
+			return c.RestartInstance(ctx, "db-1", &RestartInstanceRequest{UseOnlineFailover: true})
+		}},
+		{"PUT /v3.0/db-instances/db-1/high-availability", `{"useHighAvailability":false}`, func() (interface{}, error) { return c.DisableHighAvailability(ctx, "db-1") }},
+		{"POST /v3.0/parameter-groups", `{"parameterGroupName":"p","dbVersion":"MYSQL_V8033"}`, func() (interface{}, error) {
+			return c.CreateParameterGroup(ctx, &CreateParameterGroupRequest{ParameterGroupName: "p", DBVersion: "MYSQL_V8033"})
+		}},
+		{"POST /v3.0/parameter-groups/pg-1/copy", `{"parameterGroupName":"p2"}`, func() (interface{}, error) {
+			return c.CopyParameterGroup(ctx, "pg-1", &CopyParameterGroupRequest{ParameterGroupName: "p2"})
+		}},
+		{"GET /v3.0/db-instances/db-1/log-files", "", func() (interface{}, error) { return c.ListLogFiles(ctx, "db-1") }},
+		{"DELETE /v3.0/user-groups/ug-1", "", func() (interface{}, error) { return c.DeleteUserGroup(ctx, "ug-1") }},
+
+		// Groups
+		{"GET /v3.0/db-instances", "", func() (interface{}, error) { return c.ListInstances(ctx) }},
+		{"GET /v3.0/db-instances/db-1", "", func() (interface{}, error) { return c.GetInstance(ctx, "db-1") }},
+		{"GET /v3.0/db-instances/db-1/network-info", "", func() (interface{}, error) { return c.GetNetworkInfo(ctx, "db-1") }},
+		{"PUT /v3.0/db-instances/db-1/storage-info", `{"name":"x"}`, func() (interface{}, error) { return c.ModifyStorageInfo(ctx, "db-1", in) }},
+		{"PUT /v3.0/db-instances/db-1/high-availability", `{"name":"x"}`, func() (interface{}, error) { return c.EnableHighAvailability(ctx, "db-1", in) }},
+		{"GET /v3.0/db-instance-groups/g-1", "", func() (interface{}, error) { return c.GetInstanceGroup(ctx, "g-1") }},
+		{"GET /v3.0/db-flavors", "", func() (interface{}, error) { return c.ListFlavors(ctx) }},
+		{"GET /v3.0/network/subnets", "", func() (interface{}, error) { return c.ListSubnets(ctx) }},
+		{"POST /v3.0/db-security-groups", `{"name":"x"}`, func() (interface{}, error) { return c.CreateSecurityGroup(ctx, in) }},
+		{"PUT /v3.0/db-security-groups/sg-1/rules/r-1", `{"name":"x"}`, func() (interface{}, error) { return c.UpdateSecurityGroupRule(ctx, "sg-1", "r-1", in) }},
+		{"PUT /v3.0/parameter-groups/pg-1", `{"name":"x"}`, func() (interface{}, error) { return c.UpdateParameterGroup(ctx, "pg-1", in) }},
+		{"POST /v3.0/backups/b-1/restore", `{"name":"x"}`, func() (interface{}, error) { return c.RestoreBackup(ctx, "b-1", in) }},
+		{"PUT /v3.0/db-instances/db-1/db-users/u-1", `{"name":"x"}`, func() (interface{}, error) { return c.UpdateDBUser(ctx, "db-1", "u-1", in) }},
+		{"POST /v3.0/notification-groups", `{"notificationGroupName":"n","notificationType":"EMAIL","isEnabled":true,"recipients":null}`, func() (interface{}, error) {
+			return c.CreateNotificationGroup(ctx, &CreateNotificationGroupRequest{NotificationGroupName: "n", NotificationType: "EMAIL", IsEnabled: true})
+		}},
+		{"POST /v3.0/user-groups", `{"name":"x"}`, func() (interface{}, error) { return c.CreateUserGroup(ctx, in) }},
+	}
+
+	for i, tc := range calls {
+		_, err := tc.call()
+		if err != nil {
+			t.Fatalf("%s: %v", tc.want, err)
+		}
+		reqs := srv.Requests()
+		if got := strings.Replace(reqs[len(reqs)-1], host, "", 1); got != tc.want {
+			t.Errorf("request %d = %q, want %q", i, got, tc.want)
+		}
+		if bodies[i] != tc.body {
+			t.Errorf("%s: body = %s, want %s", tc.want, bodies[i], tc.body)
+		}
+	}
+
+	logs, _ := c.ListLogFiles(ctx, "db-1")
+	if len(logs.LogFiles) != 1 || logs.LogFiles[0].LogFileName != "error.log" {
+		t.Errorf("ListLogFiles = %+v", logs)
+	}
+	created, _ := c.CreateUserGroup(ctx, in)
+	if created.UserGroupID != "ug-1" {
+		t.Errorf("CreateUserGroup = %+v", created)
+	}
+}
+
+func TestSetAPIVersion(t *testing.T) {
+	srv := cloudtest.New(t)
+	srv.HandleJSON(host+"/", `{"header":{"isSuccessful":true}}`)
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+
+	c.core.SetAPIVersion("v9.9")
+	if c.core.APIVersion() != "v3.0" {
+		t.Fatalf("an unknown version was selected: %s", c.core.APIVersion())
+	}
+	c.core.SetAPIVersion("v4.0")
+	if _, err := c.ListInstances(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Get[ResponseHeader](ctx, c.core.Pinned("v3.0"), "/storage-types"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"POST oauth.api.nhncloudservice.com/oauth2/token/create",
+		"GET " + host + "/v4.0/db-instances",
+		"GET " + host + "/v3.0/storage-types",
+	}
+	if got := srv.Requests(); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("requests = %q, want %q", got, want)
+	}
+}
+
+func TestSetAPIVersionConcurrent(t *testing.T) {
+	srv := cloudtest.New(t)
+	srv.HandleJSON(host+"/", `{"header":{"isSuccessful":true}}`)
+	c := newTestClient(t, srv)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				c.DeleteInstance(ctx, "db-1")
+			}
+		}()
+	}
+	for _, v := range []APIVersion{"v4.0", "v3.0", "v4.0"} {
+		c.core.SetAPIVersion(v)
+	}
+	wg.Wait()
+	if c.core.APIVersion() != "v4.0" {
+		t.Errorf("APIVersion() = %s, want v4.0", c.core.APIVersion())
+	}
+}
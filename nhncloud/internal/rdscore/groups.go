@@ -0,0 +1,222 @@
+package rdscore
+
+import (
+	"context"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cache"
+)
+
+// The operation groups below have the same path, method and shape in every
+// engine's API, but their request or response bodies carry engine-specific
+// fields. Each group is generic over those bodies; an engine client embeds
+// one instantiation of every group and binds them to its Core with Bind.
+
+// binder is implemented by every operation group.
+type binder interface {
+	bind(c *Core)
+}
+
+// group is embedded by the operation groups to hold their Core.
+type group struct {
+	core *Core
+}
+
+func (g *group) bind(c *Core) { g.core = c }
+
+// Bind binds the operation groups embedded in an engine client to c.
+func (c *Core) Bind(groups ...binder) {
+	for _, g := range groups {
+		g.bind(c)
+	}
+}
+
+// StatusReporter is implemented by an engine's instance response.
+type StatusReporter interface {
+	Status() (InstanceStatus, ProgressStatus)
+}
+
+// Instances implements the DB instance operations.
+type Instances[List any, Instance StatusReporter, NetworkInfo, StorageInput, HAInput any] struct {
+	group
+}
+
+func (g *Instances[List, Instance, NetworkInfo, StorageInput, HAInput]) ListInstances(ctx context.Context) (*List, error) {
+	return Get[List](ctx, g.core.API(), "/db-instances")
+}
+
+func (g *Instances[List, Instance, NetworkInfo, StorageInput, HAInput]) GetInstance(ctx context.Context, instanceID string) (*Instance, error) {
+	return Get[Instance](ctx, g.core.API(), "/db-instances/"+instanceID)
+}
+
+// WaitForInstance polls GetInstance every interval (10s when zero) until
+// the instance is in want with no operation in progress. want must be a
+// settled status such as InstanceStatusAvailable; waiting for
+// InstanceStatusDeleted ends with a nil output once the instance is gone.
+// A failed status ends the wait with *errors.FailedStateError. Bound the
+// wait with a context deadline.
+func (g *Instances[List, Instance, NetworkInfo, StorageInput, HAInput]) WaitForInstance(ctx context.Context, instanceID string, want InstanceStatus, interval time.Duration) (*Instance, error) {
+	return WaitForInstance(ctx, instanceID, want, interval,
+		func(ctx context.Context) (*Instance, error) { return g.GetInstance(ctx, instanceID) },
+		func(out *Instance) (InstanceStatus, ProgressStatus) { return (*out).Status() })
+}
+
+func (g *Instances[List, Instance, NetworkInfo, StorageInput, HAInput]) GetNetworkInfo(ctx context.Context, instanceID string) (*NetworkInfo, error) {
+	return Get[NetworkInfo](ctx, g.core.API(), "/db-instances/"+instanceID+"/network-info")
+}
+
+func (g *Instances[List, Instance, NetworkInfo, StorageInput, HAInput]) ModifyStorageInfo(ctx context.Context, instanceID string, input *StorageInput) (*JobIDResponse, error) {
+	return Put[JobIDResponse](ctx, g.core.API(), "/db-instances/"+instanceID+"/storage-info", input)
+}
+
+func (g *Instances[List, Instance, NetworkInfo, StorageInput, HAInput]) EnableHighAvailability(ctx context.Context, instanceID string, input *HAInput) (*JobIDResponse, error) {
+	return Put[JobIDResponse](ctx, g.core.API(), "/db-instances/"+instanceID+"/high-availability", input)
+}
+
+// InstanceGroups implements the DB instance group operations.
+type InstanceGroups[List, Group any] struct {
+	group
+}
+
+func (g *InstanceGroups[List, Group]) ListInstanceGroups(ctx context.Context) (*List, error) {
+	return Get[List](ctx, g.core.API(), "/db-instance-groups")
+}
+
+func (g *InstanceGroups[List, Group]) GetInstanceGroup(ctx context.Context, groupID string) (*Group, error) {
+	return Get[Group](ctx, g.core.API(), "/db-instance-groups/"+groupID)
+}
+
+// Catalog implements the flavor, version and subnet listings.
+type Catalog[Flavors, Versions, Subnets any] struct {
+	group
+}
+
+func (g *Catalog[Flavors, Versions, Subnets]) ListFlavors(ctx context.Context) (*Flavors, error) {
+	return CachedGet[Flavors](ctx, g.core.API(), cache.OpListFlavors, "/db-flavors")
+}
+
+func (g *Catalog[Flavors, Versions, Subnets]) ListVersions(ctx context.Context) (*Versions, error) {
+	return CachedGet[Versions](ctx, g.core.API(), cache.OpListVersions, "/db-versions")
+}
+
+func (g *Catalog[Flavors, Versions, Subnets]) ListSubnets(ctx context.Context) (*Subnets, error) {
+	return Get[Subnets](ctx, g.core.API(), "/network/subnets")
+}
+
+// SecurityGroups implements the DB security group operations.
+type SecurityGroups[List, Group, CreateInput, Created, UpdateInput any] struct {
+	group
+}
+
+func (g *SecurityGroups[List, Group, CreateInput, Created, UpdateInput]) ListSecurityGroups(ctx context.Context) (*List, error) {
+	return Get[List](ctx, g.core.API(), "/db-security-groups")
+}
+
+func (g *SecurityGroups[List, Group, CreateInput, Created, UpdateInput]) GetSecurityGroup(ctx context.Context, securityGroupID string) (*Group, error) {
+	return Get[Group](ctx, g.core.API(), "/db-security-groups/"+securityGroupID)
+}
+
+func (g *SecurityGroups[List, Group, CreateInput, Created, UpdateInput]) CreateSecurityGroup(ctx context.Context, input *CreateInput) (*Created, error) {
+	return Post[Created](ctx, g.core.API(), "/db-security-groups", input)
+}
+
+func (g *SecurityGroups[List, Group, CreateInput, Created, UpdateInput]) UpdateSecurityGroup(ctx context.Context, securityGroupID string, input *UpdateInput) (*ResponseHeader, error) {
+	return Put[ResponseHeader](ctx, g.core.API(), "/db-security-groups/"+securityGroupID, input)
+}
+
+// SecurityGroupRules implements the DB security group rule operations.
+type SecurityGroupRules[CreateInput, Created, UpdateInput any] struct {
+	group
+}
+
+func (g *SecurityGroupRules[CreateInput, Created, UpdateInput]) CreateSecurityGroupRule(ctx context.Context, securityGroupID string, input *CreateInput) (*Created, error) {
+	return Post[Created](ctx, g.core.API(), "/db-security-groups/"+securityGroupID+"/rules", input)
+}
+
+func (g *SecurityGroupRules[CreateInput, Created, UpdateInput]) UpdateSecurityGroupRule(ctx context.Context, securityGroupID, ruleID string, input *UpdateInput) (*ResponseHeader, error) {
+	return Put[ResponseHeader](ctx, g.core.API(), "/db-security-groups/"+securityGroupID+"/rules/"+ruleID, input)
+}
+
+// ParameterGroups implements the parameter group operations whose bodies
+// differ per engine.
+type ParameterGroups[List, Group, UpdateInput any] struct {
+	group
+}
+
+func (g *ParameterGroups[List, Group, UpdateInput]) ListParameterGroups(ctx context.Context) (*List, error) {
+	return Get[List](ctx, g.core.API(), "/parameter-groups")
+}
+
+func (g *ParameterGroups[List, Group, UpdateInput]) GetParameterGroup(ctx context.Context, parameterGroupID string) (*Group, error) {
+	return Get[Group](ctx, g.core.API(), "/parameter-groups/"+parameterGroupID)
+}
+
+func (g *ParameterGroups[List, Group, UpdateInput]) UpdateParameterGroup(ctx context.Context, parameterGroupID string, input *UpdateInput) (*ResponseHeader, error) {
+	return Put[ResponseHeader](ctx, g.core.API(), "/parameter-groups/"+parameterGroupID, input)
+}
+
+// Backups implements the backup operations whose bodies differ per engine.
+type Backups[RestoreInput any] struct {
+	group
+}
+
+func (g *Backups[RestoreInput]) RestoreBackup(ctx context.Context, backupID string, input *RestoreInput) (*JobIDResponse, error) {
+	return Post[JobIDResponse](ctx, g.core.API(), "/backups/"+backupID+"/restore", input)
+}
+
+// DBUsers implements the DB user operations whose bodies differ per engine.
+type DBUsers[List, CreateInput, UpdateInput any] struct {
+	group
+}
+
+func (g *DBUsers[List, CreateInput, UpdateInput]) ListDBUsers(ctx context.Context, instanceID string) (*List, error) {
+	return Get[List](ctx, g.core.API(), "/db-instances/"+instanceID+"/db-users")
+}
+
+func (g *DBUsers[List, CreateInput, UpdateInput]) CreateDBUser(ctx context.Context, instanceID string, input *CreateInput) (*JobIDResponse, error) {
+	return Post[JobIDResponse](ctx, g.core.API(), "/db-instances/"+instanceID+"/db-users", input)
+}
+
+func (g *DBUsers[List, CreateInput, UpdateInput]) UpdateDBUser(ctx context.Context, instanceID, userID string, input *UpdateInput) (*JobIDResponse, error) {
+	return Put[JobIDResponse](ctx, g.core.API(), "/db-instances/"+instanceID+"/db-users/"+userID, input)
+}
+
+// NotificationGroups implements the notification group operations whose
+// bodies differ per engine.
+type NotificationGroups[List, Group, Created, UpdateInput any] struct {
+	group
+}
+
+func (g *NotificationGroups[List, Group, Created, UpdateInput]) ListNotificationGroups(ctx context.Context) (*List, error) {
+	return Get[List](ctx, g.core.API(), "/notification-groups")
+}
+
+func (g *NotificationGroups[List, Group, Created, UpdateInput]) GetNotificationGroup(ctx context.Context, notificationGroupID string) (*Group, error) {
+	return Get[Group](ctx, g.core.API(), "/notification-groups/"+notificationGroupID)
+}
+
+func (g *NotificationGroups[List, Group, Created, UpdateInput]) CreateNotificationGroup(ctx context.Context, input *CreateNotificationGroupRequest) (*Created, error) {
+	return Post[Created](ctx, g.core.API(), "/notification-groups", input)
+}
+
+func (g *NotificationGroups[List, Group, Created, UpdateInput]) UpdateNotificationGroup(ctx context.Context, notificationGroupID string, input *UpdateInput) (*ResponseHeader, error) {
+	return Put[ResponseHeader](ctx, g.core.API(), "/notification-groups/"+notificationGroupID, input)
+}
+
+// UserGroups implements the user group operations whose bodies differ per
+// engine.
+type UserGroups[List, Group, CreateInput any] struct {
+	group
+}
+
+func (g *UserGroups[List, Group, CreateInput]) ListUserGroups(ctx context.Context) (*List, error) {
+	return Get[List](ctx, g.core.API(), "/user-groups")
+}
+
+func (g *UserGroups[List, Group, CreateInput]) GetUserGroup(ctx context.Context, userGroupID string) (*Group, error) {
+	return Get[Group](ctx, g.core.API(), "/user-groups/"+userGroupID)
+}
+
+func (g *UserGroups[List, Group, CreateInput]) CreateUserGroup(ctx context.Context, input *CreateInput) (*UserGroupIDResponse, error) {
+	return Post[UserGroupIDResponse](ctx, g.core.API(), "/user-groups", input)
+}
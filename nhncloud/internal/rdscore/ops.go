@@ -0,0 +1,150 @@
+package rdscore
+
+import (
+	"context"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cache"
+)
+
+// Ops implements the operations shared by every RDS engine. The engine
+// clients embed it.
+type Ops struct {
+	core *Core
+}
+
+// Instance operations
+
+func (o *Ops) DeleteInstance(ctx context.Context, instanceID string) (*JobIDResponse, error) {
+	return Delete[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID)
+}
+
+func (o *Ops) StartInstance(ctx context.Context, instanceID string) (*JobIDResponse, error) {
+	return Post[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/start", nil)
+}
+
+func (o *Ops) StopInstance(ctx context.Context, instanceID string) (*JobIDResponse, error) {
+	return Post[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/stop", nil)
+}
+
+func (o *Ops) RestartInstance(ctx context.Context, instanceID string, req *RestartInstanceRequest) (*JobIDResponse, error) {
+	return Post[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/restart", req)
+}
+
+func (o *Ops) ForceRestartInstance(ctx context.Context, instanceID string) (*JobIDResponse, error) {
+	return Post[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/force-restart", nil)
+}
+
+func (o *Ops) ModifyNetworkInfo(ctx context.Context, instanceID string, input *ModifyNetworkInfoRequest) (*JobIDResponse, error) {
+	return Put[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/network-info", input)
+}
+
+func (o *Ops) ModifyDeletionProtection(ctx context.Context, instanceID string, input *ModifyDeletionProtectionRequest) (*JobIDResponse, error) {
+	return Put[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/deletion-protection", input)
+}
+
+// Catalog
+
+func (o *Ops) ListStorageTypes(ctx context.Context) (*StorageTypesResponse, error) {
+	return CachedGet[StorageTypesResponse](ctx, o.core.API(), cache.OpListStorageTypes, "/storage-types")
+}
+
+// Security groups
+
+func (o *Ops) DeleteSecurityGroup(ctx context.Context, securityGroupID string) (*ResponseHeader, error) {
+	return Delete[ResponseHeader](ctx, o.core.API(), "/db-security-groups/"+securityGroupID)
+}
+
+func (o *Ops) DeleteSecurityGroupRule(ctx context.Context, securityGroupID, ruleID string) (*ResponseHeader, error) {
+	return Delete[ResponseHeader](ctx, o.core.API(), "/db-security-groups/"+securityGroupID+"/rules/"+ruleID)
+}
+
+// Parameter groups
+
+func (o *Ops) CreateParameterGroup(ctx context.Context, input *CreateParameterGroupRequest) (*ParameterGroupIDResponse, error) {
+	return Post[ParameterGroupIDResponse](ctx, o.core.API(), "/parameter-groups", input)
+}
+
+func (o *Ops) CopyParameterGroup(ctx context.Context, parameterGroupID string, input *CopyParameterGroupRequest) (*ParameterGroupIDResponse, error) {
+	return Post[ParameterGroupIDResponse](ctx, o.core.API(), "/parameter-groups/"+parameterGroupID+"/copy", input)
+}
+
+func (o *Ops) ModifyParameters(ctx context.Context, parameterGroupID string, input *ModifyParametersRequest) (*ResponseHeader, error) {
+	return Put[ResponseHeader](ctx, o.core.API(), "/parameter-groups/"+parameterGroupID+"/parameters", input)
+}
+
+func (o *Ops) ResetParameterGroup(ctx context.Context, parameterGroupID string) (*ResponseHeader, error) {
+	return Put[ResponseHeader](ctx, o.core.API(), "/parameter-groups/"+parameterGroupID+"/reset", nil)
+}
+
+func (o *Ops) DeleteParameterGroup(ctx context.Context, parameterGroupID string) (*ResponseHeader, error) {
+	return Delete[ResponseHeader](ctx, o.core.API(), "/parameter-groups/"+parameterGroupID)
+}
+
+// Backups
+
+func (o *Ops) CreateBackup(ctx context.Context, instanceID string, input *CreateBackupRequest) (*JobIDResponse, error) {
+	return Post[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/backup", input)
+}
+
+func (o *Ops) BackupToObjectStorage(ctx context.Context, instanceID string, input *BackupToObjectStorageRequest) (*JobIDResponse, error) {
+	return Post[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/backup-to-object-storage", input)
+}
+
+func (o *Ops) DeleteBackup(ctx context.Context, backupID string) (*JobIDResponse, error) {
+	return Delete[JobIDResponse](ctx, o.core.API(), "/backups/"+backupID)
+}
+
+// DB users
+
+func (o *Ops) DeleteDBUser(ctx context.Context, instanceID, userID string) (*JobIDResponse, error) {
+	return Delete[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/db-users/"+userID)
+}
+
+// Replicas and high availability
+
+func (o *Ops) PromoteReplica(ctx context.Context, instanceID string) (*JobIDResponse, error) {
+	return Post[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/promote", nil)
+}
+
+func (o *Ops) DisableHighAvailability(ctx context.Context, instanceID string) (*JobIDResponse, error) {
+	input := map[string]interface{}{"useHighAvailability": false}
+	return Put[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/high-availability", input)
+}
+
+func (o *Ops) PauseHighAvailability(ctx context.Context, instanceID string) (*JobIDResponse, error) {
+	return Post[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/high-availability/pause", nil)
+}
+
+func (o *Ops) ResumeHighAvailability(ctx context.Context, instanceID string) (*JobIDResponse, error) {
+	return Post[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/high-availability/resume", nil)
+}
+
+func (o *Ops) RepairHighAvailability(ctx context.Context, instanceID string) (*JobIDResponse, error) {
+	return Post[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/high-availability/repair", nil)
+}
+
+func (o *Ops) SplitHighAvailability(ctx context.Context, instanceID string) (*JobIDResponse, error) {
+	return Post[JobIDResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/high-availability/split", nil)
+}
+
+// Notification groups
+
+func (o *Ops) DeleteNotificationGroup(ctx context.Context, notificationGroupID string) (*ResponseHeader, error) {
+	return Delete[ResponseHeader](ctx, o.core.API(), "/notification-groups/"+notificationGroupID)
+}
+
+// User groups
+
+func (o *Ops) UpdateUserGroup(ctx context.Context, userGroupID string, input *UpdateUserGroupRequest) (*ResponseHeader, error) {
+	return Put[ResponseHeader](ctx, o.core.API(), "/user-groups/"+userGroupID, input)
+}
+
+func (o *Ops) DeleteUserGroup(ctx context.Context, userGroupID string) (*ResponseHeader, error) {
+	return Delete[ResponseHeader](ctx, o.core.API(), "/user-groups/"+userGroupID)
+}
+
+// Log files
+
+func (o *Ops) ListLogFiles(ctx context.Context, instanceID string) (*LogFilesResponse, error) {
+	return Get[LogFilesResponse](ctx, o.core.API(), "/db-instances/"+instanceID+"/log-files")
+}
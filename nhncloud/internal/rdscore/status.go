@@ -0,0 +1,150 @@
+package rdscore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/waiter"
+)
+
+// InstanceStatus is a DB instance's dbInstanceStatus. Values the SDK does
+// not know are kept verbatim and report false from every helper.
+type InstanceStatus string
+
+const (
+	InstanceStatusAvailable       InstanceStatus = "AVAILABLE"
+	InstanceStatusBeforeCreate    InstanceStatus = "BEFORE_CREATE"
+	InstanceStatusStorageFull     InstanceStatus = "STORAGE_FULL"
+	InstanceStatusFailToCreate    InstanceStatus = "FAIL_TO_CREATE"
+	InstanceStatusFailToConnect   InstanceStatus = "FAIL_TO_CONNECT"
+	InstanceStatusReplicationStop InstanceStatus = "REPLICATION_STOP"
+	InstanceStatusFailover        InstanceStatus = "FAILOVER"
+	InstanceStatusShutdown        InstanceStatus = "SHUTDOWN"
+	InstanceStatusDeleted         InstanceStatus = "DELETED"
+)
+
+// IsTerminal reports whether the instance has settled. An instance can be
+// settled while an operation is still running; check ProgressStatus too.
+func (s InstanceStatus) IsTerminal() bool {
+	switch s {
+	case InstanceStatusAvailable, InstanceStatusStorageFull, InstanceStatusShutdown, InstanceStatusDeleted:
+		return true
+	}
+	return s.IsFailed()
+}
+
+// IsFailed reports whether the instance is in an error state.
+func (s InstanceStatus) IsFailed() bool {
+	switch s {
+	case InstanceStatusFailToCreate, InstanceStatusFailToConnect, InstanceStatusReplicationStop:
+		return true
+	}
+	return false
+}
+
+// IsTransitioning reports whether the instance is being created or failing
+// over.
+func (s InstanceStatus) IsTransitioning() bool {
+	return s == InstanceStatusBeforeCreate || s == InstanceStatusFailover
+}
+
+// ProgressStatus is the operation running on a DB instance or security
+// group. Any value other than NONE, including ones the SDK does not know,
+// means an operation is in progress.
+type ProgressStatus string
+
+const (
+	ProgressStatusNone                   ProgressStatus = "NONE"
+	ProgressStatusCreating               ProgressStatus = "CREATING"
+	ProgressStatusModifying              ProgressStatus = "MODIFYING"
+	ProgressStatusStarting               ProgressStatus = "STARTING"
+	ProgressStatusStopping               ProgressStatus = "STOPPING"
+	ProgressStatusRestarting             ProgressStatus = "RESTARTING"
+	ProgressStatusForceRestarting        ProgressStatus = "FORCE_RESTARTING"
+	ProgressStatusBackingUp              ProgressStatus = "BACKING_UP"
+	ProgressStatusRestoring              ProgressStatus = "RESTORING"
+	ProgressStatusReplicating            ProgressStatus = "REPLICATING"
+	ProgressStatusPromoting              ProgressStatus = "PROMOTING"
+	ProgressStatusFailingOver            ProgressStatus = "FAILING_OVER"
+	ProgressStatusApplyingParameterGroup ProgressStatus = "APPLYING_PARAMETER_GROUP"
+	ProgressStatusDeleting               ProgressStatus = "DELETING"
+)
+
+// IsTerminal reports whether no operation is running.
+func (s ProgressStatus) IsTerminal() bool {
+	return s == "" || s == ProgressStatusNone
+}
+
+// IsFailed always reports false; failures surface in InstanceStatus.
+func (s ProgressStatus) IsFailed() bool { return false }
+
+// IsTransitioning reports whether an operation is running.
+func (s ProgressStatus) IsTransitioning() bool { return !s.IsTerminal() }
+
+// BackupStatus is a backup's backupStatus. Values the SDK does not know are
+// kept verbatim and report false from every helper.
+type BackupStatus string
+
+const (
+	BackupStatusBackingUp BackupStatus = "BACKING_UP"
+	BackupStatusCompleted BackupStatus = "COMPLETED"
+	BackupStatusDeleting  BackupStatus = "DELETING"
+	BackupStatusDeleted   BackupStatus = "DELETED"
+	BackupStatusError     BackupStatus = "ERROR"
+)
+
+// IsTerminal reports whether the backup has finished, successfully or not.
+func (s BackupStatus) IsTerminal() bool {
+	return s == BackupStatusCompleted || s == BackupStatusDeleted || s == BackupStatusError
+}
+
+// IsFailed reports whether the backup failed.
+func (s BackupStatus) IsFailed() bool { return s == BackupStatusError }
+
+// IsTransitioning reports whether the backup is being taken or deleted.
+func (s BackupStatus) IsTransitioning() bool {
+	return s == BackupStatusBackingUp || s == BackupStatusDeleting
+}
+
+// WaitForInstance polls get until the instance reports want with no
+// operation in progress, and returns the last response. Waiting for
+// InstanceStatusDeleted also ends when the instance is gone, with a nil
+// response. A failed status ends the wait with *errors.FailedStateError.
+func WaitForInstance[T any](ctx context.Context, instanceID string, want InstanceStatus, interval time.Duration,
+	get func(context.Context) (*T, error), status func(*T) (InstanceStatus, ProgressStatus)) (*T, error) {
+	if !want.IsTerminal() || want.IsFailed() {
+		return nil, &errors.ValidationError{Field: "want", Reason: fmt.Sprintf("%q is not a settled instance status", want)}
+	}
+
+	var last *T
+	var lastStatus InstanceStatus
+	var lastProgress ProgressStatus
+	err := waiter.Poll(ctx, interval, func(ctx context.Context) (bool, error) {
+		out, err := get(ctx)
+		if err != nil {
+			if want == InstanceStatusDeleted && waiter.IsNotFound(err) {
+				last = nil
+				return true, nil
+			}
+			return false, err
+		}
+		last = out
+		lastStatus, lastProgress = status(out)
+		if lastProgress.IsTransitioning() {
+			return false, nil
+		}
+		if lastStatus == want {
+			return true, nil
+		}
+		if lastStatus.IsFailed() {
+			return false, &errors.FailedStateError{Resource: "db instance", ID: instanceID, Status: string(lastStatus)}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return last, fmt.Errorf("wait for db instance %s to be %s (last %s/%s): %w", instanceID, want, lastStatus, lastProgress, err)
+	}
+	return last, nil
+}
@@ -0,0 +1,123 @@
+package rdscore
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/cloudtest"
+)
+
+func TestStatusHelpers(t *testing.T) {
+	for _, tc := range []struct {
+		status                          InstanceStatus
+		terminal, failed, transitioning bool
+	}{
+		{InstanceStatusAvailable, true, false, false},
+		{InstanceStatusShutdown, true, false, false},
+		{InstanceStatusFailToCreate, true, true, false},
+		{InstanceStatusBeforeCreate, false, false, true},
+		{"SOMETHING_NEW", false, false, false},
+	} {
+		if tc.status.IsTerminal() != tc.terminal || tc.status.IsFailed() != tc.failed || tc.status.IsTransitioning() != tc.transitioning {
+			t.Errorf("%s: terminal=%v failed=%v transitioning=%v", tc.status,
+				tc.status.IsTerminal(), tc.status.IsFailed(), tc.status.IsTransitioning())
+		}
+	}
+
+	if !ProgressStatus("").IsTerminal() || !ProgressStatusNone.IsTerminal() || !ProgressStatus("SOMETHING_NEW").IsTransitioning() {
+		t.Error("ProgressStatus: only NONE and empty are terminal")
+	}
+	if !BackupStatusError.IsFailed() || !BackupStatusError.IsTerminal() || !BackupStatusBackingUp.IsTransitioning() {
+		t.Error("BackupStatus helpers disagree with the documented values")
+	}
+}
+
+func TestWaitForInstance(t *testing.T) {
+	tests := []struct {
+		name      string
+		want      InstanceStatus
+		responses []string // "" answers 404
+		check     func(*testInstance, error) error
+	}{
+		{
+			name: "available once the operation ends",
+			want: InstanceStatusAvailable,
+			responses: []string{
+				`{"dbInstanceStatus":"BEFORE_CREATE","progressStatus":"CREATING"}`,
+				`{"dbInstanceStatus":"AVAILABLE","progressStatus":"APPLYING_PARAMETER_GROUP"}`,
+				`{"dbInstanceStatus":"AVAILABLE","progressStatus":"NONE"}`,
+			},
+			check: func(out *testInstance, err error) error {
+				if err != nil || out == nil || out.Progress != ProgressStatusNone {
+					return stderrors.New("did not wait for the operation to end")
+				}
+				return nil
+			},
+		},
+		{
+			name:      "failed status",
+			want:      InstanceStatusAvailable,
+			responses: []string{`{"dbInstanceStatus":"FAIL_TO_CREATE","progressStatus":"NONE"}`},
+			check: func(out *testInstance, err error) error {
+				var failed *errors.FailedStateError
+				if !stderrors.As(err, &failed) || out == nil {
+					return stderrors.New("want *errors.FailedStateError and the last response")
+				}
+				return nil
+			},
+		},
+		{
+			name:      "deleted",
+			want:      InstanceStatusDeleted,
+			responses: []string{`{"dbInstanceStatus":"AVAILABLE","progressStatus":"DELETING"}`, ""},
+			check: func(out *testInstance, err error) error {
+				if err != nil || out != nil {
+					return stderrors.New("want a nil instance once it is gone")
+				}
+				return nil
+			},
+		},
+		{
+			name: "unsettled want",
+			want: InstanceStatusBeforeCreate,
+			check: func(out *testInstance, err error) error {
+				var invalid *errors.ValidationError
+				if !stderrors.As(err, &invalid) {
+					return stderrors.New("want *errors.ValidationError")
+				}
+				return nil
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := cloudtest.New(t)
+			n := 0
+			srv.Mux.HandleFunc(host+"/v3.0/db-instances/db-1", func(w http.ResponseWriter, r *http.Request) {
+				body := tc.responses[len(tc.responses)-1]
+				if n < len(tc.responses) {
+					body = tc.responses[n]
+				}
+				n++
+				if body == "" {
+					cloudtest.WriteJSON(w, http.StatusNotFound, map[string]interface{}{"header": map[string]interface{}{"isSuccessful": false}})
+					return
+				}
+				w.Write([]byte(body))
+			})
+			c := newTestClient(t, srv)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			out, err := c.WaitForInstance(ctx, "db-1", tc.want, time.Millisecond)
+			if problem := tc.check(out, err); problem != nil {
+				t.Errorf("%v (out %+v, err %v)", problem, out, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package rdscore
+
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
+// Types below have the same shape in every engine's API. The engine packages
+// re-export them as aliases.
+
+// ResponseHeader represents common API response header
+type ResponseHeader struct {
+	ResultCode    int    `json:"resultCode"`
+	ResultMessage string `json:"resultMessage"`
+	IsSuccessful  bool   `json:"isSuccessful"`
+}
+
+// JobIDResponse is returned by asynchronous operations
+type JobIDResponse struct {
+	Header *ResponseHeader `json:"header"`
+	JobID  string          `json:"jobId"`
+}
+
+// RestartInstanceRequest represents a request to restart a database instance
+type RestartInstanceRequest struct {
+	// UseOnlineFailover enables restart using failover (HA instances only)
+	// When true, minimizes downtime by failing over to standby before restart
+	UseOnlineFailover bool `json:"useOnlineFailover,omitempty"`
+	// ExecuteBackup triggers a backup before restart
+	ExecuteBackup bool `json:"executeBackup,omitempty"`
+}
+
+type StorageTypesResponse struct {
+	Header       *ResponseHeader `json:"header"`
+	StorageTypes []string        `json:"storageTypes"`
+}
+
+type ModifyParametersRequest struct {
+	ModifiedParameters []struct {
+		ParameterID string `json:"parameterId"`
+		Value       string `json:"value"`
+	} `json:"modifiedParameters"`
+}
+
+type CreateBackupRequest struct {
+	BackupName string `json:"backupName"`
+}
+
+type BackupToObjectStorageRequest struct {
+	TenantID        string        `json:"tenantId"`
+	Username        string        `json:"username"`
+	Password        secret.String `json:"password"`
+	TargetContainer string        `json:"targetContainer"`
+	ObjectPath      string        `json:"objectPath"`
+}
+
+type ModifyNetworkInfoRequest struct {
+	UsePublicAccess bool `json:"usePublicAccess"`
+}
+
+type ModifyDeletionProtectionRequest struct {
+	UseDeletionProtection bool `json:"useDeletionProtection"`
+}
+
+type UpdateUserGroupRequest struct {
+	UserGroupName string   `json:"userGroupName,omitempty"`
+	MemberIDs     []string `json:"memberIds,omitempty"`
+}
+
+type CopyParameterGroupRequest struct {
+	ParameterGroupName string `json:"parameterGroupName"`
+	Description        string `json:"description,omitempty"`
+}
+
+type CreateNotificationGroupRequest struct {
+	NotificationGroupName string `json:"notificationGroupName"`
+	Description           string `json:"description,omitempty"`
+	NotificationType      string `json:"notificationType"`
+	IsEnabled             bool   `json:"isEnabled"`
+	Recipients            []struct {
+		RecipientType string `json:"recipientType"`
+		Recipient     string `json:"recipient"`
+	} `json:"recipients"`
+}
+
+type CreateParameterGroupRequest struct {
+	ParameterGroupName string `json:"parameterGroupName"`
+	Description        string `json:"description,omitempty"`
+	DBVersion          string `json:"dbVersion"`
+}
+
+type LogFile struct {
+	LogFileName string         `json:"logFileName"`
+	LogFileSize int64          `json:"logFileSize"`
+	CreatedYmdt timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt timestamp.Time `json:"updatedYmdt"`
+}
+
+type LogFilesResponse struct {
+	Header   *ResponseHeader `json:"header"`
+	LogFiles []LogFile       `json:"logFiles"`
+}
+
+type ParameterGroupIDResponse struct {
+	Header           *ResponseHeader `json:"header"`
+	ParameterGroupID string          `json:"parameterGroupId"`
+}
+
+type UserGroupIDResponse struct {
+	Header      *ResponseHeader `json:"header"`
+	UserGroupID string          `json:"userGroupId"`
+}
@@ -0,0 +1,311 @@
+// Package replay decodes captured API responses into the SDK's typed
+// outputs.
+//
+// Files are named the way nhncloud/internal/capture writes them,
+// <METHOD>_<path-slug>_<unix-nanos>.json. Each file is matched to the
+// operation that produced it by a Route, decoded into that operation's
+// output type and checked for fidelity:
+//
+//   - every field of the response has a destination in the output type
+//     (see drift.UnknownFields), and
+//   - re-encoding the decoded value reproduces every non-zero value of the
+//     response, so no field is dropped or mangled by a mistyped struct.
+//
+// Only live captures say anything about the API itself. Setting
+// NHN_SDK_REPLAY_DIR to a directory of them, one subdirectory per service
+// (for example tests/captured-responses, filled by
+// examples/capture_*_smoke), replays them in go test. The fixtures under
+// testdata/<service> are not captures: they were written from the SDK's own
+// struct tags, so they guard the routing and decoding against regressions
+// but cannot show that a type matches what the API returns.
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
+// EnvVar names a directory of live captures, one subdirectory per service,
+// to replay in addition to the checked-in synthetic fixtures.
+const EnvVar = "NHN_SDK_REPLAY_DIR"
+
+// Route maps captured responses to the SDK operation that produced them.
+type Route struct {
+	// Method is the HTTP method, e.g. "GET".
+	Method string
+	// Pattern is the trailing part of the request path, with "{id}"
+	// standing for a path segment or part of one, e.g. "db-instances/{id}".
+	// It is matched against the end of the capture's path slug, so API
+	// version and app key prefixes need not be spelled out.
+	Pattern string
+	// Operation is the SDK method name, used in failure messages.
+	Operation string
+	// New returns a pointer to a zero value of the operation's output type.
+	New func() interface{}
+
+	re *regexp.Regexp
+}
+
+// NewRoute returns a Route decoding into *T.
+func NewRoute[T any](method, pattern, operation string) Route {
+	return Route{
+		Method:    method,
+		Pattern:   pattern,
+		Operation: operation,
+		New:       func() interface{} { return new(T) },
+	}
+}
+
+func (r *Route) regexp() *regexp.Regexp {
+	if r.re == nil {
+		slug := regexp.QuoteMeta(strings.ReplaceAll(strings.Trim(r.Pattern, "/"), "/", "_"))
+		slug = strings.ReplaceAll(slug, `\{id\}`, "[^_]+")
+		r.re = regexp.MustCompile("(^|_)" + slug + "$")
+	}
+	return r.re
+}
+
+// ParseName splits a capture file name into its HTTP method and path slug.
+func ParseName(name string) (method, slug string, ok bool) {
+	name = strings.TrimSuffix(filepath.Base(name), ".json")
+	method, rest, ok := strings.Cut(name, "_")
+	if !ok || method == "" || strings.ToUpper(method) != method {
+		return "", "", false
+	}
+	i := strings.LastIndex(rest, "_")
+	if i <= 0 {
+		return "", "", false
+	}
+	return method, rest[:i], true
+}
+
+// Match returns the route for a capture. When several routes match, the one
+// with the longest pattern wins, so "pools/{id}/members" is preferred over
+// "members".
+func Match(routes []Route, method, slug string) (Route, bool) {
+	best := -1
+	for i := range routes {
+		r := &routes[i]
+		if r.Method != method || !r.regexp().MatchString(slug) {
+			continue
+		}
+		if best < 0 || len(r.Pattern) > len(routes[best].Pattern) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return Route{}, false
+	}
+	return routes[best], true
+}
+
+// Verify decodes body into v and reports fields that v's type does not map
+// or that do not survive a round trip through v.
+func Verify(body []byte, v interface{}) error {
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("decode: %w", err)
+	}
+	unknown, err := drift.UnknownFields(body, v)
+	if err != nil {
+		return err
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unmapped fields: %s", strings.Join(unknown, ", "))
+	}
+
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("re-encode: %w", err)
+	}
+	var want, got interface{}
+	if err := json.Unmarshal(body, &want); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(encoded, &got); err != nil {
+		return err
+	}
+	diffs := make(map[string]bool)
+	compare(want, got, "", diffs)
+	if len(diffs) > 0 {
+		paths := make([]string, 0, len(diffs))
+		for p := range diffs {
+			paths = append(paths, p)
+		}
+		sort.Strings(paths)
+		return fmt.Errorf("lost on round trip: %s", strings.Join(paths, ", "))
+	}
+	return nil
+}
+
+// compare records the paths of want's non-zero values that got lacks or
+// holds with a different value.
+func compare(want, got interface{}, path string, diffs map[string]bool) {
+	if isZero(want) {
+		return
+	}
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, _ := got.(map[string]interface{})
+		for key, child := range w {
+			compare(child, lookup(g, key), join(path, key), diffs)
+		}
+	case []interface{}:
+		g, _ := got.([]interface{})
+		if len(g) != len(w) {
+			diffs[path] = true
+			return
+		}
+		for i := range w {
+			compare(w[i], g[i], path+"[]", diffs)
+		}
+	case string:
+		g, ok := got.(string)
+		if !ok || (g != w && !sameTime(w, g)) {
+			diffs[path] = true
+		}
+	case float64:
+		// Epoch timestamps re-encode as RFC 3339 strings.
+		if g, ok := got.(string); ok && sameTime(strconv.FormatFloat(w, 'f', -1, 64), g) {
+			return
+		}
+		if want != got {
+			diffs[path] = true
+		}
+	default:
+		if want != got {
+			diffs[path] = true
+		}
+	}
+}
+
+func lookup(m map[string]interface{}, key string) interface{} {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return nil
+}
+
+func isZero(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// sameTime accepts timestamps that timestamp.Time re-encodes in another
+// layout. Plain numbers are not treated as timestamps, so "1" and "1.0"
+// still differ.
+func sameTime(a, b string) bool {
+	if _, err := strconv.ParseFloat(b, 64); err == nil {
+		return false
+	}
+	ta, err := timestamp.Parse(a)
+	if err != nil || ta.IsZero() {
+		return false
+	}
+	tb, err := timestamp.Parse(b)
+	return err == nil && ta.Equal(tb)
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// Run verifies every capture in dir as a subtest. With requireMatch set, a
+// capture that no route claims fails; otherwise it is skipped, which suits
+// live capture directories that also hold responses the SDK does not wrap.
+func Run(t *testing.T, dir string, routes []Route, requireMatch bool) {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		file := file
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			method, slug, ok := ParseName(file)
+			if !ok {
+				t.Fatalf("not a capture file name")
+			}
+			route, ok := Match(routes, method, slug)
+			if !ok {
+				if requireMatch {
+					t.Fatalf("no route for %s %s", method, slug)
+				}
+				t.Skipf("no route for %s %s", method, slug)
+			}
+			body, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(strings.TrimSpace(string(body))) == 0 {
+				t.Skip("empty body")
+			}
+			if isErrorBody(body) {
+				t.Skip("error response")
+			}
+			if err := Verify(body, route.New()); err != nil {
+				t.Errorf("%s: %v", route.Operation, err)
+			}
+		})
+	}
+}
+
+// isErrorBody reports whether body is an error envelope rather than the
+// operation's output, as captures record failed calls too: an NHN Cloud
+// header with isSuccessful false, or an OpenStack fault such as
+// {"itemNotFound": {"code": 404, "message": "..."}}.
+func isErrorBody(body []byte) bool {
+	var doc map[string]json.RawMessage
+	if json.Unmarshal(body, &doc) != nil {
+		return false
+	}
+	if raw, ok := doc["header"]; ok {
+		var h struct {
+			IsSuccessful *bool `json:"isSuccessful"`
+		}
+		if json.Unmarshal(raw, &h) == nil && h.IsSuccessful != nil {
+			return !*h.IsSuccessful
+		}
+	}
+	if len(doc) != 1 {
+		return false
+	}
+	for _, raw := range doc {
+		var fault struct {
+			Code    json.RawMessage `json:"code"`
+			Message *string         `json:"message"`
+		}
+		return json.Unmarshal(raw, &fault) == nil && fault.Message != nil && len(fault.Code) > 0
+	}
+	return false
+}
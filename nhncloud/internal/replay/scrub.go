@@ -0,0 +1,121 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var (
+	uuidPattern   = regexp.MustCompile(`(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+	hexIDPattern  = regexp.MustCompile(`(?i)(^|_)[0-9a-f]{32}(_|$)`)
+	appKeyPattern = regexp.MustCompile(`(^|_)appkeys_[^_]+`)
+	ipv4Pattern   = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+	emailPattern  = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+)
+
+// Scrubber rewrites live captures so they can be checked in as fixtures.
+// It replaces resource IDs with made-up UUIDs, IPv4 addresses with
+// documentation addresses (192.0.2.0/24), email addresses and the values
+// of name fields, and drops app keys and tenant IDs from file names.
+// Credential fields are already redacted when a capture is written.
+//
+// Replacements are consistent across every file a Scrubber handles, so an
+// ID keeps matching the paths and references that carry it. The values
+// change but their JSON types do not, which is all the replay checks need.
+type Scrubber struct {
+	seen map[string]string
+	next map[string]int
+}
+
+// NewScrubber returns a Scrubber with no replacements yet.
+func NewScrubber() *Scrubber {
+	return &Scrubber{seen: make(map[string]string), next: make(map[string]int)}
+}
+
+// FileName returns the scrubbed name of a capture file.
+func (s *Scrubber) FileName(name string) string {
+	name = appKeyPattern.ReplaceAllString(name, "${1}appkeys_REDACTED")
+	name = hexIDPattern.ReplaceAllString(name, "${1}REDACTED${2}")
+	return uuidPattern.ReplaceAllStringFunc(name, s.uuid)
+}
+
+// Body returns the scrubbed body of a capture. A body that is not JSON is
+// an error rather than returned unscrubbed.
+func (s *Scrubber) Body(data []byte) ([]byte, error) {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("scrub: %w", err)
+	}
+	out, err := json.MarshalIndent(s.value("", v), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
+
+func (s *Scrubber) value(key string, v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		// Visit keys in order so the replacements do not depend on map
+		// iteration.
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			v[k] = s.value(k, v[k])
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = s.value(key, elem)
+		}
+	case string:
+		if v != "" && isNameKey(key) {
+			return s.replace(key, v, func(n int) string { return fmt.Sprintf("%s-%d", key, n) })
+		}
+		v = uuidPattern.ReplaceAllStringFunc(v, s.uuid)
+		v = ipv4Pattern.ReplaceAllStringFunc(v, func(ip string) string {
+			return s.replace("ip", ip, func(n int) string { return fmt.Sprintf("192.0.2.%d", (n-1)%254+1) })
+		})
+		return emailPattern.ReplaceAllStringFunc(v, func(addr string) string {
+			return s.replace("email", addr, func(n int) string { return fmt.Sprintf("user%d@example.com", n) })
+		})
+	}
+	return v
+}
+
+func (s *Scrubber) uuid(id string) string {
+	return s.replace("uuid", strings.ToLower(id), func(n int) string {
+		return fmt.Sprintf("00000000-0000-4000-8000-%012d", n)
+	})
+}
+
+// replace returns the replacement of value among the values of kind,
+// making one with format on first sight.
+func (s *Scrubber) replace(kind, value string, format func(n int) string) string {
+	k := kind + "\x00" + value
+	if r, ok := s.seen[k]; ok {
+		return r
+	}
+	s.next[kind]++
+	r := format(s.next[kind])
+	s.seen[k] = r
+	return r
+}
+
+// isNameKey reports whether key holds a user-chosen name, such as "name",
+// "dbInstanceName" or "display_name".
+func isNameKey(key string) bool {
+	if key == "name" || strings.HasSuffix(key, "_name") {
+		return true
+	}
+	return strings.HasSuffix(key, "Name") && len(key) > 4 && unicode.IsLower(rune(key[len(key)-5]))
+}
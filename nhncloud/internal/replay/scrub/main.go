@@ -0,0 +1,59 @@
+// Command scrub copies live captures into a replay fixture directory,
+// scrubbed for check-in (see replay.Scrubber):
+//
+//	go run ./nhncloud/internal/replay/scrub /tmp/rds-mysql nhncloud/internal/replay/testdata/rds-mysql
+//
+// A scrubbed capture replaces the fixture of the same operation; remove the
+// synthetic file it supersedes. Review the output before committing it:
+// the scrubber knows IDs, addresses and names, not every value a response
+// can carry.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/replay"
+)
+
+func main() {
+	if len(os.Args) != 3 {
+		fmt.Fprintln(os.Stderr, "usage: scrub <capture-dir> <fixture-dir>")
+		os.Exit(2)
+	}
+	if err := run(os.Args[1], os.Args[2]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(in, out string) error {
+	files, err := filepath.Glob(filepath.Join(in, "*.json"))
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no captures in %s", in)
+	}
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+	s := replay.NewScrubber()
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return err
+		}
+		body, err := s.Body(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f, err)
+		}
+		name := s.FileName(filepath.Base(f))
+		if err := os.WriteFile(filepath.Join(out, name), body, 0o644); err != nil {
+			return err
+		}
+		fmt.Println(name)
+	}
+	return nil
+}
@@ -15,20 +15,39 @@ import (
 	"strings"
 	"time"
 
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cache"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/circuitbreaker"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/capture"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 type TokenProvider interface {
 	GetBearerToken() (string, error)
 }
 
+// RequestAuthenticator supplies a token per request and decides where it
+// goes, for schemes other than the X-NHN-AUTHORIZATION bearer (for example
+// the Identity X-Auth-Token).
+type RequestAuthenticator interface {
+	GetToken(ctx context.Context) (string, error)
+	SetAuthHeader(req *http.Request, token string)
+}
+
 // Client is an HTTP client with retry support and middleware.
 type Client struct {
 	httpClient    *http.Client
 	baseURL       string
+	host          string
 	headers       map[string]string
 	tokenProvider TokenProvider
+	authenticator RequestAuthenticator
+	breaker       *circuitbreaker.Breaker
+	cache         *cache.Cache
+	drift         *drift.Detector
+	service       string
 
 	maxAttempts       int
 	initialBackoff    time.Duration
@@ -51,6 +70,7 @@ func NewClient(baseURL string, opts ...ClientOption) *Client {
 		},
 		baseURL:           strings.TrimSuffix(baseURL, "/"),
 		headers:           make(map[string]string),
+		drift:             drift.FromEnv(),
 		maxAttempts:       3,
 		initialBackoff:    100 * time.Millisecond,
 		maxBackoff:        5 * time.Second,
@@ -60,6 +80,10 @@ func NewClient(baseURL string, opts ...ClientOption) *Client {
 		},
 	}
 
+	if u, err := url.Parse(c.baseURL); err == nil {
+		c.host = u.Host
+	}
+
 	for _, opt := range opts {
 		opt(c)
 	}
@@ -130,13 +154,67 @@ func WithBearerAuth(token string) ClientOption {
 }
 
 // WithDynamicBearerAuth sets dynamic Bearer token authentication using a token provider.
+// An empty appKey omits the X-TC-APP-KEY header.
 func WithDynamicBearerAuth(appKey string, provider TokenProvider) ClientOption {
 	return func(c *Client) {
-		c.headers["X-TC-APP-KEY"] = appKey
+		if appKey != "" {
+			c.headers["X-TC-APP-KEY"] = appKey
+		}
 		c.tokenProvider = provider
 	}
 }
 
+// WithRequestAuth authenticates every attempt with a token from a.
+func WithRequestAuth(a RequestAuthenticator) ClientOption {
+	return func(c *Client) {
+		c.authenticator = a
+	}
+}
+
+// WithCircuitBreaker guards every request with b, keyed by the endpoint host.
+// While the circuit is open, Do fails immediately with
+// *errors.CircuitOpenError and no retries are attempted.
+func WithCircuitBreaker(b *circuitbreaker.Breaker) ClientOption {
+	return func(c *Client) {
+		c.breaker = b
+	}
+}
+
+// WithDrift reports response fields that the decoded types do not map.
+func WithDrift(d *drift.Detector, service string) ClientOption {
+	return func(c *Client) {
+		c.drift = d
+		c.service = service
+	}
+}
+
+// WithCache enables CachedGET for the given service and makes every
+// successful mutating request drop that service's cached entries.
+func WithCache(cc *cache.Cache, service string) ClientOption {
+	return func(c *Client) {
+		c.cache = cc
+		c.service = service
+	}
+}
+
+// Options translates the public settings into client options for service.
+func Options(s option.Settings, service string) []ClientOption {
+	var opts []ClientOption
+	if s.HTTPClient != nil {
+		opts = append(opts, WithHTTPClient(s.HTTPClient))
+	}
+	if s.CircuitBreaker != nil {
+		opts = append(opts, WithCircuitBreaker(s.CircuitBreaker))
+	}
+	if s.Cache != nil {
+		opts = append(opts, WithCache(s.Cache, service))
+	}
+	if s.Drift != nil {
+		opts = append(opts, WithDrift(s.Drift, service))
+	}
+	return opts
+}
+
 // Request represents an HTTP request to be executed.
 type Request struct {
 	Method  string
@@ -159,7 +237,27 @@ func (c *Client) Do(ctx context.Context, req *Request) (*Response, error) {
 	backoff := c.initialBackoff
 
 	for attempt := 1; attempt <= c.maxAttempts; attempt++ {
+		if c.breaker != nil {
+			if err := c.breaker.Allow(c.host); err != nil {
+				return nil, err
+			}
+		}
+
 		resp, err := c.doOnce(ctx, req, attempt)
+		if err == nil && c.cache != nil && req.Method != http.MethodGet {
+			c.cache.InvalidateService(c.service)
+		}
+		if c.breaker != nil {
+			switch {
+			case ctx.Err() != nil:
+				// The caller gave up; that says nothing about the endpoint.
+				c.breaker.Release(c.host)
+			default:
+				// Only failures that say the endpoint itself is unhealthy
+				// count against the circuit; 4xx means it answered fine.
+				c.breaker.Record(c.host, err == nil || !errors.IsRetryable(err))
+			}
+		}
 		if err == nil {
 			return resp, nil
 		}
@@ -251,6 +349,13 @@ func (c *Client) doOnce(ctx context.Context, req *Request, attempt int) (*Respon
 		}
 		httpReq.Header.Set("X-NHN-AUTHORIZATION", "Bearer "+token)
 	}
+	if c.authenticator != nil {
+		token, err := c.authenticator.GetToken(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get access token: %w", err)
+		}
+		c.authenticator.SetAuthHeader(httpReq, token)
+	}
 
 	// Debug logging
 	if c.debug {
@@ -377,7 +482,7 @@ func (c *Client) logRequest(req *http.Request, body interface{}, attempt int) {
 	}
 	if body != nil {
 		if jsonData, err := json.MarshalIndent(body, "", "  "); err == nil {
-			fmt.Printf("Body: %s\n", string(jsonData))
+			fmt.Printf("Body: %s\n", secret.RedactJSON(jsonData))
 		}
 	}
 	fmt.Printf("===========================\n")
@@ -393,6 +498,7 @@ func (c *Client) logResponse(resp *http.Response, body []byte) {
 		}
 	}
 	if len(body) > 0 {
+		body = secret.RedactJSON(body)
 		// Truncate very long responses
 		if len(body) > 2000 {
 			fmt.Printf("Body (truncated): %s...\n", string(body[:2000]))
@@ -405,6 +511,15 @@ func (c *Client) logResponse(resp *http.Response, body []byte) {
 
 // --- Convenience methods ---
 
+// decode unmarshals a response body into result, checking it for unmapped
+// fields first when drift detection is enabled.
+func (c *Client) decode(body []byte, result interface{}) error {
+	if err := c.drift.Check(c.service, "", body, result); err != nil {
+		return err
+	}
+	return json.Unmarshal(body, result)
+}
+
 // GET performs a GET request.
 func (c *Client) GET(ctx context.Context, path string, result interface{}) error {
 	resp, err := c.Do(ctx, &Request{Method: "GET", Path: path})
@@ -412,11 +527,50 @@ func (c *Client) GET(ctx context.Context, path string, result interface{}) error
 		return err
 	}
 	if result != nil && len(resp.Body) > 0 {
-		return json.Unmarshal(resp.Body, result)
+		return c.decode(resp.Body, result)
 	}
 	return nil
 }
 
+// CachedGET performs a GET request for a catalog operation, serving the
+// response from the configured cache when possible. Without a cache it is
+// equivalent to GET.
+func (c *Client) CachedGET(ctx context.Context, operation, path string, result interface{}) error {
+	if c.cache == nil || c.cache.TTL(c.service, operation) <= 0 {
+		return c.GET(ctx, path, result)
+	}
+	key := cache.Key(c.identity(), c.baseURL+path)
+	if body, ok := c.cache.Get(c.service, operation, key); ok {
+		return json.Unmarshal(body, result)
+	}
+	gen := c.cache.Generation(c.service)
+
+	resp, err := c.Do(ctx, &Request{Method: "GET", Path: path})
+	if err != nil {
+		return err
+	}
+	if len(resp.Body) == 0 {
+		return nil
+	}
+	if err := c.decode(resp.Body, result); err != nil {
+		return err
+	}
+	c.cache.SetIfCurrent(c.service, operation, key, resp.Body, gen)
+	return nil
+}
+
+// identity names the project and credentials c sends requests for.
+func (c *Client) identity() string {
+	id := c.headers["X-TC-APP-KEY"] + "/" + c.headers["X-TC-AUTHENTICATION-ID"]
+	if p, ok := c.tokenProvider.(interface{ GetAccessKeyID() string }); ok {
+		id += p.GetAccessKeyID()
+	}
+	if a, ok := c.authenticator.(interface{ Identity() string }); ok {
+		id += a.Identity()
+	}
+	return id
+}
+
 // POST performs a POST request.
 func (c *Client) POST(ctx context.Context, path string, body, result interface{}) error {
 	resp, err := c.Do(ctx, &Request{Method: "POST", Path: path, Body: body})
@@ -424,7 +578,7 @@ func (c *Client) POST(ctx context.Context, path string, body, result interface{}
 		return err
 	}
 	if result != nil && len(resp.Body) > 0 {
-		return json.Unmarshal(resp.Body, result)
+		return c.decode(resp.Body, result)
 	}
 	return nil
 }
@@ -436,7 +590,7 @@ func (c *Client) PUT(ctx context.Context, path string, body, result interface{})
 		return err
 	}
 	if result != nil && len(resp.Body) > 0 {
-		return json.Unmarshal(resp.Body, result)
+		return c.decode(resp.Body, result)
 	}
 	return nil
 }
@@ -448,7 +602,7 @@ func (c *Client) DELETE(ctx context.Context, path string, result interface{}) er
 		return err
 	}
 	if result != nil && len(resp.Body) > 0 {
-		return json.Unmarshal(resp.Body, result)
+		return c.decode(resp.Body, result)
 	}
 	return nil
 }
@@ -0,0 +1,128 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cache"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/circuitbreaker"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+)
+
+func TestDoRetries(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.Header.Get("X-TC-APP-KEY") != "app" {
+			t.Errorf("X-TC-APP-KEY = %q", r.Header.Get("X-TC-APP-KEY"))
+		}
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, WithRetry(3, time.Millisecond, time.Millisecond), WithAppKeyAuth("app", "id", "secret"))
+	resp, err := c.Do(context.Background(), &Request{Method: http.MethodGet, Path: "/db-instances"})
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if string(resp.Body) != `{"ok":true}` || requests != 3 {
+		t.Errorf("Do = %s after %d requests", resp.Body, requests)
+	}
+}
+
+func TestDoCircuitBreaker(t *testing.T) {
+	var status, requests int32 = http.StatusServiceUnavailable, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	breaker := circuitbreaker.New(circuitbreaker.Config{FailureThreshold: 3, OpenTimeout: time.Hour})
+	opts := Options(option.Apply(option.WithCircuitBreaker(breaker)), "rds-mysql")
+	c := NewClient(server.URL, append(opts, WithRetry(3, time.Millisecond, time.Millisecond))...)
+	host := strings.TrimPrefix(server.URL, "http://")
+	ctx := context.Background()
+	req := &Request{Method: http.MethodGet, Path: "/db-instances"}
+
+	// A 404 says the endpoint is healthy and is not retried.
+	atomic.StoreInt32(&status, http.StatusNotFound)
+	if _, err := c.Do(ctx, req); err == nil {
+		t.Fatal("expected an error from a 404")
+	}
+	if requests != 1 || breaker.State(host) != circuitbreaker.StateClosed {
+		t.Fatalf("after a 404: %d requests, circuit %s", requests, breaker.State(host))
+	}
+
+	// Three retried 503s open the circuit.
+	atomic.StoreInt32(&status, http.StatusServiceUnavailable)
+	if _, err := c.Do(ctx, req); err == nil {
+		t.Fatal("expected an error from a 503")
+	}
+	if requests != 4 || breaker.State(host) != circuitbreaker.StateOpen {
+		t.Fatalf("after 503s: %d requests, circuit %s", requests, breaker.State(host))
+	}
+
+	if _, err := c.Do(ctx, req); !errors.IsCircuitOpen(err) {
+		t.Errorf("expected CircuitOpenError, got %v", err)
+	}
+	if requests != 4 {
+		t.Errorf("the open circuit let %d requests through", requests-4)
+	}
+}
+
+func TestDoCanceledReleasesProbe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	breaker := circuitbreaker.New(circuitbreaker.Config{FailureThreshold: 1})
+	c := NewClient(server.URL, WithCircuitBreaker(breaker), WithoutRetry())
+	if _, err := c.Do(ctx, &Request{Method: http.MethodGet, Path: "/"}); err == nil {
+		t.Fatal("expected an error from a canceled request")
+	}
+	if got := breaker.State(strings.TrimPrefix(server.URL, "http://")); got != circuitbreaker.StateClosed {
+		t.Errorf("a canceled request moved the circuit to %s", got)
+	}
+}
+
+func TestCachedGETScopedByAppKey(t *testing.T) {
+	var gets int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&gets, 1)
+		w.Write([]byte(`{"appKey":"` + r.Header.Get("X-TC-APP-KEY") + `"}`))
+	}))
+	defer server.Close()
+
+	cc := cache.New(cache.Config{})
+	opts := Options(option.Apply(option.WithCache(cc)), "rds-mysql")
+	opts = opts[:len(opts):len(opts)]
+	a := NewClient(server.URL, append(opts, WithAppKeyAuth("project-a", "id", "secret"))...)
+	b := NewClient(server.URL, append(opts, WithAppKeyAuth("project-b", "id", "secret"))...)
+
+	for i, c := range []*Client{a, b, a, b} {
+		var out struct{ AppKey string }
+		if err := c.CachedGET(context.Background(), cache.OpListFlavors, "/db-flavors", &out); err != nil {
+			t.Fatal(err)
+		}
+		if want := []string{"project-a", "project-b"}[i%2]; out.AppKey != want {
+			t.Errorf("%s got the flavors of %s", want, out.AppKey)
+		}
+	}
+	if gets != 2 {
+		t.Errorf("expected one GET per project, got %d", gets)
+	}
+}
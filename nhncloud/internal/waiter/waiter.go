@@ -0,0 +1,48 @@
+// Package waiter polls a resource until it reaches a wanted state. The
+// service packages build their WaitFor* methods on it.
+package waiter
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+)
+
+// DefaultInterval is used when a caller passes a zero interval.
+const DefaultInterval = 10 * time.Second
+
+// Poll calls check immediately and then every interval until it reports
+// done or returns an error. It returns ctx.Err() when ctx ends first, so
+// callers bound the wait with a context deadline.
+func Poll(ctx context.Context, interval time.Duration, check func(context.Context) (done bool, err error)) error {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		done, err := check(ctx)
+		if err != nil || done {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// IsNotFound reports whether err is a 404 from either of the SDK's HTTP
+// clients, which waiters treat as "deleted".
+func IsNotFound(err error) bool {
+	if errors.IsNotFound(err) {
+		return true
+	}
+	var apiErr *client.APIError
+	return stderrors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,82 @@
+package waiter
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/transport"
+)
+
+func TestPoll(t *testing.T) {
+	ctx := context.Background()
+
+	calls := 0
+	err := Poll(ctx, time.Millisecond, func(context.Context) (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	if err != nil || calls != 3 {
+		t.Errorf("Poll = %v after %d checks, want nil after 3", err, calls)
+	}
+
+	boom := stderrors.New("boom")
+	calls = 0
+	err = Poll(ctx, time.Millisecond, func(context.Context) (bool, error) {
+		calls++
+		return false, boom
+	})
+	if err != boom || calls != 1 {
+		t.Errorf("Poll = %v after %d checks, want the check's error after 1", err, calls)
+	}
+
+	// A zero interval means DefaultInterval, so only the deadline ends this.
+	short, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	calls = 0
+	err = Poll(short, 0, func(context.Context) (bool, error) {
+		calls++
+		return false, nil
+	})
+	if err != context.DeadlineExceeded || calls != 1 {
+		t.Errorf("Poll = %v after %d checks, want DeadlineExceeded after 1", err, calls)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := http.StatusNotFound
+		if r.URL.Path == "/forbidden" {
+			status = http.StatusForbidden
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+	var out struct{}
+
+	identity := client.NewClient(srv.URL, nil)
+	appKey := transport.NewClient(srv.URL)
+	for name, err := range map[string]error{
+		"client":    identity.GET(ctx, "/servers/gone", &out),
+		"transport": appKey.GET(ctx, "/db-instances/gone", &out),
+	} {
+		if !IsNotFound(err) {
+			t.Errorf("%s: IsNotFound(%v) = false", name, err)
+		}
+	}
+	for name, err := range map[string]error{
+		"client 403":    identity.GET(ctx, "/forbidden", &out),
+		"transport 403": appKey.GET(ctx, "/forbidden", &out),
+		"other":         stderrors.New("connection refused"),
+	} {
+		if IsNotFound(err) {
+			t.Errorf("%s: IsNotFound(%v) = true", name, err)
+		}
+	}
+}
@@ -0,0 +1,399 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/storage/nas"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/storage/object"
+)
+
+// Resource types.
+const (
+	TypeServer        = "compute.server"
+	TypeImage         = "compute.image"
+	TypeFlavor        = "compute.flavor"
+	TypeVolume        = "block.volume"
+	TypeSnapshot      = "block.snapshot"
+	TypeVPC           = "network.vpc"
+	TypeSubnet        = "network.subnet"
+	TypePort          = "network.port"
+	TypeSecurityGroup = "network.security-group"
+	TypeFloatingIP    = "network.floating-ip"
+	TypeLoadBalancer  = "network.load-balancer"
+	TypeNKSCluster    = "nks.cluster"
+	TypeNKSNodeGroup  = "nks.node-group"
+	TypeNCRRegistry   = "ncr.registry"
+	TypeContainer     = "object-storage.container"
+	TypeNASVolume     = "nas.volume"
+	TypeDNSZone       = "dnsplus.zone"
+
+	TypeMySQLInstance      = "rds-mysql" + rdsInstance
+	TypeMySQLBackup        = "rds-mysql" + rdsBackup
+	TypeMariaDBInstance    = "rds-mariadb" + rdsInstance
+	TypeMariaDBBackup      = "rds-mariadb" + rdsBackup
+	TypePostgreSQLInstance = "rds-postgresql" + rdsInstance
+	TypePostgreSQLBackup   = "rds-postgresql" + rdsBackup
+
+	TypeMySQLParameterGroup      = "rds-mysql" + rdsParameterGroup
+	TypeMariaDBParameterGroup    = "rds-mariadb" + rdsParameterGroup
+	TypePostgreSQLParameterGroup = "rds-postgresql" + rdsParameterGroup
+
+	// The engine's service key prefixes the RDS resource types, including
+	// the security groups instances refer to.
+	rdsInstance       = ".instance"
+	rdsBackup         = ".backup"
+	rdsSecurityGroup  = ".security-group"
+	rdsParameterGroup = ".parameter-group"
+)
+
+// pageSize is the page size of the paginated list calls.
+const pageSize = 100
+
+// Collector lists the resources of one type in a region.
+type Collector struct {
+	// Name identifies the collector in failures, e.g. "compute.servers".
+	Name string
+	// Service is the service key, like Config.AppKeys, that decides the
+	// regions the collector runs in (see nhncloud.MultiRegion.RegionsFor).
+	Service string
+	// Types are the resource types the collector returns. When it fails,
+	// Diff leaves these types of the region out; without Types, the whole
+	// region.
+	Types []string
+	// Collect returns the resources of a region. A collector that could
+	// list only some of them returns those with an *IncompleteError.
+	Collect func(ctx context.Context, c *nhncloud.Client) ([]Resource, error)
+}
+
+// IncompleteError is returned by a collector with the resources it did
+// list, when resources of Types may be missing. Take keeps the resources
+// and records a Failure for Types only.
+type IncompleteError struct {
+	Types []string
+	Err   error
+}
+
+func (e *IncompleteError) Error() string { return e.Err.Error() }
+
+func (e *IncompleteError) Unwrap() error { return e.Err }
+
+// Collectors returns the built-in collectors.
+func Collectors() []Collector {
+	collectors := []Collector{
+		{"compute.servers", "compute", []string{TypeServer}, collectServers},
+		{"block.volumes", "block-storage", []string{TypeVolume}, collectVolumes},
+		{"block.snapshots", "block-storage", []string{TypeSnapshot}, collectSnapshots},
+		{"network.vpcs", "network", []string{TypeVPC}, collectVPCs},
+		{"network.subnets", "network", []string{TypeSubnet}, collectSubnets},
+		{"network.security-groups", "network", []string{TypeSecurityGroup}, collectSecurityGroups},
+		{"network.floating-ips", "network", []string{TypeFloatingIP}, collectFloatingIPs},
+		{"network.load-balancers", "network", []string{TypeLoadBalancer}, collectLoadBalancers},
+	}
+	for _, e := range rdsEngines {
+		collectors = append(collectors, e.collectors()...)
+	}
+	return append(collectors, []Collector{
+		{"nks.clusters", "nks", []string{TypeNKSCluster, TypeNKSNodeGroup}, collectNKS},
+		{"ncr.registries", "ncr", []string{TypeNCRRegistry}, collectRegistries},
+		{"object-storage.containers", "object-storage", []string{TypeContainer}, collectContainers},
+		{"nas.volumes", "nas", []string{TypeNASVolume}, collectNASVolumes},
+		{"dnsplus.zones", "dnsplus", []string{TypeDNSZone}, collectDNSZones},
+	}...)
+}
+
+// relations returns a Relation of type typ for each non-empty ID.
+func relations(typ string, ids ...string) []Relation {
+	var rels []Relation
+	for _, id := range ids {
+		if id != "" {
+			rels = append(rels, Relation{Type: typ, ID: id})
+		}
+	}
+	return rels
+}
+
+func collectServers(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	out, err := c.Compute().ListServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Servers name their security groups; IDs are not returned.
+	sgIDs := securityGroupIDs(ctx, c)
+	resources := make([]Resource, 0, len(out.Servers))
+	for _, s := range out.Servers {
+		rels := relations(TypeImage, s.Image.ID)
+		rels = append(rels, relations(TypeFlavor, s.Flavor.ID)...)
+		for _, sg := range s.SecurityGroups {
+			if id := sgIDs[sg.Name]; id != "" {
+				rels = append(rels, Relation{Type: TypeSecurityGroup, ID: id})
+			} else {
+				rels = append(rels, Relation{Type: TypeSecurityGroup, Name: sg.Name})
+			}
+		}
+		resources = append(resources, Resource{
+			Type: TypeServer, ID: s.ID, Name: s.Name, Status: string(s.Status), CreatedAt: s.Created,
+			Relations: rels, Attributes: attributes(s),
+		})
+	}
+	return resources, nil
+}
+
+// securityGroupIDs maps the security group names of the project to their
+// IDs. Names shared by several groups map to "", as does every name when
+// the groups cannot be listed; those relations keep the name.
+func securityGroupIDs(ctx context.Context, c *nhncloud.Client) map[string]string {
+	out, err := c.SecurityGroup().ListSecurityGroups(ctx)
+	if err != nil {
+		return nil
+	}
+	ids := make(map[string]string, len(out.SecurityGroups))
+	for _, sg := range out.SecurityGroups {
+		if _, dup := ids[sg.Name]; dup {
+			ids[sg.Name] = ""
+		} else {
+			ids[sg.Name] = sg.ID
+		}
+	}
+	return ids
+}
+
+func collectVolumes(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	out, err := c.BlockStorage().ListVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]Resource, 0, len(out.Volumes))
+	for _, v := range out.Volumes {
+		var rels []Relation
+		for _, a := range v.Attachments {
+			rels = append(rels, relations(TypeServer, a.ServerID)...)
+		}
+		rels = append(rels, relations(TypeSnapshot, v.SnapshotID)...)
+		resources = append(resources, Resource{
+			Type: TypeVolume, ID: v.ID, Name: v.Name, Status: string(v.Status), CreatedAt: v.CreatedAt,
+			Relations: rels, Attributes: attributes(v),
+		})
+	}
+	return resources, nil
+}
+
+func collectSnapshots(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	out, err := c.BlockStorage().ListSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]Resource, 0, len(out.Snapshots))
+	for _, s := range out.Snapshots {
+		resources = append(resources, Resource{
+			Type: TypeSnapshot, ID: s.ID, Name: s.Name, Status: string(s.Status), CreatedAt: s.CreatedAt,
+			Relations: relations(TypeVolume, s.VolumeID), Attributes: attributes(s),
+		})
+	}
+	return resources, nil
+}
+
+func collectVPCs(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	out, err := c.VPC().ListVPCs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]Resource, 0, len(out.VPCs))
+	for _, v := range out.VPCs {
+		resources = append(resources, Resource{
+			Type: TypeVPC, ID: v.ID, Name: v.Name, Status: v.State, CreatedAt: v.CreatedAt,
+			Attributes: attributes(v),
+		})
+	}
+	return resources, nil
+}
+
+func collectSubnets(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	out, err := c.VPC().ListSubnets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]Resource, 0, len(out.Subnets))
+	for _, s := range out.Subnets {
+		vpcID := s.VPCID
+		if vpcID == "" {
+			vpcID = s.NetworkID
+		}
+		resources = append(resources, Resource{
+			Type: TypeSubnet, ID: s.ID, Name: s.Name,
+			Relations: relations(TypeVPC, vpcID), Attributes: attributes(s),
+		})
+	}
+	return resources, nil
+}
+
+func collectSecurityGroups(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	out, err := c.SecurityGroup().ListSecurityGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]Resource, 0, len(out.SecurityGroups))
+	for _, sg := range out.SecurityGroups {
+		resources = append(resources, Resource{
+			Type: TypeSecurityGroup, ID: sg.ID, Name: sg.Name,
+			Attributes: attributes(sg),
+		})
+	}
+	return resources, nil
+}
+
+func collectFloatingIPs(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	out, err := c.FloatingIP().ListFloatingIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]Resource, 0, len(out.FloatingIPs))
+	for _, ip := range out.FloatingIPs {
+		var rels []Relation
+		if ip.PortID != nil {
+			rels = relations(TypePort, *ip.PortID)
+		}
+		resources = append(resources, Resource{
+			Type: TypeFloatingIP, ID: ip.ID, Name: ip.FloatingIPAddress, Status: ip.Status, CreatedAt: ip.CreatedAt,
+			Relations: rels, Attributes: attributes(ip),
+		})
+	}
+	return resources, nil
+}
+
+func collectLoadBalancers(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	out, err := c.LoadBalancer().ListLoadBalancers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]Resource, 0, len(out.LoadBalancers))
+	for _, lb := range out.LoadBalancers {
+		rels := relations(TypeSubnet, lb.VIPSubnetID)
+		rels = append(rels, relations(TypePort, lb.VIPPortID)...)
+		resources = append(resources, Resource{
+			Type: TypeLoadBalancer, ID: lb.ID, Name: lb.Name, Status: string(lb.ProvisioningStatus), CreatedAt: lb.CreatedAt,
+			Relations: rels, Attributes: attributes(lb),
+		})
+	}
+	return resources, nil
+}
+
+// collectNKS lists clusters and the node groups of each. A cluster whose
+// node groups cannot be listed fails only its node groups.
+func collectNKS(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	client := c.NKS()
+	out, err := client.ListClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resources []Resource
+	var failed []error
+	for _, cl := range out.Clusters {
+		rels := relations(TypeVPC, cl.NetworkID)
+		rels = append(rels, relations(TypeSubnet, cl.SubnetID)...)
+		resources = append(resources, Resource{
+			Type: TypeNKSCluster, ID: cl.ID, Name: cl.Name, Status: string(cl.Status), CreatedAt: cl.CreatedAt,
+			Relations: rels, Attributes: attributes(cl),
+		})
+
+		groups, err := client.ListNodeGroups(ctx, cl.ID)
+		if err != nil {
+			failed = append(failed, fmt.Errorf("node groups of cluster %s: %w", cl.ID, err))
+			continue
+		}
+		for _, ng := range groups.NodeGroups {
+			rels := relations(TypeNKSCluster, cl.ID)
+			rels = append(rels, relations(TypeFlavor, ng.FlavorID)...)
+			resources = append(resources, Resource{
+				Type: TypeNKSNodeGroup, ID: ng.ID, Name: ng.Name, Status: string(ng.Status), CreatedAt: ng.CreatedAt,
+				Relations: rels, Attributes: attributes(ng),
+			})
+		}
+	}
+	if len(failed) > 0 {
+		return resources, &IncompleteError{Types: []string{TypeNKSNodeGroup}, Err: errors.Join(failed...)}
+	}
+	return resources, nil
+}
+
+func collectRegistries(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	out, err := c.NCR().ListRegistries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]Resource, 0, len(out.Registries))
+	for _, r := range out.Registries {
+		resources = append(resources, Resource{
+			Type: TypeNCRRegistry, ID: strconv.FormatInt(r.ID, 10), Name: r.Name, Status: r.Status, CreatedAt: r.CreatedAt,
+			Attributes: attributes(r),
+		})
+	}
+	return resources, nil
+}
+
+// collectContainers lists object storage containers. Containers have no ID;
+// the name is used.
+func collectContainers(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	in := &object.ListContainersInput{Limit: pageSize}
+	var resources []Resource
+	for {
+		out, err := c.ObjectStorage().ListContainers(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		for _, ct := range out.Containers {
+			resources = append(resources, Resource{
+				Type: TypeContainer, ID: ct.Name, Name: ct.Name,
+				Attributes: attributes(ct),
+			})
+		}
+		if len(out.Containers) < pageSize {
+			return resources, nil
+		}
+		in.Marker = out.Containers[len(out.Containers)-1].Name
+	}
+}
+
+func collectNASVolumes(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	limit := pageSize
+	var resources []Resource
+	for page := 1; ; page++ {
+		page := page
+		out, err := c.NAS().ListVolumes(ctx, &nas.ListVolumesInput{Limit: &limit, Page: &page})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range out.Volumes {
+			var rels []Relation
+			for _, iface := range v.Interfaces {
+				rels = append(rels, relations(TypeSubnet, iface.SubnetID)...)
+			}
+			resources = append(resources, Resource{
+				Type: TypeNASVolume, ID: v.ID, Name: v.Name, Status: string(v.Status), CreatedAt: v.CreatedAt,
+				Relations: rels, Attributes: attributes(v),
+			})
+		}
+		// A response without a total reads as 0; only a short page ends it.
+		if len(out.Volumes) < pageSize || (out.Paging.TotalCount > 0 && len(resources) >= out.Paging.TotalCount) {
+			return resources, nil
+		}
+	}
+}
+
+func collectDNSZones(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	out, err := c.DNSPlus().ListZones(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]Resource, 0, len(out.ZoneList))
+	for _, z := range out.ZoneList {
+		resources = append(resources, Resource{
+			Type: TypeDNSZone, ID: z.ZoneID, Name: z.ZoneName, Status: z.ZoneStatus, CreatedAt: z.CreatedAt,
+			Attributes: attributes(z),
+		})
+	}
+	return resources, nil
+}
@@ -0,0 +1,179 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/cloudtest"
+)
+
+// page returns items [from, to) of n as fn(i), for 1-based page and limit.
+func page(n, pageNum, limit int, fn func(i int) map[string]interface{}) []map[string]interface{} {
+	items := []map[string]interface{}{}
+	for i := (pageNum - 1) * limit; i < n && i < pageNum*limit; i++ {
+		items = append(items, fn(i))
+	}
+	return items
+}
+
+func collector(t *testing.T, name string) Collector {
+	t.Helper()
+	for _, c := range Collectors() {
+		if c.Name == name {
+			return c
+		}
+	}
+	t.Fatalf("no collector %q", name)
+	return Collector{}
+}
+
+func TestCollectors(t *testing.T) {
+	srv := cloudtest.New(t)
+	ok := map[string]interface{}{"isSuccessful": true}
+	mysql := "kr1-rds-mysql.api.nhncloudservice.com/v3.0"
+	srv.Mux.HandleFunc(mysql+"/db-instances", func(w http.ResponseWriter, r *http.Request) {
+		cloudtest.WriteJSON(w, http.StatusOK, map[string]interface{}{"header": ok, "dbInstances": []map[string]interface{}{{
+			"dbInstanceId": "db-1", "dbInstanceName": "orders", "dbInstanceStatus": "AVAILABLE",
+			"subnetId": "sub-1", "parameterGroupId": "pg-1", "dbSecurityGroupIds": []string{"dbsg-1"},
+		}}})
+	})
+	// The backups span two pages and, like some responses, omit totalCounts.
+	srv.Mux.HandleFunc(mysql+"/backups", func(w http.ResponseWriter, r *http.Request) {
+		p, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		cloudtest.WriteJSON(w, http.StatusOK, map[string]interface{}{"header": ok, "backups": page(pageSize+5, p, size, func(i int) map[string]interface{} {
+			return map[string]interface{}{"backupId": fmt.Sprintf("b-%03d", i), "dbInstanceId": "db-1", "backupStatus": "COMPLETED"}
+		})})
+	})
+	srv.Mux.HandleFunc(mysql+"/parameter-groups", func(w http.ResponseWriter, r *http.Request) {
+		cloudtest.WriteJSON(w, http.StatusOK, map[string]interface{}{"header": ok, "parameterGroups": []map[string]string{
+			{"parameterGroupId": "pg-1", "parameterGroupName": "default", "parameterGroupStatus": "STABLE"},
+		}})
+	})
+	srv.Mux.HandleFunc(mysql+"/parameter-groups/pg-1", func(w http.ResponseWriter, r *http.Request) {
+		cloudtest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"header": ok, "parameterGroupId": "pg-1", "parameterGroupName": "default",
+			"parameters": []map[string]string{{"parameterName": "max_connections", "value": "500"}},
+		})
+	})
+	// NAS volumes span two pages without a total.
+	srv.Mux.HandleFunc("kr1-api-nas-infrastructure.nhncloudservice.com/v1/volumes", func(w http.ResponseWriter, r *http.Request) {
+		p, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		cloudtest.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"header": ok,
+			"volumes": page(pageSize+1, p, limit, func(i int) map[string]interface{} {
+				return map[string]interface{}{"id": fmt.Sprintf("nas-%03d", i), "interfaces": []map[string]string{{"subnetId": "sub-1"}}}
+			}),
+		})
+	})
+	// Containers page by the name of the last one.
+	srv.Mux.HandleFunc("object-store.test/", func(w http.ResponseWriter, r *http.Request) {
+		start := 0
+		if m := r.URL.Query().Get("marker"); m != "" {
+			fmt.Sscanf(m, "c-%d", &start)
+			start++
+		}
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		var containers []map[string]string
+		for i := start; i < pageSize+3 && i < start+limit; i++ {
+			containers = append(containers, map[string]string{"name": fmt.Sprintf("c-%03d", i)})
+		}
+		cloudtest.WriteJSON(w, http.StatusOK, containers)
+	})
+
+	c, err := nhncloud.New(&nhncloud.Config{
+		Region:              "kr1",
+		Credentials:         credentials.NewStatic("access-key", "secret-key"),
+		IdentityCredentials: credentials.NewStaticIdentity("user", "pass", "tenant"),
+		AppKeys:             map[string]string{"rds-mysql": "mysql-appkey"},
+		HTTPClient:          srv.Client(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	collect := func(name string) []Resource {
+		t.Helper()
+		resources, err := collector(t, name).Collect(ctx, c)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		return resources
+	}
+
+	instances := collect("rds-mysql.instances")
+	if len(instances) != 1 {
+		t.Fatalf("instances = %+v", instances)
+	}
+	want := []Relation{{Type: TypeSubnet, ID: "sub-1"}, {Type: TypeMySQLParameterGroup, ID: "pg-1"}, {Type: "rds-mysql.security-group", ID: "dbsg-1"}}
+	if db := instances[0]; db.Type != TypeMySQLInstance || db.Name != "orders" || fmt.Sprint(db.Relations) != fmt.Sprint(want) {
+		t.Errorf("instance = %+v", db)
+	}
+
+	backups := collect("rds-mysql.backups")
+	if len(backups) != pageSize+5 || backups[0].Type != TypeMySQLBackup || backups[0].Relations[0] != (Relation{Type: TypeMySQLInstance, ID: "db-1"}) {
+		t.Errorf("got %d backups, want %d: %+v", len(backups), pageSize+5, backups[:1])
+	}
+
+	groups := collect("rds-mysql.parameter-groups")
+	if len(groups) != 1 || groups[0].Status != "STABLE" {
+		t.Fatalf("parameter groups = %+v", groups)
+	}
+	if params, _ := groups[0].Attributes["parameters"].(map[string]interface{}); params["max_connections"] != "500" {
+		t.Errorf("parameters = %v", groups[0].Attributes["parameters"])
+	}
+
+	if volumes := collect("nas.volumes"); len(volumes) != pageSize+1 || volumes[0].Relations[0] != (Relation{Type: TypeSubnet, ID: "sub-1"}) {
+		t.Errorf("got %d NAS volumes, want %d", len(volumes), pageSize+1)
+	}
+	if containers := collect("object-storage.containers"); len(containers) != pageSize+3 || containers[pageSize+2].ID != "c-102" {
+		t.Errorf("got %d containers, want %d", len(containers), pageSize+3)
+	}
+}
+
+func TestCollectorsResolveAndKeepPartialResults(t *testing.T) {
+	srv := cloudtest.New(t)
+	srv.HandleJSON("compute.test/servers/detail", `{"servers": [{"id": "vm-1", "security_groups": [{"name": "web"}, {"name": "default"}, {"name": "gone"}]}]}`)
+	// "default" names two groups, so it cannot be resolved.
+	srv.HandleJSON("network.test/v2.0/security-groups", `{"security_groups": [
+		{"id": "sg-1", "name": "web"}, {"id": "sg-2", "name": "default"}, {"id": "sg-3", "name": "default"}]}`)
+	srv.HandleJSON("container-infra.test/clusters", `{"clusters": [{"uuid": "k-1"}, {"uuid": "k-2"}]}`)
+	srv.HandleJSON("container-infra.test/clusters/k-1/nodegroups", `{"nodegroups": [{"uuid": "ng-1"}]}`)
+	srv.Mux.HandleFunc("container-infra.test/clusters/k-2/nodegroups", func(w http.ResponseWriter, r *http.Request) {
+		cloudtest.WriteJSON(w, http.StatusInternalServerError, map[string]string{"message": "boom"})
+	})
+
+	m, err := nhncloud.NewMultiRegion(&nhncloud.Config{
+		Credentials:         credentials.NewStatic("access-key", "secret-key"),
+		IdentityCredentials: credentials.NewStaticIdentity("user", "pass", "tenant"),
+		HTTPClient:          srv.Client(),
+	}, "kr1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	snap, err := Take(context.Background(), m, Options{Collectors: []Collector{collector(t, "compute.servers"), collector(t, "nks.clusters")}})
+
+	var pe *PartialError
+	if !errors.As(err, &pe) || len(pe.Failures) != 1 || pe.Failures[0].Collector != "nks.clusters" ||
+		fmt.Sprint(pe.Failures[0].Types) != fmt.Sprint([]string{TypeNKSNodeGroup}) {
+		t.Fatalf("expected an nks.clusters failure for node groups only, got %v", err)
+	}
+	for _, r := range []Relation{{Type: TypeNKSCluster, ID: "k-1"}, {Type: TypeNKSCluster, ID: "k-2"}, {Type: TypeNKSNodeGroup, ID: "ng-1"}} {
+		if snap.Find("kr1", r.Type, r.ID) == nil {
+			t.Errorf("the clusters that were listed lost %s", r)
+		}
+	}
+
+	want := []Relation{{Type: TypeSecurityGroup, ID: "sg-1"}, {Type: TypeSecurityGroup, Name: "default"}, {Type: TypeSecurityGroup, Name: "gone"}}
+	if vm := snap.Find("kr1", TypeServer, "vm-1"); vm == nil || !reflect.DeepEqual(vm.Relations, want) {
+		t.Errorf("server relations = %+v, want %+v", vm, want)
+	}
+}
@@ -0,0 +1,380 @@
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/fanout"
+)
+
+// DefaultIgnore are the fields Diff ignores when DiffOptions.Ignore is nil:
+// update times, which change without any change worth reporting.
+var DefaultIgnore = []string{"updatedAt", "updated_at", "updatedYmdt", "updated", "updated_time", "update_time"}
+
+// ChangeKind is what happened to a resource between two snapshots.
+type ChangeKind string
+
+const (
+	Added    ChangeKind = "added"
+	Removed  ChangeKind = "removed"
+	Modified ChangeKind = "modified"
+)
+
+// FieldChange is one changed field of a modified resource. Path is
+// "name", "status", "createdAt", "relations" or "attributes." followed by
+// the field's JSON path. Elements of lists whose elements have an "id" are
+// matched by ID and named like "rules[id=r1]"; an added or removed element
+// has only New or Old. A "." or "\" in a field name or ID is escaped with
+// "\", as in "attributes.parameters.pg_stat_statements\.max".
+type FieldChange struct {
+	Path string      `json:"path"`
+	Old  interface{} `json:"old,omitempty"`
+	New  interface{} `json:"new,omitempty"`
+}
+
+// Change is a resource that was added, removed or modified.
+type Change struct {
+	Kind   ChangeKind    `json:"kind"`
+	Region string        `json:"region"`
+	Type   string        `json:"type"`
+	ID     string        `json:"id"`
+	Name   string        `json:"name,omitempty"`
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// Report lists the changes between two snapshots, ordered by region, type
+// and ID.
+type Report struct {
+	Changes []Change `json:"changes"`
+	// Skipped are the collector failures of either snapshot. The resource
+	// types they cover are not compared in their region.
+	Skipped []Failure `json:"skipped,omitempty"`
+}
+
+// DiffOptions configures Diff.
+type DiffOptions struct {
+	// Ignore lists fields to leave out of the comparison, escaped like
+	// FieldChange.Path. An entry without a dot, such as "updatedAt" or
+	// "pg_stat_statements\.max", matches that field at any depth. Other
+	// entries match a dotted path and everything below it; a path segment
+	// may be a path.Match pattern, as in "attributes.*.checksum". Nil means
+	// DefaultIgnore; pass an empty slice to compare every field.
+	Ignore []string
+}
+
+// Diff compares the resources of two snapshots, matched by Resource.Key.
+// A collector that failed in a region, in either snapshot, leaves its
+// resource types in that region out of the comparison, so that what it
+// could not list is not reported as removed or added; the failures are
+// listed in Report.Skipped.
+func Diff(old, new *Snapshot, opts DiffOptions) *Report {
+	ignore := opts.Ignore
+	if ignore == nil {
+		ignore = DefaultIgnore
+	}
+	d := differ{ignore: ignore}
+
+	report := &Report{Changes: []Change{}}
+	// skip holds "region/type" for the failed types, and "region/" for a
+	// failed collector without Types.
+	skip := map[string]bool{}
+	seen := map[string]bool{}
+	for _, snap := range []*Snapshot{old, new} {
+		for _, f := range snap.Failures {
+			if !seen[f.Region+"/"+f.Collector] {
+				seen[f.Region+"/"+f.Collector] = true
+				report.Skipped = append(report.Skipped, f)
+			}
+			if len(f.Types) == 0 {
+				skip[f.Region+"/"] = true
+			}
+			for _, typ := range f.Types {
+				skip[f.Region+"/"+typ] = true
+			}
+		}
+	}
+	index := func(resources []Resource) map[string]*Resource {
+		m := make(map[string]*Resource, len(resources))
+		for i := range resources {
+			r := &resources[i]
+			if !skip[r.Region+"/"] && !skip[r.Region+"/"+r.Type] {
+				m[r.Key()] = r
+			}
+		}
+		return m
+	}
+	before, after := index(old.Resources), index(new.Resources)
+
+	for key, o := range before {
+		n, ok := after[key]
+		if !ok {
+			report.Changes = append(report.Changes, change(Removed, o, nil))
+			continue
+		}
+		if fields := d.resource(o, n); len(fields) > 0 {
+			report.Changes = append(report.Changes, change(Modified, n, fields))
+		}
+	}
+	for key, n := range after {
+		if _, ok := before[key]; !ok {
+			report.Changes = append(report.Changes, change(Added, n, nil))
+		}
+	}
+	fanout.Sort(report.Changes, func(c *Change) []string { return []string{c.Region, c.Type, c.ID} })
+	fanout.Sort(report.Skipped, func(f *Failure) []string { return []string{f.Region, f.Collector} })
+	return report
+}
+
+func change(kind ChangeKind, r *Resource, fields []FieldChange) Change {
+	return Change{Kind: kind, Region: r.Region, Type: r.Type, ID: r.ID, Name: r.Name, Fields: fields}
+}
+
+// Count returns the number of changes of kind.
+func (r *Report) Count(kind ChangeKind) int {
+	n := 0
+	for _, c := range r.Changes {
+		if c.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteText writes the report for people: one line per resource, marked +,
+// - or ~, with the changed fields of modified resources indented below, a
+// line marked ! per skipped collector, and a summary at the end.
+func (r *Report) WriteText(w io.Writer) error {
+	marks := map[ChangeKind]string{Added: "+", Removed: "-", Modified: "~"}
+	return fanout.WriteText(w, func(b *strings.Builder) {
+		for _, c := range r.Changes {
+			fmt.Fprintf(b, "%s %s %s %s\n", marks[c.Kind], c.Region, c.Type, fanout.Label(c.ID, c.Name))
+			for _, f := range c.Fields {
+				switch {
+				case f.Old == nil:
+					fmt.Fprintf(b, "    %s: added %s\n", f.Path, compact(f.New))
+				case f.New == nil:
+					fmt.Fprintf(b, "    %s: removed %s\n", f.Path, compact(f.Old))
+				default:
+					fmt.Fprintf(b, "    %s: %s -> %s\n", f.Path, compact(f.Old), compact(f.New))
+				}
+			}
+		}
+		for _, f := range r.Skipped {
+			fmt.Fprintf(b, "! %s %s not compared: %s\n", f.Region, f.Collector, f.Error)
+		}
+		fmt.Fprintf(b, "%d added, %d removed, %d modified\n", r.Count(Added), r.Count(Removed), r.Count(Modified))
+	})
+}
+
+func compact(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+type differ struct {
+	ignore  []string
+	changes []FieldChange
+}
+
+func (d *differ) resource(o, n *Resource) []FieldChange {
+	d.changes = nil
+	d.value("name", o.Name, n.Name)
+	d.value("status", o.Status, n.Status)
+	d.value("createdAt", o.CreatedAt.String(), n.CreatedAt.String())
+	d.value("relations", relationList(o.Relations), relationList(n.Relations))
+	d.value("attributes", plainMap(o.Attributes), plainMap(n.Attributes))
+	return d.changes
+}
+
+// relationList renders relations as sorted Relation.String values, so their
+// order does not matter.
+func relationList(rels []Relation) interface{} {
+	if len(rels) == 0 {
+		return nil
+	}
+	list := make([]interface{}, len(rels))
+	ids := make([]string, len(rels))
+	for i, r := range rels {
+		ids[i] = r.String()
+	}
+	sort.Strings(ids)
+	for i, id := range ids {
+		list[i] = id
+	}
+	return list
+}
+
+// plainMap avoids comparing a nil map with an empty one.
+func plainMap(m map[string]interface{}) interface{} {
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
+
+// value compares a and b, plain JSON values, at path p.
+func (d *differ) value(p string, a, b interface{}) {
+	if d.ignored(p) {
+		return
+	}
+	am, aok := a.(map[string]interface{})
+	bm, bok := b.(map[string]interface{})
+	if aok && bok {
+		keys := make(map[string]bool, len(am)+len(bm))
+		for k := range am {
+			keys[k] = true
+		}
+		for k := range bm {
+			keys[k] = true
+		}
+		for _, k := range sortedKeys(keys) {
+			d.value(p+"."+escapePath(k), am[k], bm[k])
+		}
+		return
+	}
+	al, aok := a.([]interface{})
+	bl, bok := b.([]interface{})
+	if aok && bok {
+		if ai, ok := byID(al); ok {
+			if bi, ok := byID(bl); ok {
+				d.list(p, ai, bi)
+				return
+			}
+		}
+	}
+	if !reflect.DeepEqual(a, b) {
+		d.changes = append(d.changes, FieldChange{Path: p, Old: a, New: b})
+	}
+}
+
+// list compares lists whose elements are matched by ID.
+func (d *differ) list(p string, a, b map[string]interface{}) {
+	ids := make(map[string]bool, len(a)+len(b))
+	for id := range a {
+		ids[id] = true
+	}
+	for id := range b {
+		ids[id] = true
+	}
+	for _, id := range sortedKeys(ids) {
+		d.value(p+"[id="+escapePath(id)+"]", a[id], b[id])
+	}
+}
+
+// byID indexes a list of objects by their "id" field. It fails if any
+// element is not an object with a unique string id.
+func byID(list []interface{}) (map[string]interface{}, bool) {
+	if len(list) == 0 {
+		return map[string]interface{}{}, true
+	}
+	index := make(map[string]interface{}, len(list))
+	for _, elem := range list {
+		m, ok := elem.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		id, ok := m["id"].(string)
+		if !ok || id == "" {
+			return nil, false
+		}
+		if _, dup := index[id]; dup {
+			return nil, false
+		}
+		index[id] = elem
+	}
+	return index, true
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ignored reports whether path p matches an ignore entry.
+func (d *differ) ignored(p string) bool {
+	segments := splitPath(p, false)
+	for i, s := range segments {
+		segments[i] = stripIndex(s)
+	}
+	for _, pattern := range d.ignore {
+		parts := splitPath(pattern, true)
+		if len(parts) == 1 {
+			if ok, _ := path.Match(parts[0], segments[len(segments)-1]); ok {
+				return true
+			}
+			continue
+		}
+		if len(parts) > len(segments) {
+			continue
+		}
+		match := true
+		for i, part := range parts {
+			if ok, _ := path.Match(part, segments[i]); !ok {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// escapePath escapes a field name or ID for use in a path.
+func escapePath(s string) string {
+	if !strings.ContainsAny(s, `.\`) {
+		return s
+	}
+	return strings.NewReplacer(`\`, `\\`, ".", `\.`).Replace(s)
+}
+
+// splitPath splits p at its unescaped dots. keepEscapes keeps the
+// backslashes, for segments used as path.Match patterns.
+func splitPath(p string, keepEscapes bool) []string {
+	var segments []string
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		switch c := p[i]; {
+		case c == '\\' && i+1 < len(p):
+			if keepEscapes {
+				b.WriteByte(c)
+			}
+			i++
+			b.WriteByte(p[i])
+		case c == '.':
+			segments = append(segments, b.String())
+			b.Reset()
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return append(segments, b.String())
+}
+
+// stripIndex removes a trailing "[id=...]" from a path segment.
+func stripIndex(s string) string {
+	if i := strings.Index(s, "[id="); i >= 0 && strings.HasSuffix(s, "]") {
+		return s[:i]
+	}
+	return s
+}
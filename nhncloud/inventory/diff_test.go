@@ -0,0 +1,132 @@
+package inventory
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func decodeResources(t *testing.T, s string) []Resource {
+	t.Helper()
+	var rs []Resource
+	if err := json.Unmarshal([]byte(s), &rs); err != nil {
+		t.Fatal(err)
+	}
+	return rs
+}
+
+func TestDiff(t *testing.T) {
+	old := &Snapshot{Resources: decodeResources(t, `[
+		{"type": "compute.server", "id": "s1", "name": "web-1", "region": "kr1", "status": "ACTIVE",
+		 "attributes": {"updated": "2024-03-01T00:00:00Z", "metadata": {"env": "prod"}}},
+		{"type": "compute.server", "id": "s2", "name": "web-2", "region": "kr1", "status": "ACTIVE"},
+		{"type": "network.security-group", "id": "sg1", "name": "default", "region": "kr1",
+		 "attributes": {"security_group_rules": [
+			{"id": "r1", "direction": "ingress", "port_range_min": 22},
+			{"id": "r2", "direction": "ingress", "port_range_min": 80}]}},
+		{"type": "rds-mysql.parameter-group", "id": "pg1", "region": "kr1",
+		 "attributes": {"parameters": {"max_connections": "100", "wait_timeout": "28800", "pg_stat_statements.max": "5000"}}}
+	]`)}
+	new := &Snapshot{Resources: decodeResources(t, `[
+		{"type": "compute.server", "id": "s1", "name": "web-1", "region": "kr1", "status": "SHUTOFF",
+		 "attributes": {"updated": "2024-03-02T00:00:00Z", "metadata": {"env": "prod"}}},
+		{"type": "compute.server", "id": "s3", "name": "web-3", "region": "kr1", "status": "BUILD"},
+		{"type": "network.security-group", "id": "sg1", "name": "default", "region": "kr1",
+		 "attributes": {"security_group_rules": [
+			{"id": "r2", "direction": "ingress", "port_range_min": 80},
+			{"id": "r3", "direction": "ingress", "port_range_min": 443}]}},
+		{"type": "rds-mysql.parameter-group", "id": "pg1", "region": "kr1",
+		 "attributes": {"parameters": {"max_connections": "500", "wait_timeout": "28800", "pg_stat_statements.max": "10000"}}}
+	]`)}
+
+	report := Diff(old, new, DiffOptions{})
+	got := map[string]Change{}
+	for _, c := range report.Changes {
+		got[c.ID] = c
+	}
+	if len(got) != 5 || got["s2"].Kind != Removed || got["s3"].Kind != Added {
+		t.Fatalf("changes = %+v", report.Changes)
+	}
+	if want := []FieldChange{{Path: "status", Old: "ACTIVE", New: "SHUTOFF"}}; !reflect.DeepEqual(got["s1"].Fields, want) {
+		t.Errorf("s1 fields = %+v, want %+v (updated is ignored by default)", got["s1"].Fields, want)
+	}
+
+	var paths []string
+	for _, f := range got["sg1"].Fields {
+		paths = append(paths, f.Path)
+	}
+	if want := []string{"attributes.security_group_rules[id=r1]", "attributes.security_group_rules[id=r3]"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("sg1 paths = %v, want %v", paths, want)
+	}
+	if f := got["pg1"].Fields; len(f) != 2 || f[0].Path != "attributes.parameters.max_connections" || f[0].New != "500" || f[1].Path != `attributes.parameters.pg_stat_statements\.max` {
+		t.Errorf("pg1 fields = %+v", f)
+	}
+
+	var text bytes.Buffer
+	if err := report.WriteText(&text); err != nil {
+		t.Fatal(err)
+	}
+	want := `~ kr1 compute.server s1 (web-1)
+    status: "ACTIVE" -> "SHUTOFF"
+- kr1 compute.server s2 (web-2)
++ kr1 compute.server s3 (web-3)
+~ kr1 network.security-group sg1 (default)
+    attributes.security_group_rules[id=r1]: removed {"direction":"ingress","id":"r1","port_range_min":22}
+    attributes.security_group_rules[id=r3]: added {"direction":"ingress","id":"r3","port_range_min":443}
+~ kr1 rds-mysql.parameter-group pg1
+    attributes.parameters.max_connections: "100" -> "500"
+    attributes.parameters.pg_stat_statements\.max: "5000" -> "10000"
+1 added, 1 removed, 3 modified
+`
+	if text.String() != want {
+		t.Errorf("text:\n%s\nwant:\n%s", text.String(), want)
+	}
+
+	report = Diff(old, new, DiffOptions{Ignore: []string{"updated", "status", "attributes.security_group_rules", "attributes.*.max_connections", `pg_stat_statements\.max`}})
+	for _, c := range report.Changes {
+		if c.Kind == Modified {
+			t.Errorf("unexpected change with ignore list: %+v", c)
+		}
+	}
+	report = Diff(old, new, DiffOptions{Ignore: []string{}})
+	for _, c := range report.Changes {
+		if c.ID == "s1" && len(c.Fields) != 2 {
+			t.Errorf("s1 fields without ignore list = %+v", c.Fields)
+		}
+	}
+	// "max" alone does not match the escaped field.
+	report = Diff(old, new, DiffOptions{Ignore: []string{"updated", "max", "attributes.parameters.pg_stat_statements"}})
+	for _, c := range report.Changes {
+		if c.ID == "pg1" && len(c.Fields) != 2 {
+			t.Errorf("pg1 fields = %+v", c.Fields)
+		}
+	}
+}
+
+func TestDiffSkipsFailedCollectors(t *testing.T) {
+	old := &Snapshot{Resources: []Resource{
+		{Type: TypeServer, ID: "s1", Region: "kr1"},
+		{Type: TypeServer, ID: "s2", Region: "kr2"},
+		{Type: TypeVolume, ID: "v1", Region: "kr1"},
+	}}
+	// kr1 servers and every kr2 collector failed today.
+	new := &Snapshot{
+		Resources: []Resource{{Type: TypeVolume, ID: "v2", Region: "kr1"}},
+		Failures: []Failure{
+			{Collector: "compute.servers", Region: "kr1", Types: []string{TypeServer}, Error: "503"},
+			{Collector: "custom", Region: "kr2", Error: "timeout"},
+		},
+	}
+	report := Diff(old, new, DiffOptions{})
+	var keys []string
+	for _, c := range report.Changes {
+		keys = append(keys, string(c.Kind)+" "+c.Region+"/"+c.ID)
+	}
+	if want := []string{"removed kr1/v1", "added kr1/v2"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("changes = %v, want %v", keys, want)
+	}
+	if len(report.Skipped) != 2 || report.Skipped[0].Collector != "compute.servers" {
+		t.Errorf("skipped = %+v", report.Skipped)
+	}
+}
@@ -0,0 +1,98 @@
+package inventory
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
+// csvHeader is the header row of WriteCSV.
+var csvHeader = []string{"region", "type", "id", "name", "status", "created_at", "relations"}
+
+// jsonlHeader is the snapshot without its resources, the first line of
+// WriteJSONL.
+type jsonlHeader struct {
+	TakenAt  timestamp.Time `json:"takenAt"`
+	Regions  []string       `json:"regions"`
+	Failures []Failure      `json:"failures,omitempty"`
+}
+
+// jsonlLine is a line of the JSON Lines format: the header or a resource.
+type jsonlLine struct {
+	Snapshot *jsonlHeader `json:"snapshot,omitempty"`
+	Resource
+}
+
+// WriteJSONL writes the snapshot as JSON Lines: a first line
+// {"snapshot": {...}} with the time, regions and failures, then one
+// resource per line.
+func (s *Snapshot) WriteJSONL(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	header := &jsonlHeader{TakenAt: s.TakenAt, Regions: s.Regions, Failures: s.Failures}
+	if err := enc.Encode(struct {
+		Snapshot *jsonlHeader `json:"snapshot"`
+	}{header}); err != nil {
+		return err
+	}
+	for i := range s.Resources {
+		if err := enc.Encode(&s.Resources[i]); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// ReadJSONL reads a snapshot written by WriteJSONL. Blank lines are
+// skipped. A file of resources only, without the header line, reads as a
+// snapshot without failures.
+func ReadJSONL(r io.Reader) (*Snapshot, error) {
+	snap := &Snapshot{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var l jsonlLine
+		if err := json.Unmarshal([]byte(line), &l); err != nil {
+			return nil, fmt.Errorf("inventory: line %d: %w", n, err)
+		}
+		if h := l.Snapshot; h != nil {
+			snap.TakenAt, snap.Regions, snap.Failures = h.TakenAt, h.Regions, h.Failures
+			continue
+		}
+		snap.Resources = append(snap.Resources, l.Resource)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return snap, nil
+}
+
+// WriteCSV writes the resources as CSV with a header row. Attributes are
+// left out; relations are written as Relation.String separated by spaces.
+func (s *Snapshot) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, r := range s.Resources {
+		rels := make([]string, len(r.Relations))
+		for i, rel := range r.Relations {
+			rels[i] = rel.String()
+		}
+		err := cw.Write([]string{r.Region, r.Type, r.ID, r.Name, r.Status, r.CreatedAt.String(), strings.Join(rels, " ")})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
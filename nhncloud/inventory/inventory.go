@@ -0,0 +1,222 @@
+// Package inventory takes normalized snapshots of the resources in one or
+// more regions, for audits and change reports.
+//
+// A snapshot walks every Collector over the regions where its service is
+// available, a few at a time, and records each resource with its type, ID,
+// name, region, status, creation time and relations to other resources. A
+// collector that fails is recorded in Snapshot.Failures and does not stop
+// the others.
+//
+//	mr, _ := nhncloud.NewMultiRegion(cfg, "kr1", "kr2")
+//	snap, err := inventory.Take(ctx, mr, inventory.Options{})
+//	if err != nil {
+//	    log.Printf("partial inventory: %v", err)
+//	}
+//	snap.WriteCSV(os.Stdout)
+//
+// Diff compares two snapshots, such as last night's JSON Lines export and
+// today's, and reports added, removed and modified resources down to the
+// changed field. Resources a failed collector could not list in either
+// snapshot are left out rather than reported as removed or added:
+//
+//	before, _ := inventory.ReadJSONL(f)
+//	inventory.Diff(before, snap, inventory.DiffOptions{}).WriteText(os.Stdout)
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/fanout"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
+// DefaultConcurrency is the number of collector calls Take runs at once when
+// Options.Concurrency is zero.
+const DefaultConcurrency = 4
+
+// Resource is one resource in a snapshot.
+type Resource struct {
+	// Type is the resource type, such as TypeServer ("compute.server").
+	Type      string         `json:"type"`
+	ID        string         `json:"id"`
+	Name      string         `json:"name,omitempty"`
+	Region    string         `json:"region"`
+	Status    string         `json:"status,omitempty"`
+	CreatedAt timestamp.Time `json:"createdAt"`
+	// Relations are the resources this one refers to: the subnet of a
+	// server's port, the volume of a snapshot, the cluster of a node group.
+	Relations []Relation `json:"relations,omitempty"`
+	// Attributes are the resource as the API returned it, decoded into
+	// plain JSON values.
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// Key identifies the resource across snapshots.
+func (r *Resource) Key() string {
+	return r.Region + "/" + r.Type + "/" + r.ID
+}
+
+// Relation is a reference from one resource to another. Name is set
+// instead of ID when the resource refers to the other by a name that could
+// not be resolved to an ID.
+type Relation struct {
+	Type string `json:"type"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// String returns "type:id", or "type:name=<name>" for a relation by name.
+func (r Relation) String() string {
+	if r.ID == "" && r.Name != "" {
+		return r.Type + ":name=" + r.Name
+	}
+	return r.Type + ":" + r.ID
+}
+
+// Failure is a collector that failed in one region.
+type Failure struct {
+	Collector string `json:"collector"`
+	Region    string `json:"region"`
+	// Types are the collector's Types, the resource types missing from
+	// the region.
+	Types []string `json:"types,omitempty"`
+	Error string   `json:"error"`
+
+	err error
+}
+
+// Unwrap returns the collector's error. It is nil for a Failure read back
+// from JSON.
+func (f *Failure) Unwrap() error { return f.err }
+
+// Snapshot is the inventory of a set of regions at one time.
+type Snapshot struct {
+	TakenAt   timestamp.Time `json:"takenAt"`
+	Regions   []string       `json:"regions"`
+	Resources []Resource     `json:"resources"`
+	Failures  []Failure      `json:"failures,omitempty"`
+}
+
+// PartialError reports the collectors that failed during Take.
+type PartialError struct {
+	Failures []Failure
+}
+
+func (e *PartialError) Error() string {
+	msgs := make([]string, len(e.Failures))
+	for i, f := range e.Failures {
+		msgs[i] = fmt.Sprintf("%s in %s: %s", f.Collector, f.Region, f.Error)
+	}
+	return "inventory: " + strings.Join(msgs, "; ")
+}
+
+func (e *PartialError) Unwrap() []error {
+	var errs []error
+	for _, f := range e.Failures {
+		if f.err != nil {
+			errs = append(errs, f.err)
+		}
+	}
+	return errs
+}
+
+// Options configures Take.
+type Options struct {
+	// Collectors to run. Nil means Collectors().
+	Collectors []Collector
+	// Concurrency bounds the collector calls in flight. Zero means
+	// DefaultConcurrency.
+	Concurrency int
+	// NoAttributes leaves Resource.Attributes empty, for smaller snapshots.
+	NoAttributes bool
+}
+
+// Take runs the collectors over every region of m where their service is
+// available and returns the snapshot. If any collector fails, Take still
+// returns the snapshot of the others, with a *PartialError; the failures are
+// also recorded in Snapshot.Failures. The resources a collector listed
+// before an *IncompleteError are kept. Resources are sorted by region, type
+// and ID.
+func Take(ctx context.Context, m *nhncloud.MultiRegion, opts Options) (*Snapshot, error) {
+	collectors := opts.Collectors
+	if collectors == nil {
+		collectors = Collectors()
+	}
+	limit := opts.Concurrency
+	if limit <= 0 {
+		limit = DefaultConcurrency
+	}
+
+	jobs := fanout.Jobs(m, collectors, func(c Collector) string { return c.Service })
+	results := fanout.Run(ctx, jobs, limit, func(ctx context.Context, j fanout.Job[Collector]) ([]Resource, error) {
+		return j.Item.Collect(ctx, m.Client(j.Region))
+	})
+
+	snap := &Snapshot{TakenAt: timestamp.New(time.Now()), Regions: m.Regions()}
+	for i, res := range results {
+		j := jobs[i]
+		if res.Err != nil {
+			f := failure(j.Item, j.Region, res.Err)
+			var incomplete *IncompleteError
+			if errors.As(res.Err, &incomplete) {
+				f.Types = incomplete.Types
+			}
+			snap.Failures = append(snap.Failures, f)
+			if incomplete == nil {
+				continue
+			}
+		}
+		for _, r := range res.Value {
+			r.Region = j.Region
+			if opts.NoAttributes {
+				r.Attributes = nil
+			}
+			snap.Resources = append(snap.Resources, r)
+		}
+	}
+
+	snap.sort()
+	if len(snap.Failures) > 0 {
+		return snap, &PartialError{Failures: snap.Failures}
+	}
+	return snap, nil
+}
+
+func failure(c Collector, region string, err error) Failure {
+	return Failure{Collector: c.Name, Region: region, Types: c.Types, Error: err.Error(), err: err}
+}
+
+func (s *Snapshot) sort() {
+	fanout.Sort(s.Resources, func(r *Resource) []string { return []string{r.Region, r.Type, r.ID} })
+	fanout.Sort(s.Failures, func(f *Failure) []string { return []string{f.Region, f.Collector} })
+}
+
+// Find returns the resource of type typ with id in region, or nil.
+func (s *Snapshot) Find(region, typ, id string) *Resource {
+	for i := range s.Resources {
+		r := &s.Resources[i]
+		if r.Region == region && r.Type == typ && r.ID == id {
+			return r
+		}
+	}
+	return nil
+}
+
+// attributes converts v to plain JSON values.
+func attributes(v interface{}) map[string]interface{} {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil
+	}
+	return m
+}
@@ -0,0 +1,114 @@
+package inventory
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
+func newMultiRegion(t *testing.T, regions ...string) *nhncloud.MultiRegion {
+	t.Helper()
+	m, err := nhncloud.NewMultiRegion(&nhncloud.Config{Credentials: credentials.NewStatic("a", "b")}, regions...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return m
+}
+
+func TestTakeRecordsPartialFailures(t *testing.T) {
+	m := newMultiRegion(t, "kr1", "kr2", "us1")
+	regionOf := map[*nhncloud.Client]string{m.Client("kr1"): "kr1", m.Client("kr2"): "kr2", m.Client("us1"): "us1"}
+	down := errors.New("service unavailable")
+
+	var inFlight, peak int32
+	servers := Collector{Name: "servers", Service: "compute", Collect: func(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		if regionOf[c] == "kr2" {
+			return nil, down
+		}
+		return []Resource{
+			{Type: TypeServer, ID: "s2", Name: "web-2"},
+			{Type: TypeServer, ID: "s1", Name: "web-1", Relations: relations(TypeImage, "img")},
+		}, nil
+	}}
+	databases := Collector{Name: "databases", Service: "rds-mariadb", Collect: func(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+		return []Resource{{Type: TypeMariaDBInstance, ID: "db1"}}, nil
+	}}
+
+	snap, err := Take(context.Background(), m, Options{Collectors: []Collector{servers, databases}, Concurrency: 1})
+	var pe *PartialError
+	if !errors.As(err, &pe) || len(pe.Failures) != 1 || pe.Failures[0].Region != "kr2" || !errors.Is(err, down) {
+		t.Fatalf("expected a kr2 failure wrapping the collector error, got %v", err)
+	}
+	if peak != 1 {
+		t.Errorf("peak concurrency = %d, want 1", peak)
+	}
+
+	var keys []string
+	for _, r := range snap.Resources {
+		keys = append(keys, r.Key())
+	}
+	// rds-mariadb is not offered in us1, so it is not collected there.
+	want := []string{"kr1/compute.server/s1", "kr1/compute.server/s2", "kr1/rds-mariadb.instance/db1", "kr2/rds-mariadb.instance/db1", "us1/compute.server/s1", "us1/compute.server/s2"}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("resources = %v, want %v", keys, want)
+	}
+	if snap.Find("us1", TypeServer, "s1") == nil {
+		t.Error("Find did not return us1 s1")
+	}
+}
+
+func TestExport(t *testing.T) {
+	snap := &Snapshot{TakenAt: timestamp.MustParse("2024-03-02T00:00:00Z"), Regions: []string{"kr1"}, Resources: []Resource{
+		{Type: TypeVolume, ID: "v1", Name: "data, primary", Region: "kr1", Status: "in-use",
+			CreatedAt:  timestamp.MustParse("2024-03-01T09:00:00+09:00"),
+			Relations:  []Relation{{Type: TypeServer, ID: "s1"}, {Type: TypeSnapshot, ID: "snap1"}},
+			Attributes: map[string]interface{}{"size": float64(100)}},
+		{Type: TypeSubnet, ID: "sub1", Region: "kr1"},
+	}, Failures: []Failure{{Collector: "compute.servers", Region: "kr1", Types: []string{TypeServer}, Error: "503"}}}
+
+	var csvOut bytes.Buffer
+	if err := snap.WriteCSV(&csvOut); err != nil {
+		t.Fatal(err)
+	}
+	wantCSV := "region,type,id,name,status,created_at,relations\n" +
+		`kr1,block.volume,v1,"data, primary",in-use,2024-03-01T09:00:00+09:00,compute.server:s1 block.snapshot:snap1` + "\n" +
+		"kr1,network.subnet,sub1,,,,\n"
+	if csvOut.String() != wantCSV {
+		t.Errorf("CSV:\n%s\nwant:\n%s", csvOut.String(), wantCSV)
+	}
+
+	var jsonl bytes.Buffer
+	if err := snap.WriteJSONL(&jsonl); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(jsonl.String(), "\n"); n != 3 {
+		t.Fatalf("JSONL has %d lines, want 3", n)
+	}
+	got, err := ReadJSONL(&jsonl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := got.Resources
+	if len(res) != 2 || !res[0].CreatedAt.Equal(snap.Resources[0].CreatedAt) || !reflect.DeepEqual(res[0].Relations, snap.Resources[0].Relations) || res[0].Attributes["size"] != float64(100) {
+		t.Errorf("round trip = %+v", res)
+	}
+	if !got.TakenAt.Equal(snap.TakenAt) || !reflect.DeepEqual(got.Regions, snap.Regions) || !reflect.DeepEqual(got.Failures, snap.Failures) {
+		t.Errorf("round trip header = %+v", got)
+	}
+}
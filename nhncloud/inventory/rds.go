@@ -0,0 +1,276 @@
+package inventory
+
+import (
+	"context"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
+// rdsEngine adapts the client of one RDS engine to the RDS collectors,
+// which the engines share.
+type rdsEngine struct {
+	// service is the engine's service key, which prefixes its types.
+	service   string
+	instances func(ctx context.Context, c *nhncloud.Client) ([]rdsItem, error)
+	// backups lists one page of backups.
+	backups         func(ctx context.Context, c *nhncloud.Client, page int) ([]rdsItem, error)
+	parameterGroups func(ctx context.Context, c *nhncloud.Client) ([]rdsItem, error)
+	// parameters returns a parameter group without its parameters, and
+	// the parameters' values by name.
+	parameters func(ctx context.Context, c *nhncloud.Client, id string) (interface{}, map[string]string, error)
+}
+
+// rdsItem is an RDS instance, backup or parameter group.
+type rdsItem struct {
+	id, name, status string
+	createdAt        timestamp.Time
+	// subnetID, parameterGroupID and securityGroupIDs are an instance's
+	// references; instanceID is a backup's.
+	subnetID, parameterGroupID string
+	securityGroupIDs           []string
+	instanceID                 string
+	// raw is the API's value, recorded as the attributes.
+	raw interface{}
+}
+
+func (e rdsEngine) collectors() []Collector {
+	return []Collector{
+		{e.service + ".instances", e.service, []string{e.service + rdsInstance}, e.collectInstances},
+		{e.service + ".backups", e.service, []string{e.service + rdsBackup}, e.collectBackups},
+		{e.service + ".parameter-groups", e.service, []string{e.service + rdsParameterGroup}, e.collectParameterGroups},
+	}
+}
+
+func (e rdsEngine) collectInstances(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	items, err := e.instances(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]Resource, 0, len(items))
+	for _, db := range items {
+		rels := relations(TypeSubnet, db.subnetID)
+		rels = append(rels, relations(e.service+rdsParameterGroup, db.parameterGroupID)...)
+		rels = append(rels, relations(e.service+rdsSecurityGroup, db.securityGroupIDs...)...)
+		resources = append(resources, Resource{
+			Type: e.service + rdsInstance, ID: db.id, Name: db.name, Status: db.status, CreatedAt: db.createdAt,
+			Relations: rels, Attributes: attributes(db.raw),
+		})
+	}
+	return resources, nil
+}
+
+func (e rdsEngine) collectBackups(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	var resources []Resource
+	for page := 1; ; page++ {
+		items, err := e.backups(ctx, c, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range items {
+			resources = append(resources, Resource{
+				Type: e.service + rdsBackup, ID: b.id, Name: b.name, Status: b.status, CreatedAt: b.createdAt,
+				Relations: relations(e.service+rdsInstance, b.instanceID), Attributes: attributes(b.raw),
+			})
+		}
+		if len(items) < pageSize {
+			return resources, nil
+		}
+	}
+}
+
+// collectParameterGroups records the parameters as a name to value map
+// under "parameters", so a diff reports each changed parameter by name.
+func (e rdsEngine) collectParameterGroups(ctx context.Context, c *nhncloud.Client) ([]Resource, error) {
+	groups, err := e.parameterGroups(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]Resource, 0, len(groups))
+	for _, g := range groups {
+		group, params, err := e.parameters(ctx, c, g.id)
+		if err != nil {
+			return nil, err
+		}
+		attrs := attributes(group)
+		if attrs == nil {
+			attrs = make(map[string]interface{})
+		}
+		values := make(map[string]interface{}, len(params))
+		for k, v := range params {
+			values[k] = v
+		}
+		attrs["parameters"] = values
+		resources = append(resources, Resource{
+			Type: e.service + rdsParameterGroup, ID: g.id, Name: g.name, Status: g.status, CreatedAt: g.createdAt,
+			Attributes: attrs,
+		})
+	}
+	return resources, nil
+}
+
+// rdsEngines are the RDS engines, in collector order.
+var rdsEngines = []rdsEngine{
+	{
+		service: "rds-mysql",
+		instances: func(ctx context.Context, c *nhncloud.Client) ([]rdsItem, error) {
+			out, err := c.MySQL().ListInstances(ctx)
+			if err != nil {
+				return nil, err
+			}
+			items := make([]rdsItem, 0, len(out.DBInstances))
+			for _, db := range out.DBInstances {
+				items = append(items, rdsItem{
+					id: db.DBInstanceID, name: db.DBInstanceName, status: string(db.DBInstanceStatus), createdAt: db.CreatedYmdt,
+					subnetID: db.SubnetID, parameterGroupID: db.ParameterGroupID, securityGroupIDs: db.DBSecurityGroupIDs, raw: db,
+				})
+			}
+			return items, nil
+		},
+		backups: func(ctx context.Context, c *nhncloud.Client, page int) ([]rdsItem, error) {
+			out, err := c.MySQL().ListBackups(ctx, "", "", page, pageSize)
+			if err != nil {
+				return nil, err
+			}
+			items := make([]rdsItem, 0, len(out.Backups))
+			for _, b := range out.Backups {
+				items = append(items, rdsItem{
+					id: b.BackupID, name: b.BackupName, status: string(b.BackupStatus), createdAt: b.CreatedYmdt,
+					instanceID: b.DBInstanceID, raw: b,
+				})
+			}
+			return items, nil
+		},
+		parameterGroups: func(ctx context.Context, c *nhncloud.Client) ([]rdsItem, error) {
+			out, err := c.MySQL().ListParameterGroups(ctx)
+			if err != nil {
+				return nil, err
+			}
+			items := make([]rdsItem, 0, len(out.ParameterGroups))
+			for _, g := range out.ParameterGroups {
+				items = append(items, rdsItem{id: g.ParameterGroupID, name: g.ParameterGroupName, status: g.ParameterGroupStatus, createdAt: g.CreatedYmdt})
+			}
+			return items, nil
+		},
+		parameters: func(ctx context.Context, c *nhncloud.Client, id string) (interface{}, map[string]string, error) {
+			full, err := c.MySQL().GetParameterGroup(ctx, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			params := make(map[string]string, len(full.Parameters))
+			for _, p := range full.Parameters {
+				params[p.ParameterName] = p.Value
+			}
+			full.Parameters = nil
+			return full.ParameterGroup, params, nil
+		},
+	},
+	{
+		service: "rds-mariadb",
+		instances: func(ctx context.Context, c *nhncloud.Client) ([]rdsItem, error) {
+			out, err := c.MariaDB().ListInstances(ctx)
+			if err != nil {
+				return nil, err
+			}
+			items := make([]rdsItem, 0, len(out.DBInstances))
+			for _, db := range out.DBInstances {
+				items = append(items, rdsItem{
+					id: db.DBInstanceID, name: db.DBInstanceName, status: string(db.DBInstanceStatus), createdAt: db.CreatedYmdt,
+					subnetID: db.SubnetID, parameterGroupID: db.ParameterGroupID, securityGroupIDs: db.DBSecurityGroupIDs, raw: db,
+				})
+			}
+			return items, nil
+		},
+		backups: func(ctx context.Context, c *nhncloud.Client, page int) ([]rdsItem, error) {
+			out, err := c.MariaDB().ListBackups(ctx, "", "", page, pageSize)
+			if err != nil {
+				return nil, err
+			}
+			items := make([]rdsItem, 0, len(out.Backups))
+			for _, b := range out.Backups {
+				items = append(items, rdsItem{
+					id: b.BackupID, name: b.BackupName, status: string(b.BackupStatus), createdAt: b.CreatedYmdt,
+					instanceID: b.DBInstanceID, raw: b,
+				})
+			}
+			return items, nil
+		},
+		parameterGroups: func(ctx context.Context, c *nhncloud.Client) ([]rdsItem, error) {
+			out, err := c.MariaDB().ListParameterGroups(ctx)
+			if err != nil {
+				return nil, err
+			}
+			items := make([]rdsItem, 0, len(out.ParameterGroups))
+			for _, g := range out.ParameterGroups {
+				items = append(items, rdsItem{id: g.ParameterGroupID, name: g.ParameterGroupName, status: g.ParameterGroupStatus, createdAt: g.CreatedYmdt})
+			}
+			return items, nil
+		},
+		parameters: func(ctx context.Context, c *nhncloud.Client, id string) (interface{}, map[string]string, error) {
+			full, err := c.MariaDB().GetParameterGroup(ctx, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			params := make(map[string]string, len(full.Parameters))
+			for _, p := range full.Parameters {
+				params[p.ParameterName] = p.Value
+			}
+			full.Parameters = nil
+			return full.ParameterGroup, params, nil
+		},
+	},
+	{
+		service: "rds-postgresql",
+		instances: func(ctx context.Context, c *nhncloud.Client) ([]rdsItem, error) {
+			out, err := c.PostgreSQL().ListInstances(ctx)
+			if err != nil {
+				return nil, err
+			}
+			items := make([]rdsItem, 0, len(out.DBInstances))
+			for _, db := range out.DBInstances {
+				// PostgreSQL instances do not report their subnet.
+				items = append(items, rdsItem{
+					id: db.DBInstanceID, name: db.DBInstanceName, status: string(db.DBInstanceStatus), createdAt: db.CreatedYmdt,
+					parameterGroupID: db.ParameterGroupID, securityGroupIDs: db.DBSecurityGroupIDs, raw: db,
+				})
+			}
+			return items, nil
+		},
+		backups: func(ctx context.Context, c *nhncloud.Client, page int) ([]rdsItem, error) {
+			out, err := c.PostgreSQL().ListBackups(ctx, "", page, pageSize)
+			if err != nil {
+				return nil, err
+			}
+			items := make([]rdsItem, 0, len(out.Backups))
+			for _, b := range out.Backups {
+				items = append(items, rdsItem{
+					id: b.BackupID, name: b.BackupName, status: string(b.BackupStatus), createdAt: b.CreatedYmdt,
+					instanceID: b.DBInstanceID, raw: b,
+				})
+			}
+			return items, nil
+		},
+		parameterGroups: func(ctx context.Context, c *nhncloud.Client) ([]rdsItem, error) {
+			out, err := c.PostgreSQL().ListParameterGroups(ctx)
+			if err != nil {
+				return nil, err
+			}
+			items := make([]rdsItem, 0, len(out.ParameterGroups))
+			for _, g := range out.ParameterGroups {
+				items = append(items, rdsItem{id: g.ParameterGroupID, name: g.ParameterGroupName, createdAt: g.CreatedYmdt})
+			}
+			return items, nil
+		},
+		parameters: func(ctx context.Context, c *nhncloud.Client, id string) (interface{}, map[string]string, error) {
+			full, err := c.PostgreSQL().GetParameterGroup(ctx, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			params := make(map[string]string, len(full.Parameters))
+			for _, p := range full.Parameters {
+				params[p.ParameterName] = p.Value
+			}
+			return full.ParameterGroup, params, nil
+		},
+	},
+}
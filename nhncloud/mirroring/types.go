@@ -1,7 +1,9 @@
 // Package mirroring provides Traffic Mirroring service types and client
 package mirroring
 
-import "time"
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
 
 // ================================
 // Session Types
@@ -9,19 +11,19 @@ import "time"
 
 // Session represents a mirroring session
 type Session struct {
-	ID            string    `json:"id"`
-	Name          string    `json:"name"`
-	Description   string    `json:"description,omitempty"`
-	SourceType    string    `json:"source_type"` // PORT, LOADBALANCER_MEMBER
-	SourceID      string    `json:"source_id"`
-	TargetType    string    `json:"target_type"` // PORT
-	TargetID      string    `json:"target_id"`
-	Direction     string    `json:"direction"` // in, out, both
-	FilterGroupID string    `json:"filter_group_id,omitempty"`
-	AdminStateUp  bool      `json:"admin_state_up"`
-	State         string    `json:"state"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at,omitempty"`
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description,omitempty"`
+	SourceType    string         `json:"source_type"` // PORT, LOADBALANCER_MEMBER
+	SourceID      string         `json:"source_id"`
+	TargetType    string         `json:"target_type"` // PORT
+	TargetID      string         `json:"target_id"`
+	Direction     string         `json:"direction"` // in, out, both
+	FilterGroupID string         `json:"filter_group_id,omitempty"`
+	AdminStateUp  bool           `json:"admin_state_up"`
+	State         string         `json:"state"`
+	CreatedAt     timestamp.Time `json:"created_at"`
+	UpdatedAt     timestamp.Time `json:"updated_at,omitempty"`
 }
 
 // ListSessionsOutput represents the response from listing sessions
@@ -62,13 +64,13 @@ type UpdateSessionInput struct {
 
 // FilterGroup represents a mirroring filter group
 type FilterGroup struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description,omitempty"`
-	FilterIDs   []string  `json:"filter_ids,omitempty"`
-	State       string    `json:"state"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	FilterIDs   []string       `json:"filter_ids,omitempty"`
+	State       string         `json:"state"`
+	CreatedAt   timestamp.Time `json:"created_at"`
+	UpdatedAt   timestamp.Time `json:"updated_at,omitempty"`
 }
 
 // ListFilterGroupsOutput represents the response from listing filter groups
@@ -99,21 +101,21 @@ type UpdateFilterGroupInput struct {
 
 // Filter represents a mirroring filter
 type Filter struct {
-	ID            string    `json:"id"`
-	FilterGroupID string    `json:"filter_group_id"`
-	Name          string    `json:"name"`
-	Description   string    `json:"description,omitempty"`
-	Protocol      string    `json:"protocol,omitempty"`
-	SourceCIDR    string    `json:"source_cidr,omitempty"`
-	DestCIDR      string    `json:"destination_cidr,omitempty"`
-	SourcePortMin int       `json:"source_port_min,omitempty"`
-	SourcePortMax int       `json:"source_port_max,omitempty"`
-	DestPortMin   int       `json:"destination_port_min,omitempty"`
-	DestPortMax   int       `json:"destination_port_max,omitempty"`
-	Action        string    `json:"action"` // accept, drop
-	State         string    `json:"state"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at,omitempty"`
+	ID            string         `json:"id"`
+	FilterGroupID string         `json:"filter_group_id"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description,omitempty"`
+	Protocol      string         `json:"protocol,omitempty"`
+	SourceCIDR    string         `json:"source_cidr,omitempty"`
+	DestCIDR      string         `json:"destination_cidr,omitempty"`
+	SourcePortMin int            `json:"source_port_min,omitempty"`
+	SourcePortMax int            `json:"source_port_max,omitempty"`
+	DestPortMin   int            `json:"destination_port_min,omitempty"`
+	DestPortMax   int            `json:"destination_port_max,omitempty"`
+	Action        string         `json:"action"` // accept, drop
+	State         string         `json:"state"`
+	CreatedAt     timestamp.Time `json:"created_at"`
+	UpdatedAt     timestamp.Time `json:"updated_at,omitempty"`
 }
 
 // ListFiltersOutput represents the response from listing filters
@@ -0,0 +1,273 @@
+package nhncloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Regions NHN Cloud operates in, as used in Config.Region.
+const (
+	RegionKR1 = "kr1" // Korea (Pangyo)
+	RegionKR2 = "kr2" // Korea (Pyeongchon)
+	RegionKR3 = "kr3" // Korea (Gwangju)
+	RegionJP1 = "jp1" // Japan (Tokyo)
+	RegionUS1 = "us1" // US (California)
+)
+
+// serviceRegions lists the regions of services that are not offered in every
+// region, keyed like Config.AppKeys. Services without an entry are assumed to
+// be available everywhere. MultiRegion.SetServiceRegions overrides entries.
+var serviceRegions = map[string][]string{
+	"rds-mysql":      {RegionKR1, RegionKR2, RegionJP1, RegionUS1},
+	"rds-mariadb":    {RegionKR1, RegionKR2, RegionJP1},
+	"rds-postgresql": {RegionKR1, RegionKR2, RegionJP1},
+	"nks":            {RegionKR1, RegionKR2, RegionJP1, RegionUS1},
+	"ncr":            {RegionKR1, RegionKR2},
+	"ncs":            {RegionKR1, RegionKR2},
+	"nas":            {RegionKR1, RegionKR2, RegionJP1},
+	"apigw":          {RegionKR1, RegionJP1},
+}
+
+// globalServices are not regional: every region returns the same resources,
+// so a fan-out queries only the first region.
+var globalServices = map[string]bool{
+	"iam":             true,
+	"dnsplus":         true,
+	"keymanager":      true,
+	"certmanager":     true,
+	"cloudtrail":      true,
+	"resourcewatcher": true,
+}
+
+// ServiceAvailable reports whether service, named like the Config.AppKeys
+// keys, is offered in region according to the SDK's availability table.
+// Global services are available in every region.
+func ServiceAvailable(service, region string) bool {
+	regions, ok := serviceRegions[service]
+	if !ok {
+		return true
+	}
+	return containsRegion(regions, region)
+}
+
+func containsRegion(regions []string, region string) bool {
+	for _, r := range regions {
+		if strings.EqualFold(r, region) {
+			return true
+		}
+	}
+	return false
+}
+
+// MultiRegion holds one Client per region, all built from the same Config,
+// for queries that span regions. Use FanOut to run a List operation in every
+// region concurrently.
+type MultiRegion struct {
+	regions []string
+	clients map[string]*Client
+
+	mu        sync.RWMutex
+	overrides map[string][]string
+}
+
+// NewMultiRegion returns a MultiRegion over regions. Each region gets its
+// own Client built from a copy of cfg with Region replaced; cfg.Region is
+// ignored. Shared settings such as the HTTP client, circuit breaker and cache
+// are shared by every region.
+func NewMultiRegion(cfg *Config, regions ...string) (*MultiRegion, error) {
+	if cfg == nil {
+		return nil, ErrCredentialsRequired
+	}
+	m := &MultiRegion{clients: make(map[string]*Client)}
+	for _, region := range regions {
+		region = strings.ToLower(strings.TrimSpace(region))
+		if region == "" {
+			return nil, ErrRegionRequired
+		}
+		if _, ok := m.clients[region]; ok {
+			continue
+		}
+		regional := *cfg
+		regional.Region = region
+		c, err := New(&regional)
+		if err != nil {
+			return nil, err
+		}
+		m.regions = append(m.regions, region)
+		m.clients[region] = c
+	}
+	if len(m.regions) == 0 {
+		return nil, ErrRegionRequired
+	}
+	return m, nil
+}
+
+// Regions returns the regions, in the order given to NewMultiRegion.
+func (m *MultiRegion) Regions() []string {
+	return append([]string(nil), m.regions...)
+}
+
+// Client returns the Client for region, or nil if region is not one of
+// m's regions.
+func (m *MultiRegion) Client(region string) *Client {
+	return m.clients[strings.ToLower(region)]
+}
+
+// SetServiceRegions overrides the availability table for service, for
+// example when NHN Cloud opens a service in a new region before the SDK's
+// table is updated.
+func (m *MultiRegion) SetServiceRegions(service string, regions ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.overrides == nil {
+		m.overrides = make(map[string][]string)
+	}
+	m.overrides[service] = append([]string(nil), regions...)
+}
+
+// RegionsFor returns the regions a fan-out over service queries: m's
+// regions where the service is available, or only the first region for a
+// global service.
+func (m *MultiRegion) RegionsFor(service string) []string {
+	if globalServices[service] {
+		return m.regions[:1]
+	}
+	m.mu.RLock()
+	available, overridden := m.overrides[service]
+	m.mu.RUnlock()
+
+	var regions []string
+	for _, region := range m.regions {
+		if overridden && containsRegion(available, region) || !overridden && ServiceAvailable(service, region) {
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
+// Regional is an item returned by a region.
+type Regional[T any] struct {
+	Region string
+	Item   T
+}
+
+// RegionError is the failure of one region in a fan-out.
+type RegionError struct {
+	Region string
+	Err    error
+}
+
+func (e *RegionError) Error() string {
+	return fmt.Sprintf("nhncloud: region %s: %v", e.Region, e.Err)
+}
+
+func (e *RegionError) Unwrap() error { return e.Err }
+
+// FanOutError reports the regions that failed in a fan-out. It unwraps to
+// each region's error, so errors.Is and errors.As see through it.
+type FanOutError struct {
+	Errors []*RegionError
+}
+
+func (e *FanOutError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e *FanOutError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// FanOutResult is the outcome of a fan-out.
+type FanOutResult[T any] struct {
+	// Items holds the items of every region that succeeded, grouped by
+	// region in the MultiRegion's order.
+	Items []Regional[T]
+	// Skipped lists the regions where the service is not available.
+	Skipped []string
+	// Failed lists the regions whose list call returned an error.
+	Failed []*RegionError
+}
+
+// FanOut calls list with each region's Client concurrently and merges the
+// results. service, named like the Config.AppKeys keys, selects the regions
+// through the availability table (see MultiRegion.RegionsFor); the others
+// are reported in Skipped.
+//
+// A failing region does not stop the others: FanOut returns the items of
+// the regions that succeeded together with a *FanOutError naming the ones
+// that failed, so callers can choose to use partial results. A list call
+// that panics fails its region the same way instead of crashing the
+// program.
+//
+//	res, err := nhncloud.FanOut(ctx, mr, "compute",
+//	    func(ctx context.Context, c *nhncloud.Client) ([]compute.Server, error) {
+//	        out, err := c.Compute().ListServers(ctx)
+//	        if err != nil {
+//	            return nil, err
+//	        }
+//	        return out.Servers, nil
+//	    })
+//	for _, s := range res.Items {
+//	    fmt.Println(s.Region, s.Item.Name)
+//	}
+func FanOut[T any](ctx context.Context, m *MultiRegion, service string, list func(ctx context.Context, c *Client) ([]T, error)) (*FanOutResult[T], error) {
+	regions := m.RegionsFor(service)
+	res := &FanOutResult[T]{}
+	if !globalServices[service] {
+		for _, region := range m.regions {
+			if !containsRegion(regions, region) {
+				res.Skipped = append(res.Skipped, region)
+			}
+		}
+	}
+
+	items := make([][]T, len(regions))
+	errs := make([]error, len(regions))
+	var wg sync.WaitGroup
+	for i, region := range regions {
+		wg.Add(1)
+		go func(i int, c *Client) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					items[i], errs[i] = nil, panicError(r)
+				}
+			}()
+			items[i], errs[i] = list(ctx, c)
+		}(i, m.clients[region])
+	}
+	wg.Wait()
+
+	for i, region := range regions {
+		if errs[i] != nil {
+			res.Failed = append(res.Failed, &RegionError{Region: region, Err: errs[i]})
+			continue
+		}
+		for _, item := range items[i] {
+			res.Items = append(res.Items, Regional[T]{Region: region, Item: item})
+		}
+	}
+	if len(res.Failed) > 0 {
+		return res, &FanOutError{Errors: res.Failed}
+	}
+	return res, nil
+}
+
+// panicError converts a value recovered from a list call to an error,
+// wrapping it when it is one.
+func panicError(r interface{}) error {
+	if err, ok := r.(error); ok {
+		return fmt.Errorf("panic: %w", err)
+	}
+	return fmt.Errorf("panic: %v", r)
+}
@@ -0,0 +1,111 @@
+package nhncloud
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+)
+
+func newTestMultiRegion(t *testing.T, regions ...string) *MultiRegion {
+	t.Helper()
+	m, err := NewMultiRegion(&Config{Credentials: credentials.NewStatic("access-key", "secret-key")}, regions...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return m
+}
+
+func TestFanOutMergesAndReportsFailures(t *testing.T) {
+	m := newTestMultiRegion(t, "kr1", "KR2", "jp1", "kr1")
+	if got := m.Regions(); !reflect.DeepEqual(got, []string{"kr1", "kr2", "jp1"}) {
+		t.Fatalf("Regions() = %v", got)
+	}
+
+	down := errors.New("connection refused")
+	res, err := FanOut(context.Background(), m, "compute", func(ctx context.Context, c *Client) ([]string, error) {
+		if c.config.Region == "kr2" {
+			return nil, down
+		}
+		return []string{c.config.Region + "-a", c.config.Region + "-b"}, nil
+	})
+
+	var fe *FanOutError
+	if !errors.As(err, &fe) || len(fe.Errors) != 1 || fe.Errors[0].Region != "kr2" {
+		t.Fatalf("expected kr2 failure, got %v", err)
+	}
+	if !errors.Is(err, down) {
+		t.Errorf("error does not wrap the region's error: %v", err)
+	}
+	want := []Regional[string]{
+		{Region: "kr1", Item: "kr1-a"}, {Region: "kr1", Item: "kr1-b"},
+		{Region: "jp1", Item: "jp1-a"}, {Region: "jp1", Item: "jp1-b"},
+	}
+	if !reflect.DeepEqual(res.Items, want) {
+		t.Errorf("Items = %v, want %v", res.Items, want)
+	}
+}
+
+func TestFanOutRecoversPanics(t *testing.T) {
+	m := newTestMultiRegion(t, "kr1", "kr2", "jp1")
+
+	res, err := FanOut(context.Background(), m, "compute", func(ctx context.Context, c *Client) ([]string, error) {
+		switch c.config.Region {
+		case "kr2":
+			var servers map[string]string
+			servers["web"] = "down" // a nil map write panics with a runtime.Error
+		case "jp1":
+			panic("unexpected response")
+		}
+		return []string{c.config.Region}, nil
+	})
+
+	var fe *FanOutError
+	if !errors.As(err, &fe) || len(fe.Errors) != 2 || fe.Errors[0].Region != "kr2" || fe.Errors[1].Region != "jp1" {
+		t.Fatalf("expected kr2 and jp1 failures, got %v", err)
+	}
+	var runtimeErr runtime.Error
+	if !errors.As(fe.Errors[0], &runtimeErr) {
+		t.Errorf("kr2 error does not wrap the runtime error: %v", fe.Errors[0])
+	}
+	if got := fe.Errors[1].Error(); got != "nhncloud: region jp1: panic: unexpected response" {
+		t.Errorf("jp1 error = %q", got)
+	}
+	if want := []Regional[string]{{Region: "kr1", Item: "kr1"}}; !reflect.DeepEqual(res.Items, want) {
+		t.Errorf("Items = %v, want %v", res.Items, want)
+	}
+}
+
+func TestFanOutSkipsUnavailableRegions(t *testing.T) {
+	m := newTestMultiRegion(t, "kr1", "us1")
+
+	var called []string
+	res, err := FanOut(context.Background(), m, "rds-mariadb", func(ctx context.Context, c *Client) ([]int, error) {
+		called = append(called, c.config.Region)
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(called, []string{"kr1"}) || !reflect.DeepEqual(res.Skipped, []string{"us1"}) {
+		t.Errorf("called %v, skipped %v", called, res.Skipped)
+	}
+
+	m.SetServiceRegions("rds-mariadb", "kr1", "us1")
+	if got := m.RegionsFor("rds-mariadb"); !reflect.DeepEqual(got, []string{"kr1", "us1"}) {
+		t.Errorf("RegionsFor after override = %v", got)
+	}
+	if got := m.RegionsFor("dnsplus"); !reflect.DeepEqual(got, []string{"kr1"}) {
+		t.Errorf("RegionsFor global service = %v", got)
+	}
+}
+
+func TestNewMultiRegionRequiresRegions(t *testing.T) {
+	_, err := NewMultiRegion(&Config{Credentials: credentials.NewStatic("a", "b")})
+	if err != ErrRegionRequired {
+		t.Errorf("expected ErrRegionRequired, got %v", err)
+	}
+}
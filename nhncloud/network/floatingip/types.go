@@ -1,17 +1,19 @@
 package floatingip
 
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
 // FloatingIP represents a floating IP address
 type FloatingIP struct {
-	ID                string  `json:"id"`
-	FloatingNetworkID string  `json:"floating_network_id"`
-	FloatingIPAddress string  `json:"floating_ip_address"`
-	FixedIPAddress    string  `json:"fixed_ip_address,omitempty"`
-	PortID            *string `json:"port_id,omitempty"`
-	TenantID          string  `json:"tenant_id"`
-	Status            string  `json:"status"`
-	Description       string  `json:"description,omitempty"`
-	CreatedAt         string  `json:"created_at"`
-	UpdatedAt         string  `json:"updated_at"`
+	ID                string         `json:"id"`
+	FloatingNetworkID string         `json:"floating_network_id"`
+	FloatingIPAddress string         `json:"floating_ip_address"`
+	FixedIPAddress    string         `json:"fixed_ip_address,omitempty"`
+	PortID            *string        `json:"port_id,omitempty"`
+	TenantID          string         `json:"tenant_id"`
+	Status            string         `json:"status"`
+	Description       string         `json:"description,omitempty"`
+	CreatedAt         timestamp.Time `json:"created_at"`
+	UpdatedAt         timestamp.Time `json:"updated_at"`
 }
 
 // ListFloatingIPsOutput represents the response for listing floating IPs
@@ -1,34 +1,36 @@
 package flowlog
 
-import "time"
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
 
 // Logger represents a flow log logger
 type Logger struct {
-	ID               string    `json:"id"`
-	Name             string    `json:"name"`
-	Description      string    `json:"description,omitempty"`
-	ResourceType     string    `json:"resource_type"` // VPC, SUBNET, PORT
-	ResourceID       string    `json:"resource_id"`
-	FilterType       string    `json:"filter_type"`       // ALL, ACCEPT, DROP
-	ConnectionAction string    `json:"connection_action"` // enable, disable
-	StorageType      string    `json:"storage_type"`      // OBS
-	StorageURL       string    `json:"storage_url"`
-	LogFormat        string    `json:"log_format"`       // CSV, PARQUET
-	CompressionType  string    `json:"compression_type"` // RAW, GZIP
-	PartitionPeriod  string    `json:"partition_period"` // HOUR, DAY
-	AdminStateUp     bool      `json:"admin_state_up"`
-	State            string    `json:"state"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at,omitempty"`
+	ID               string         `json:"id"`
+	Name             string         `json:"name"`
+	Description      string         `json:"description,omitempty"`
+	ResourceType     string         `json:"resource_type"` // VPC, SUBNET, PORT
+	ResourceID       string         `json:"resource_id"`
+	FilterType       string         `json:"filter_type"`       // ALL, ACCEPT, DROP
+	ConnectionAction string         `json:"connection_action"` // enable, disable
+	StorageType      string         `json:"storage_type"`      // OBS
+	StorageURL       string         `json:"storage_url"`
+	LogFormat        string         `json:"log_format"`       // CSV, PARQUET
+	CompressionType  string         `json:"compression_type"` // RAW, GZIP
+	PartitionPeriod  string         `json:"partition_period"` // HOUR, DAY
+	AdminStateUp     bool           `json:"admin_state_up"`
+	State            string         `json:"state"`
+	CreatedAt        timestamp.Time `json:"created_at"`
+	UpdatedAt        timestamp.Time `json:"updated_at,omitempty"`
 }
 
 // LoggingPort represents a logging port
 type LoggingPort struct {
-	ID        string    `json:"id"`
-	LoggerID  string    `json:"logger_id"`
-	PortID    string    `json:"port_id"`
-	State     string    `json:"state"`
-	CreatedAt time.Time `json:"created_at"`
+	ID        string         `json:"id"`
+	LoggerID  string         `json:"logger_id"`
+	PortID    string         `json:"port_id"`
+	State     string         `json:"state"`
+	CreatedAt timestamp.Time `json:"created_at"`
 }
 
 // ListLoggersOutput represents the response from listing loggers
@@ -1,13 +1,15 @@
 package internetgateway
 
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
 type InternetGateway struct {
-	ID                string `json:"id"`
-	Name              string `json:"name"`
-	TenantID          string `json:"tenant_id"`
-	RoutingTableID    string `json:"routingtable_id,omitempty"`
-	ExternalNetworkID string `json:"external_network_id,omitempty"`
-	State             string `json:"state,omitempty"`
-	CreateTime        string `json:"create_time,omitempty"`
+	ID                string         `json:"id"`
+	Name              string         `json:"name"`
+	TenantID          string         `json:"tenant_id"`
+	RoutingTableID    string         `json:"routingtable_id,omitempty"`
+	ExternalNetworkID string         `json:"external_network_id,omitempty"`
+	State             string         `json:"state,omitempty"`
+	CreateTime        timestamp.Time `json:"create_time,omitempty"`
 }
 
 type ListInternetGatewaysOutput struct {
@@ -0,0 +1,91 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/waiter"
+)
+
+// ProvisioningStatus is the provisioning_status of a load balancer or one
+// of its child resources. Any PENDING_* value counts as transitioning, so
+// actions the SDK does not list are still recognized.
+type ProvisioningStatus string
+
+const (
+	ProvisioningStatusActive        ProvisioningStatus = "ACTIVE"
+	ProvisioningStatusDeleted       ProvisioningStatus = "DELETED"
+	ProvisioningStatusError         ProvisioningStatus = "ERROR"
+	ProvisioningStatusPendingCreate ProvisioningStatus = "PENDING_CREATE"
+	ProvisioningStatusPendingUpdate ProvisioningStatus = "PENDING_UPDATE"
+	ProvisioningStatusPendingDelete ProvisioningStatus = "PENDING_DELETE"
+)
+
+// IsTerminal reports whether no change is being applied.
+func (s ProvisioningStatus) IsTerminal() bool {
+	return s == ProvisioningStatusActive || s == ProvisioningStatusDeleted || s == ProvisioningStatusError
+}
+
+// IsFailed reports whether provisioning failed.
+func (s ProvisioningStatus) IsFailed() bool { return s == ProvisioningStatusError }
+
+// IsTransitioning reports whether a change is being applied. Child
+// resources cannot be modified while their load balancer is transitioning.
+func (s ProvisioningStatus) IsTransitioning() bool {
+	return strings.HasPrefix(string(s), "PENDING_")
+}
+
+// OperatingStatus is the operating_status of a load balancer or one of its
+// child resources.
+type OperatingStatus string
+
+const (
+	OperatingStatusOnline    OperatingStatus = "ONLINE"
+	OperatingStatusDraining  OperatingStatus = "DRAINING"
+	OperatingStatusOffline   OperatingStatus = "OFFLINE"
+	OperatingStatusDegraded  OperatingStatus = "DEGRADED"
+	OperatingStatusError     OperatingStatus = "ERROR"
+	OperatingStatusNoMonitor OperatingStatus = "NO_MONITOR"
+)
+
+// IsFailed reports whether the resource is not serving traffic because of
+// an error.
+func (s OperatingStatus) IsFailed() bool { return s == OperatingStatusError }
+
+// WaitForLoadBalancer polls GetLoadBalancer every interval (10s when zero)
+// until the load balancer's provisioning status is want, which must be
+// settled. Waiting for ProvisioningStatusDeleted ends with a nil output once
+// the load balancer is gone. ERROR ends the wait with
+// *errors.FailedStateError. Bound the wait with a context deadline.
+func (c *Client) WaitForLoadBalancer(ctx context.Context, lbID string, want ProvisioningStatus, interval time.Duration) (*GetLoadBalancerOutput, error) {
+	if !want.IsTerminal() || want.IsFailed() {
+		return nil, &errors.ValidationError{Field: "want", Reason: fmt.Sprintf("%q is not a settled provisioning status", want)}
+	}
+
+	var last *GetLoadBalancerOutput
+	err := waiter.Poll(ctx, interval, func(ctx context.Context) (bool, error) {
+		out, err := c.GetLoadBalancer(ctx, lbID)
+		if err != nil {
+			if want == ProvisioningStatusDeleted && waiter.IsNotFound(err) {
+				last = nil
+				return true, nil
+			}
+			return false, err
+		}
+		last = out
+		switch s := out.LoadBalancer.ProvisioningStatus; {
+		case s == want:
+			return true, nil
+		case s.IsFailed():
+			return false, &errors.FailedStateError{Resource: "load balancer", ID: lbID, Status: string(s)}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return last, fmt.Errorf("wait for load balancer %s to be %s: %w", lbID, want, err)
+	}
+	return last, nil
+}
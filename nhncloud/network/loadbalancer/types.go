@@ -1,26 +1,28 @@
 package loadbalancer
 
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
 type IDReference struct {
 	ID string `json:"id"`
 }
 
 type LoadBalancer struct {
-	ID                 string        `json:"id"`
-	Name               string        `json:"name"`
-	Description        string        `json:"description,omitempty"`
-	TenantID           string        `json:"tenant_id"`
-	VIPAddress         string        `json:"vip_address"`
-	VIPPortID          string        `json:"vip_port_id"`
-	VIPSubnetID        string        `json:"vip_subnet_id"`
-	VIPNetworkID       string        `json:"vip_network_id"`
-	ProvisioningStatus string        `json:"provisioning_status"`
-	OperatingStatus    string        `json:"operating_status"`
-	AdminStateUp       bool          `json:"admin_state_up"`
-	Provider           string        `json:"provider"`
-	Listeners          []IDReference `json:"listeners,omitempty"`
-	Pools              []IDReference `json:"pools,omitempty"`
-	CreatedAt          string        `json:"created_at"`
-	UpdatedAt          string        `json:"updated_at"`
+	ID                 string             `json:"id"`
+	Name               string             `json:"name"`
+	Description        string             `json:"description,omitempty"`
+	TenantID           string             `json:"tenant_id"`
+	VIPAddress         string             `json:"vip_address"`
+	VIPPortID          string             `json:"vip_port_id"`
+	VIPSubnetID        string             `json:"vip_subnet_id"`
+	VIPNetworkID       string             `json:"vip_network_id"`
+	ProvisioningStatus ProvisioningStatus `json:"provisioning_status"`
+	OperatingStatus    OperatingStatus    `json:"operating_status"`
+	AdminStateUp       bool               `json:"admin_state_up"`
+	Provider           string             `json:"provider"`
+	Listeners          []IDReference      `json:"listeners,omitempty"`
+	Pools              []IDReference      `json:"pools,omitempty"`
+	CreatedAt          timestamp.Time     `json:"created_at"`
+	UpdatedAt          timestamp.Time     `json:"updated_at"`
 }
 
 // ListLoadBalancersOutput represents the response for listing load balancers
@@ -67,22 +69,22 @@ type UpdateLoadBalancerRequest struct {
 
 // Listener represents a load balancer listener
 type Listener struct {
-	ID                     string   `json:"id"`
-	Name                   string   `json:"name"`
-	Description            string   `json:"description,omitempty"`
-	TenantID               string   `json:"tenant_id"`
-	LoadBalancerID         string   `json:"loadbalancer_id"`
-	Protocol               string   `json:"protocol"`
-	ProtocolPort           int      `json:"protocol_port"`
-	DefaultPoolID          string   `json:"default_pool_id,omitempty"`
-	ConnectionLimit        int      `json:"connection_limit"`
-	AdminStateUp           bool     `json:"admin_state_up"`
-	ProvisioningStatus     string   `json:"provisioning_status"`
-	OperatingStatus        string   `json:"operating_status"`
-	DefaultTLSContainerRef string   `json:"default_tls_container_ref,omitempty"`
-	SNIContainerRefs       []string `json:"sni_container_refs,omitempty"`
-	CreatedAt              string   `json:"created_at"`
-	UpdatedAt              string   `json:"updated_at"`
+	ID                     string             `json:"id"`
+	Name                   string             `json:"name"`
+	Description            string             `json:"description,omitempty"`
+	TenantID               string             `json:"tenant_id"`
+	LoadBalancerID         string             `json:"loadbalancer_id"`
+	Protocol               string             `json:"protocol"`
+	ProtocolPort           int                `json:"protocol_port"`
+	DefaultPoolID          string             `json:"default_pool_id,omitempty"`
+	ConnectionLimit        int                `json:"connection_limit"`
+	AdminStateUp           bool               `json:"admin_state_up"`
+	ProvisioningStatus     ProvisioningStatus `json:"provisioning_status"`
+	OperatingStatus        OperatingStatus    `json:"operating_status"`
+	DefaultTLSContainerRef string             `json:"default_tls_container_ref,omitempty"`
+	SNIContainerRefs       []string           `json:"sni_container_refs,omitempty"`
+	CreatedAt              timestamp.Time     `json:"created_at"`
+	UpdatedAt              timestamp.Time     `json:"updated_at"`
 }
 
 // ListListenersOutput represents the response for listing listeners
@@ -122,15 +124,15 @@ type Pool struct {
 	Protocol           string              `json:"protocol"`
 	LBAlgorithm        string              `json:"lb_algorithm"`
 	AdminStateUp       bool                `json:"admin_state_up"`
-	ProvisioningStatus string              `json:"provisioning_status"`
-	OperatingStatus    string              `json:"operating_status"`
+	ProvisioningStatus ProvisioningStatus  `json:"provisioning_status"`
+	OperatingStatus    OperatingStatus     `json:"operating_status"`
 	LoadBalancerID     string              `json:"loadbalancer_id,omitempty"`
 	ListenerID         string              `json:"listener_id,omitempty"`
 	Members            []Member            `json:"members,omitempty"`
 	HealthMonitorID    string              `json:"healthmonitor_id,omitempty"`
 	SessionPersistence *SessionPersistence `json:"session_persistence,omitempty"`
-	CreatedAt          string              `json:"created_at"`
-	UpdatedAt          string              `json:"updated_at"`
+	CreatedAt          timestamp.Time      `json:"created_at"`
+	UpdatedAt          timestamp.Time      `json:"updated_at"`
 }
 
 // SessionPersistence represents session persistence configuration
@@ -168,18 +170,18 @@ type CreatePoolRequest struct {
 
 // Member represents a pool member
 type Member struct {
-	ID                 string `json:"id"`
-	Name               string `json:"name"`
-	TenantID           string `json:"tenant_id"`
-	Address            string `json:"address"`
-	ProtocolPort       int    `json:"protocol_port"`
-	Weight             int    `json:"weight"`
-	SubnetID           string `json:"subnet_id"`
-	AdminStateUp       bool   `json:"admin_state_up"`
-	ProvisioningStatus string `json:"provisioning_status"`
-	OperatingStatus    string `json:"operating_status"`
-	CreatedAt          string `json:"created_at"`
-	UpdatedAt          string `json:"updated_at"`
+	ID                 string             `json:"id"`
+	Name               string             `json:"name"`
+	TenantID           string             `json:"tenant_id"`
+	Address            string             `json:"address"`
+	ProtocolPort       int                `json:"protocol_port"`
+	Weight             int                `json:"weight"`
+	SubnetID           string             `json:"subnet_id"`
+	AdminStateUp       bool               `json:"admin_state_up"`
+	ProvisioningStatus ProvisioningStatus `json:"provisioning_status"`
+	OperatingStatus    OperatingStatus    `json:"operating_status"`
+	CreatedAt          timestamp.Time     `json:"created_at"`
+	UpdatedAt          timestamp.Time     `json:"updated_at"`
 }
 
 // ListMembersOutput represents the response for listing members
@@ -209,23 +211,23 @@ type CreateMemberRequest struct {
 
 // HealthMonitor represents a health monitor
 type HealthMonitor struct {
-	ID                 string `json:"id"`
-	Name               string `json:"name"`
-	TenantID           string `json:"tenant_id"`
-	PoolID             string `json:"pool_id"`
-	Type               string `json:"type"`
-	Delay              int    `json:"delay"`
-	Timeout            int    `json:"timeout"`
-	MaxRetries         int    `json:"max_retries"`
-	MaxRetriesDown     int    `json:"max_retries_down"`
-	HTTPMethod         string `json:"http_method,omitempty"`
-	URLPath            string `json:"url_path,omitempty"`
-	ExpectedCodes      string `json:"expected_codes,omitempty"`
-	AdminStateUp       bool   `json:"admin_state_up"`
-	ProvisioningStatus string `json:"provisioning_status"`
-	OperatingStatus    string `json:"operating_status"`
-	CreatedAt          string `json:"created_at"`
-	UpdatedAt          string `json:"updated_at"`
+	ID                 string             `json:"id"`
+	Name               string             `json:"name"`
+	TenantID           string             `json:"tenant_id"`
+	PoolID             string             `json:"pool_id"`
+	Type               string             `json:"type"`
+	Delay              int                `json:"delay"`
+	Timeout            int                `json:"timeout"`
+	MaxRetries         int                `json:"max_retries"`
+	MaxRetriesDown     int                `json:"max_retries_down"`
+	HTTPMethod         string             `json:"http_method,omitempty"`
+	URLPath            string             `json:"url_path,omitempty"`
+	ExpectedCodes      string             `json:"expected_codes,omitempty"`
+	AdminStateUp       bool               `json:"admin_state_up"`
+	ProvisioningStatus ProvisioningStatus `json:"provisioning_status"`
+	OperatingStatus    OperatingStatus    `json:"operating_status"`
+	CreatedAt          timestamp.Time     `json:"created_at"`
+	UpdatedAt          timestamp.Time     `json:"updated_at"`
 }
 
 // ListHealthMonitorsOutput represents the response for listing health monitors
@@ -309,23 +311,23 @@ type UpdateHealthMonitorRequest struct {
 }
 
 type L7Policy struct {
-	ID                 string        `json:"id"`
-	Name               string        `json:"name"`
-	Description        string        `json:"description,omitempty"`
-	TenantID           string        `json:"tenant_id"`
-	ListenerID         string        `json:"listener_id"`
-	Action             string        `json:"action"`
-	Position           int           `json:"position"`
-	RedirectPoolID     string        `json:"redirect_pool_id,omitempty"`
-	RedirectURL        string        `json:"redirect_url,omitempty"`
-	RedirectPrefix     string        `json:"redirect_prefix,omitempty"`
-	RedirectHTTPCode   int           `json:"redirect_http_code,omitempty"`
-	AdminStateUp       bool          `json:"admin_state_up"`
-	ProvisioningStatus string        `json:"provisioning_status"`
-	OperatingStatus    string        `json:"operating_status"`
-	Rules              []IDReference `json:"rules,omitempty"`
-	CreatedAt          string        `json:"created_at"`
-	UpdatedAt          string        `json:"updated_at"`
+	ID                 string             `json:"id"`
+	Name               string             `json:"name"`
+	Description        string             `json:"description,omitempty"`
+	TenantID           string             `json:"tenant_id"`
+	ListenerID         string             `json:"listener_id"`
+	Action             string             `json:"action"`
+	Position           int                `json:"position"`
+	RedirectPoolID     string             `json:"redirect_pool_id,omitempty"`
+	RedirectURL        string             `json:"redirect_url,omitempty"`
+	RedirectPrefix     string             `json:"redirect_prefix,omitempty"`
+	RedirectHTTPCode   int                `json:"redirect_http_code,omitempty"`
+	AdminStateUp       bool               `json:"admin_state_up"`
+	ProvisioningStatus ProvisioningStatus `json:"provisioning_status"`
+	OperatingStatus    OperatingStatus    `json:"operating_status"`
+	Rules              []IDReference      `json:"rules,omitempty"`
+	CreatedAt          timestamp.Time     `json:"created_at"`
+	UpdatedAt          timestamp.Time     `json:"updated_at"`
 }
 
 type ListL7PoliciesOutput struct {
@@ -370,18 +372,18 @@ type UpdateL7PolicyRequest struct {
 }
 
 type L7Rule struct {
-	ID                 string `json:"id"`
-	TenantID           string `json:"tenant_id"`
-	Type               string `json:"type"`
-	CompareType        string `json:"compare_type"`
-	Key                string `json:"key,omitempty"`
-	Value              string `json:"value"`
-	Invert             bool   `json:"invert"`
-	AdminStateUp       bool   `json:"admin_state_up"`
-	ProvisioningStatus string `json:"provisioning_status"`
-	OperatingStatus    string `json:"operating_status"`
-	CreatedAt          string `json:"created_at"`
-	UpdatedAt          string `json:"updated_at"`
+	ID                 string             `json:"id"`
+	TenantID           string             `json:"tenant_id"`
+	Type               string             `json:"type"`
+	CompareType        string             `json:"compare_type"`
+	Key                string             `json:"key,omitempty"`
+	Value              string             `json:"value"`
+	Invert             bool               `json:"invert"`
+	AdminStateUp       bool               `json:"admin_state_up"`
+	ProvisioningStatus ProvisioningStatus `json:"provisioning_status"`
+	OperatingStatus    OperatingStatus    `json:"operating_status"`
+	CreatedAt          timestamp.Time     `json:"created_at"`
+	UpdatedAt          timestamp.Time     `json:"updated_at"`
 }
 
 type ListL7RulesOutput struct {
@@ -1,18 +1,20 @@
 package natgateway
 
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
 type NATGateway struct {
-	ID                string `json:"id"`
-	Name              string `json:"name"`
-	Description       string `json:"description,omitempty"`
-	TenantID          string `json:"tenant_id"`
-	SubnetID          string `json:"subnet_id,omitempty"`
-	VPCID             string `json:"vpc_id,omitempty"`
-	FloatingIPID      string `json:"floatingip_id,omitempty"`
-	FloatingIPAddress string `json:"floatingip_address,omitempty"`
-	Status            string `json:"status,omitempty"`
-	State             string `json:"state,omitempty"`
-	CreatedAt         string `json:"created_at,omitempty"`
-	UpdatedAt         string `json:"updated_at,omitempty"`
+	ID                string         `json:"id"`
+	Name              string         `json:"name"`
+	Description       string         `json:"description,omitempty"`
+	TenantID          string         `json:"tenant_id"`
+	SubnetID          string         `json:"subnet_id,omitempty"`
+	VPCID             string         `json:"vpc_id,omitempty"`
+	FloatingIPID      string         `json:"floatingip_id,omitempty"`
+	FloatingIPAddress string         `json:"floatingip_address,omitempty"`
+	Status            string         `json:"status,omitempty"`
+	State             string         `json:"state,omitempty"`
+	CreatedAt         timestamp.Time `json:"created_at,omitempty"`
+	UpdatedAt         timestamp.Time `json:"updated_at,omitempty"`
 }
 
 type ListNATGatewaysOutput struct {
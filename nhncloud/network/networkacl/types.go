@@ -1,14 +1,16 @@
 package networkacl
 
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
 // ACL represents a network access control list
 type ACL struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	TenantID    string `json:"tenant_id"`
-	CreateTime  string `json:"create_time,omitempty"`
-	UpdateTime  string `json:"update_time,omitempty"`
-	Shared      bool   `json:"shared"`
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	TenantID    string         `json:"tenant_id"`
+	CreateTime  timestamp.Time `json:"create_time,omitempty"`
+	UpdateTime  timestamp.Time `json:"update_time,omitempty"`
+	Shared      bool           `json:"shared"`
 }
 
 // ListACLsOutput represents the response for ACL list API
@@ -45,22 +47,22 @@ type UpdateACLRequest struct {
 
 // ACLRule represents a network ACL rule
 type ACLRule struct {
-	ID          string `json:"id"`
-	ACLID       string `json:"acl_id"`
-	TenantID    string `json:"tenant_id"`
-	Description string `json:"description"`
-	Protocol    string `json:"protocol"`      // tcp, udp, icmp, or null (any)
-	EtherType   string `json:"ethertype"`     // IPv4, IPv6
-	SrcIPPrefix string `json:"src_ip_prefix"` // Source IP CIDR
-	DstIPPrefix string `json:"dst_ip_prefix"` // Destination IP CIDR
-	SrcPortMin  *int   `json:"src_port_min"`
-	SrcPortMax  *int   `json:"src_port_max"`
-	DstPortMin  *int   `json:"dst_port_min"`
-	DstPortMax  *int   `json:"dst_port_max"`
-	Policy      string `json:"policy"` // allow, deny
-	OrderNum    int    `json:"order"`  // Rule order/priority
-	CreateTime  string `json:"create_time,omitempty"`
-	UpdateTime  string `json:"update_time,omitempty"`
+	ID          string         `json:"id"`
+	ACLID       string         `json:"acl_id"`
+	TenantID    string         `json:"tenant_id"`
+	Description string         `json:"description"`
+	Protocol    string         `json:"protocol"`      // tcp, udp, icmp, or null (any)
+	EtherType   string         `json:"ethertype"`     // IPv4, IPv6
+	SrcIPPrefix string         `json:"src_ip_prefix"` // Source IP CIDR
+	DstIPPrefix string         `json:"dst_ip_prefix"` // Destination IP CIDR
+	SrcPortMin  *int           `json:"src_port_min"`
+	SrcPortMax  *int           `json:"src_port_max"`
+	DstPortMin  *int           `json:"dst_port_min"`
+	DstPortMax  *int           `json:"dst_port_max"`
+	Policy      string         `json:"policy"` // allow, deny
+	OrderNum    int            `json:"order"`  // Rule order/priority
+	CreateTime  timestamp.Time `json:"create_time,omitempty"`
+	UpdateTime  timestamp.Time `json:"update_time,omitempty"`
 }
 
 // ListACLRulesOutput represents the response for ACL rules list API
@@ -115,11 +117,11 @@ type UpdateACLRuleRequest struct {
 
 // ACLBinding represents an ACL binding to a network resource (subnet)
 type ACLBinding struct {
-	ID         string `json:"id"`
-	ACLID      string `json:"acl_id"`
-	SubnetID   string `json:"subnet_id"`
-	TenantID   string `json:"tenant_id"`
-	CreateTime string `json:"create_time,omitempty"`
+	ID         string         `json:"id"`
+	ACLID      string         `json:"acl_id"`
+	SubnetID   string         `json:"subnet_id"`
+	TenantID   string         `json:"tenant_id"`
+	CreateTime timestamp.Time `json:"create_time,omitempty"`
 }
 
 // ListACLBindingsOutput represents the response for ACL bindings list API
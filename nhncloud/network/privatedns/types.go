@@ -1,30 +1,32 @@
 package privatedns
 
-import "time"
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
 
 // Zone represents a private DNS zone
 type Zone struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description,omitempty"`
-	VPCID       string    `json:"vpc_id"`
-	State       string    `json:"state"`
-	RecordCount int       `json:"record_count"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	VPCID       string         `json:"vpc_id"`
+	State       string         `json:"state"`
+	RecordCount int            `json:"record_count"`
+	CreatedAt   timestamp.Time `json:"created_at"`
+	UpdatedAt   timestamp.Time `json:"updated_at,omitempty"`
 }
 
 // RRSet represents a DNS record set
 type RRSet struct {
-	ID        string    `json:"id"`
-	ZoneID    string    `json:"zone_id"`
-	Name      string    `json:"name"`
-	Type      string    `json:"type"` // A, AAAA, CNAME, MX, TXT, etc.
-	TTL       int       `json:"ttl"`
-	Records   []string  `json:"records"`
-	State     string    `json:"state"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	ID        string         `json:"id"`
+	ZoneID    string         `json:"zone_id"`
+	Name      string         `json:"name"`
+	Type      string         `json:"type"` // A, AAAA, CNAME, MX, TXT, etc.
+	TTL       int            `json:"ttl"`
+	Records   []string       `json:"records"`
+	State     string         `json:"state"`
+	CreatedAt timestamp.Time `json:"created_at"`
+	UpdatedAt timestamp.Time `json:"updated_at,omitempty"`
 }
 
 // ListZonesOutput represents the response from listing zones
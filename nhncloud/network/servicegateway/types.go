@@ -1,28 +1,30 @@
 package servicegateway
 
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
 // ServiceGateway represents a service gateway for accessing NHN Cloud services privately
 type ServiceGateway struct {
-	ID                string `json:"id"`
-	Name              string `json:"name"`
-	Description       string `json:"description"`
-	TenantID          string `json:"tenant_id"`
-	SubnetID          string `json:"subnet_id"`
-	ServiceEndpointID string `json:"service_endpoint_id"`
-	IPAddress         string `json:"ip_address"`
-	Status            string `json:"status"`
-	CreateTime        string `json:"create_time,omitempty"`
-	UpdateTime        string `json:"update_time,omitempty"`
+	ID                string         `json:"id"`
+	Name              string         `json:"name"`
+	Description       string         `json:"description"`
+	TenantID          string         `json:"tenant_id"`
+	SubnetID          string         `json:"subnet_id"`
+	ServiceEndpointID string         `json:"service_endpoint_id"`
+	IPAddress         string         `json:"ip_address"`
+	Status            string         `json:"status"`
+	CreateTime        timestamp.Time `json:"create_time,omitempty"`
+	UpdateTime        timestamp.Time `json:"update_time,omitempty"`
 }
 
 // ServiceEndpoint represents a predefined NHN Cloud service endpoint
 type ServiceEndpoint struct {
-	ID           string `json:"id"`
-	Name         string `json:"name"`
-	ServiceName  string `json:"service_name"`
-	Description  string `json:"description"`
-	Region       string `json:"region"`
-	EndpointType string `json:"endpoint_type"`
-	CreateTime   string `json:"create_time,omitempty"`
+	ID           string         `json:"id"`
+	Name         string         `json:"name"`
+	ServiceName  string         `json:"service_name"`
+	Description  string         `json:"description"`
+	Region       string         `json:"region"`
+	EndpointType string         `json:"endpoint_type"`
+	CreateTime   timestamp.Time `json:"create_time,omitempty"`
 }
 
 // ListServiceGatewaysOutput represents the response for service gateways list API
@@ -1,20 +1,22 @@
 package transithub
 
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
 // =============================================================================
 // Transit Hub Types
 // =============================================================================
 
 // TransitHub represents a transit hub for multi-VPC networking
 type TransitHub struct {
-	ID                  string `json:"id"`
-	Name                string `json:"name"`
-	Description         string `json:"description,omitempty"`
-	TenantID            string `json:"tenant_id"`
-	State               string `json:"state,omitempty"`
-	Status              string `json:"status,omitempty"`
-	DefaultRoutingTable string `json:"default_routingtable_id,omitempty"`
-	CreatedAt           string `json:"created_at,omitempty"`
-	UpdatedAt           string `json:"updated_at,omitempty"`
+	ID                  string         `json:"id"`
+	Name                string         `json:"name"`
+	Description         string         `json:"description,omitempty"`
+	TenantID            string         `json:"tenant_id"`
+	State               string         `json:"state,omitempty"`
+	Status              string         `json:"status,omitempty"`
+	DefaultRoutingTable string         `json:"default_routingtable_id,omitempty"`
+	CreatedAt           timestamp.Time `json:"created_at,omitempty"`
+	UpdatedAt           timestamp.Time `json:"updated_at,omitempty"`
 }
 
 type ListTransitHubsOutput struct {
@@ -49,18 +51,18 @@ type UpdateTransitHubRequest struct {
 
 // Attachment represents a transit hub attachment (VPC connection)
 type Attachment struct {
-	ID           string `json:"id"`
-	Name         string `json:"name"`
-	Description  string `json:"description,omitempty"`
-	TenantID     string `json:"tenant_id"`
-	TransitHubID string `json:"transithub_id"`
-	ResourceType string `json:"resource_type"` // "VPC", "VPN", etc.
-	ResourceID   string `json:"resource_id"`
-	ResourceName string `json:"resource_name,omitempty"`
-	State        string `json:"state,omitempty"`
-	Status       string `json:"status,omitempty"`
-	CreatedAt    string `json:"created_at,omitempty"`
-	UpdatedAt    string `json:"updated_at,omitempty"`
+	ID           string         `json:"id"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description,omitempty"`
+	TenantID     string         `json:"tenant_id"`
+	TransitHubID string         `json:"transithub_id"`
+	ResourceType string         `json:"resource_type"` // "VPC", "VPN", etc.
+	ResourceID   string         `json:"resource_id"`
+	ResourceName string         `json:"resource_name,omitempty"`
+	State        string         `json:"state,omitempty"`
+	Status       string         `json:"status,omitempty"`
+	CreatedAt    timestamp.Time `json:"created_at,omitempty"`
+	UpdatedAt    timestamp.Time `json:"updated_at,omitempty"`
 }
 
 type ListAttachmentsOutput struct {
@@ -98,16 +100,16 @@ type UpdateAttachmentRequest struct {
 
 // RoutingTable represents a transit hub routing table
 type RoutingTable struct {
-	ID           string `json:"id"`
-	Name         string `json:"name"`
-	Description  string `json:"description,omitempty"`
-	TenantID     string `json:"tenant_id"`
-	TransitHubID string `json:"transithub_id"`
-	DefaultTable bool   `json:"default_table,omitempty"`
-	State        string `json:"state,omitempty"`
-	Status       string `json:"status,omitempty"`
-	CreatedAt    string `json:"created_at,omitempty"`
-	UpdatedAt    string `json:"updated_at,omitempty"`
+	ID           string         `json:"id"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description,omitempty"`
+	TenantID     string         `json:"tenant_id"`
+	TransitHubID string         `json:"transithub_id"`
+	DefaultTable bool           `json:"default_table,omitempty"`
+	State        string         `json:"state,omitempty"`
+	Status       string         `json:"status,omitempty"`
+	CreatedAt    timestamp.Time `json:"created_at,omitempty"`
+	UpdatedAt    timestamp.Time `json:"updated_at,omitempty"`
 }
 
 type ListRoutingTablesOutput struct {
@@ -143,14 +145,14 @@ type UpdateRoutingTableRequest struct {
 
 // RoutingAssociation represents an association between attachment and routing table
 type RoutingAssociation struct {
-	ID             string `json:"id"`
-	TenantID       string `json:"tenant_id"`
-	RoutingTableID string `json:"routing_table_id"`
-	AttachmentID   string `json:"attachment_id"`
-	State          string `json:"state,omitempty"`
-	Status         string `json:"status,omitempty"`
-	CreatedAt      string `json:"created_at,omitempty"`
-	UpdatedAt      string `json:"updated_at,omitempty"`
+	ID             string         `json:"id"`
+	TenantID       string         `json:"tenant_id"`
+	RoutingTableID string         `json:"routing_table_id"`
+	AttachmentID   string         `json:"attachment_id"`
+	State          string         `json:"state,omitempty"`
+	Status         string         `json:"status,omitempty"`
+	CreatedAt      timestamp.Time `json:"created_at,omitempty"`
+	UpdatedAt      timestamp.Time `json:"updated_at,omitempty"`
 }
 
 type ListRoutingAssociationsOutput struct {
@@ -176,14 +178,14 @@ type CreateRoutingAssociationRequest struct {
 
 // RoutingPropagation represents route propagation from attachment to routing table
 type RoutingPropagation struct {
-	ID             string `json:"id"`
-	TenantID       string `json:"tenant_id"`
-	RoutingTableID string `json:"routing_table_id"`
-	AttachmentID   string `json:"attachment_id"`
-	State          string `json:"state,omitempty"`
-	Status         string `json:"status,omitempty"`
-	CreatedAt      string `json:"created_at,omitempty"`
-	UpdatedAt      string `json:"updated_at,omitempty"`
+	ID             string         `json:"id"`
+	TenantID       string         `json:"tenant_id"`
+	RoutingTableID string         `json:"routing_table_id"`
+	AttachmentID   string         `json:"attachment_id"`
+	State          string         `json:"state,omitempty"`
+	Status         string         `json:"status,omitempty"`
+	CreatedAt      timestamp.Time `json:"created_at,omitempty"`
+	UpdatedAt      timestamp.Time `json:"updated_at,omitempty"`
 }
 
 type ListRoutingPropagationsOutput struct {
@@ -209,17 +211,17 @@ type CreateRoutingPropagationRequest struct {
 
 // RoutingRule represents a static routing rule in a routing table
 type RoutingRule struct {
-	ID             string `json:"id"`
-	TenantID       string `json:"tenant_id"`
-	RoutingTableID string `json:"routing_table_id"`
-	Destination    string `json:"destination"`
-	TargetType     string `json:"target_type"` // "ATTACHMENT", "BLACKHOLE"
-	TargetID       string `json:"target_id,omitempty"`
-	State          string `json:"state,omitempty"`
-	Status         string `json:"status,omitempty"`
-	Propagated     bool   `json:"propagated,omitempty"`
-	CreatedAt      string `json:"created_at,omitempty"`
-	UpdatedAt      string `json:"updated_at,omitempty"`
+	ID             string         `json:"id"`
+	TenantID       string         `json:"tenant_id"`
+	RoutingTableID string         `json:"routing_table_id"`
+	Destination    string         `json:"destination"`
+	TargetType     string         `json:"target_type"` // "ATTACHMENT", "BLACKHOLE"
+	TargetID       string         `json:"target_id,omitempty"`
+	State          string         `json:"state,omitempty"`
+	Status         string         `json:"status,omitempty"`
+	Propagated     bool           `json:"propagated,omitempty"`
+	CreatedAt      timestamp.Time `json:"created_at,omitempty"`
+	UpdatedAt      timestamp.Time `json:"updated_at,omitempty"`
 }
 
 type ListRoutingRulesOutput struct {
@@ -257,15 +259,15 @@ type UpdateRoutingRuleRequest struct {
 
 // MulticastDomain represents a multicast domain for transit hub
 type MulticastDomain struct {
-	ID           string `json:"id"`
-	Name         string `json:"name"`
-	Description  string `json:"description,omitempty"`
-	TenantID     string `json:"tenant_id"`
-	TransitHubID string `json:"transithub_id"`
-	State        string `json:"state,omitempty"`
-	Status       string `json:"status,omitempty"`
-	CreatedAt    string `json:"created_at,omitempty"`
-	UpdatedAt    string `json:"updated_at,omitempty"`
+	ID           string         `json:"id"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description,omitempty"`
+	TenantID     string         `json:"tenant_id"`
+	TransitHubID string         `json:"transithub_id"`
+	State        string         `json:"state,omitempty"`
+	Status       string         `json:"status,omitempty"`
+	CreatedAt    timestamp.Time `json:"created_at,omitempty"`
+	UpdatedAt    timestamp.Time `json:"updated_at,omitempty"`
 }
 
 type ListMulticastDomainsOutput struct {
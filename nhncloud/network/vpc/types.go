@@ -1,15 +1,17 @@
 package vpc
 
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
 type VPC struct {
-	ID             string `json:"id"`
-	Name           string `json:"name"`
-	TenantID       string `json:"tenant_id"`
-	CIDRv4         string `json:"cidrv4"`
-	State          string `json:"state"`
-	Shared         bool   `json:"shared"`
-	RouterExternal bool   `json:"router:external"`
-	CreatedAt      string `json:"created_time,omitempty"`
-	UpdatedAt      string `json:"updated_time,omitempty"`
+	ID             string         `json:"id"`
+	Name           string         `json:"name"`
+	TenantID       string         `json:"tenant_id"`
+	CIDRv4         string         `json:"cidrv4"`
+	State          string         `json:"state"`
+	Shared         bool           `json:"shared"`
+	RouterExternal bool           `json:"router:external"`
+	CreatedAt      timestamp.Time `json:"created_time,omitempty"`
+	UpdatedAt      timestamp.Time `json:"updated_time,omitempty"`
 }
 
 type Subnet struct {
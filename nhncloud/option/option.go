@@ -0,0 +1,69 @@
+// Package option configures the HTTP behavior shared by the service
+// clients: the HTTP client, circuit breaker, catalog cache and drift
+// detector.
+//
+// nhncloud.Client passes the settings of its Config to every service it
+// builds, so most programs never use this package directly. It is for
+// programs that construct a service client on its own:
+//
+//	c := mysql.NewClient("kr1", appKey, creds, false,
+//	    option.WithCircuitBreaker(breaker),
+//	    option.WithCache(catalogCache))
+package option
+
+import (
+	"net/http"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cache"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/circuitbreaker"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
+)
+
+// Option sets one of the Settings.
+type Option func(*Settings)
+
+// Settings are the shared settings of a service client. Nil fields leave
+// the client's defaults in place.
+type Settings struct {
+	// HTTPClient sends the client's requests, including its token
+	// requests.
+	HTTPClient *http.Client
+	// CircuitBreaker short-circuits requests to endpoint hosts that keep
+	// failing.
+	CircuitBreaker *circuitbreaker.Breaker
+	// Cache serves the service's catalog lookups from memory.
+	Cache *cache.Cache
+	// Drift reports response fields the SDK's types do not map.
+	Drift *drift.Detector
+}
+
+// WithHTTPClient sends requests with hc.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(s *Settings) { s.HTTPClient = hc }
+}
+
+// WithCircuitBreaker guards requests with b.
+func WithCircuitBreaker(b *circuitbreaker.Breaker) Option {
+	return func(s *Settings) { s.CircuitBreaker = b }
+}
+
+// WithCache serves catalog lookups from c.
+func WithCache(c *cache.Cache) Option {
+	return func(s *Settings) { s.Cache = c }
+}
+
+// WithDrift checks responses with d.
+func WithDrift(d *drift.Detector) Option {
+	return func(s *Settings) { s.Drift = d }
+}
+
+// Apply returns the settings opts select.
+func Apply(opts ...Option) Settings {
+	var s Settings
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&s)
+		}
+	}
+	return s
+}
@@ -0,0 +1,302 @@
+package orphan
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/inventory"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/loadbalancer"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/port"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/securitygroup"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/storage/block"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/storage/object"
+)
+
+// pageSize is the page size of the paginated list calls.
+const pageSize = 100
+
+// defaultSecurityGroup is the group every project gets. It cannot be
+// deleted, so it is never reported.
+const defaultSecurityGroup = "default"
+
+// TypeNCRTag is the resource type of NCR image tags, which the inventory
+// does not collect.
+const TypeNCRTag = "ncr.tag"
+
+// Check finds one kind of orphaned resource in a region and deletes it.
+type Check struct {
+	Kind Kind
+	// Service is the service key, like Config.AppKeys, that decides the
+	// regions the check runs in (see nhncloud.MultiRegion.RegionsFor).
+	Service string
+	// Find returns the orphaned resources. Kind and Region are filled in
+	// by the caller.
+	Find func(ctx context.Context, c *nhncloud.Client) ([]Finding, error)
+	// Delete deletes a resource Find returned.
+	Delete func(ctx context.Context, c *nhncloud.Client, f Finding) error
+}
+
+// Checks returns the built-in checks.
+func Checks() []Check {
+	return []Check{
+		{UnattachedFloatingIP, "network", findFloatingIPs, deleteFloatingIP},
+		{AvailableVolume, "block-storage", findVolumes, deleteVolume},
+		{OrphanedSnapshot, "block-storage", findSnapshots, deleteSnapshot},
+		{UnusedSecurityGroup, "network", findSecurityGroups, deleteSecurityGroup},
+		{IdleLoadBalancer, "network", findLoadBalancers, deleteLoadBalancer},
+		{EmptyContainer, "object-storage", findContainers, deleteContainer},
+		{UnpulledTag, "ncr", findTags, deleteTag},
+	}
+}
+
+func findFloatingIPs(ctx context.Context, c *nhncloud.Client) ([]Finding, error) {
+	out, err := c.FloatingIP().ListFloatingIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var findings []Finding
+	for _, ip := range out.FloatingIPs {
+		if ip.PortID != nil && *ip.PortID != "" {
+			continue
+		}
+		findings = append(findings, Finding{
+			Type: inventory.TypeFloatingIP, ID: ip.ID, Name: ip.FloatingIPAddress, CreatedAt: ip.CreatedAt,
+			Reason: "not associated with any port",
+		})
+	}
+	return findings, nil
+}
+
+func deleteFloatingIP(ctx context.Context, c *nhncloud.Client, f Finding) error {
+	return c.FloatingIP().DeleteFloatingIP(ctx, f.ID)
+}
+
+func findVolumes(ctx context.Context, c *nhncloud.Client) ([]Finding, error) {
+	out, err := c.BlockStorage().ListVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var findings []Finding
+	for _, v := range out.Volumes {
+		if v.Status != block.VolumeStatusAvailable {
+			continue
+		}
+		findings = append(findings, Finding{
+			Type: inventory.TypeVolume, ID: v.ID, Name: v.Name, CreatedAt: v.CreatedAt,
+			Reason: fmt.Sprintf("%d GB volume is available, not attached to any server", v.Size),
+		})
+	}
+	return findings, nil
+}
+
+func deleteVolume(ctx context.Context, c *nhncloud.Client, f Finding) error {
+	return c.BlockStorage().DeleteVolume(ctx, f.ID)
+}
+
+func findSnapshots(ctx context.Context, c *nhncloud.Client) ([]Finding, error) {
+	snaps, err := c.BlockStorage().ListSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vols, err := c.BlockStorage().ListVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return orphanedSnapshots(snaps.Snapshots, vols.Volumes), nil
+}
+
+// orphanedSnapshots returns the snapshots whose source volume is not among
+// vols.
+func orphanedSnapshots(snaps []block.Snapshot, vols []block.Volume) []Finding {
+	exists := make(map[string]bool, len(vols))
+	for _, v := range vols {
+		exists[v.ID] = true
+	}
+	var findings []Finding
+	for _, s := range snaps {
+		if exists[s.VolumeID] {
+			continue
+		}
+		findings = append(findings, Finding{
+			Type: inventory.TypeSnapshot, ID: s.ID, Name: s.Name, CreatedAt: s.CreatedAt,
+			Reason: fmt.Sprintf("source volume %s no longer exists", s.VolumeID),
+		})
+	}
+	return findings
+}
+
+func deleteSnapshot(ctx context.Context, c *nhncloud.Client, f Finding) error {
+	return c.BlockStorage().DeleteSnapshot(ctx, f.ID)
+}
+
+func findSecurityGroups(ctx context.Context, c *nhncloud.Client) ([]Finding, error) {
+	groups, err := c.SecurityGroup().ListSecurityGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ports, err := c.Port().ListPorts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return unboundSecurityGroups(groups.SecurityGroups, ports.Ports), nil
+}
+
+// unboundSecurityGroups returns the groups, other than the default group,
+// that none of ports uses.
+func unboundSecurityGroups(groups []securitygroup.SecurityGroup, ports []port.Port) []Finding {
+	bound := make(map[string]bool)
+	for _, p := range ports {
+		for _, id := range p.SecurityGroups {
+			bound[id] = true
+		}
+	}
+	var findings []Finding
+	for _, sg := range groups {
+		if bound[sg.ID] || sg.Name == defaultSecurityGroup {
+			continue
+		}
+		findings = append(findings, Finding{
+			Type: inventory.TypeSecurityGroup, ID: sg.ID, Name: sg.Name,
+			Reason: "not bound to any port",
+		})
+	}
+	return findings
+}
+
+func deleteSecurityGroup(ctx context.Context, c *nhncloud.Client, f Finding) error {
+	return c.SecurityGroup().DeleteSecurityGroup(ctx, f.ID)
+}
+
+func findLoadBalancers(ctx context.Context, c *nhncloud.Client) ([]Finding, error) {
+	lbs, err := c.LoadBalancer().ListLoadBalancers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pools, err := c.LoadBalancer().ListPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]loadbalancer.Pool, len(pools.Pools))
+	byLB := make(map[string][]string)
+	for _, p := range pools.Pools {
+		byID[p.ID] = p
+		byLB[p.LoadBalancerID] = append(byLB[p.LoadBalancerID], p.ID)
+	}
+
+	var findings []Finding
+	for _, lb := range lbs.LoadBalancers {
+		// A pool may name its load balancer, be named by it, or both.
+		poolIDs := map[string]bool{}
+		for _, id := range byLB[lb.ID] {
+			poolIDs[id] = true
+		}
+		for _, ref := range lb.Pools {
+			poolIDs[ref.ID] = true
+		}
+		members := 0
+		for id := range poolIDs {
+			if p, ok := byID[id]; ok && len(p.Members) > 0 {
+				members += len(p.Members)
+				continue
+			}
+			// The pool list may leave out members; ask for them.
+			out, err := c.LoadBalancer().ListMembers(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			members += len(out.Members)
+		}
+		if members > 0 {
+			continue
+		}
+		reason := "has no pools"
+		if len(poolIDs) > 0 {
+			reason = "its pools have no members"
+		}
+		findings = append(findings, Finding{
+			Type: inventory.TypeLoadBalancer, ID: lb.ID, Name: lb.Name, CreatedAt: lb.CreatedAt,
+			Reason: reason,
+		})
+	}
+	return findings, nil
+}
+
+// deleteLoadBalancer deletes the load balancer only. The API refuses while
+// it still has listeners or pools; those are left for a person to remove.
+func deleteLoadBalancer(ctx context.Context, c *nhncloud.Client, f Finding) error {
+	return c.LoadBalancer().DeleteLoadBalancer(ctx, f.ID)
+}
+
+func findContainers(ctx context.Context, c *nhncloud.Client) ([]Finding, error) {
+	in := &object.ListContainersInput{Limit: pageSize}
+	var findings []Finding
+	for {
+		out, err := c.ObjectStorage().ListContainers(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		for _, ct := range out.Containers {
+			if ct.Count > 0 {
+				continue
+			}
+			findings = append(findings, Finding{
+				Type: inventory.TypeContainer, ID: ct.Name, Name: ct.Name,
+				Reason: "contains no objects",
+			})
+		}
+		if len(out.Containers) < pageSize {
+			return findings, nil
+		}
+		in.Marker = out.Containers[len(out.Containers)-1].Name
+	}
+}
+
+func deleteContainer(ctx context.Context, c *nhncloud.Client, f Finding) error {
+	return c.ObjectStorage().DeleteContainer(ctx, f.ID)
+}
+
+// findTags reports tags that were never pulled. A tag's ID is
+// "<registry ID>/<image>:<tag>".
+func findTags(ctx context.Context, c *nhncloud.Client) ([]Finding, error) {
+	registries, err := c.NCR().ListRegistries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var findings []Finding
+	for _, r := range registries.Registries {
+		registryID := strconv.FormatInt(r.ID, 10)
+		images, err := c.NCR().ListImages(ctx, registryID)
+		if err != nil {
+			return nil, err
+		}
+		for _, img := range images.Images {
+			tags, err := c.NCR().ListTags(ctx, registryID, img.Name)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range tags.Tags {
+				if !t.LastPulledAt.IsZero() {
+					continue
+				}
+				ref := img.Name + ":" + t.Name
+				findings = append(findings, Finding{
+					Type: TypeNCRTag, ID: registryID + "/" + ref, Name: r.Name + "/" + ref, CreatedAt: t.CreatedAt,
+					Reason: fmt.Sprintf("never pulled (%d bytes)", t.Size),
+				})
+			}
+		}
+	}
+	return findings, nil
+}
+
+func deleteTag(ctx context.Context, c *nhncloud.Client, f Finding) error {
+	registryID, ref, ok := strings.Cut(f.ID, "/")
+	i := strings.LastIndex(ref, ":")
+	if !ok || i < 0 {
+		return fmt.Errorf("orphan: malformed tag ID %q", f.ID)
+	}
+	return c.NCR().DeleteTag(ctx, registryID, ref[:i], ref[i+1:])
+}
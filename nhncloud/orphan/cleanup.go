@@ -0,0 +1,119 @@
+package orphan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+)
+
+// Result is what Cleanup did with a finding.
+type Result string
+
+const (
+	// WouldDelete is the result of every finding in a dry run.
+	WouldDelete Result = "would-delete"
+	Deleted     Result = "deleted"
+	// Skipped means the resource is no longer orphaned, or is gone.
+	Skipped Result = "skipped"
+	Failed  Result = "failed"
+)
+
+// Outcome is the result of cleaning up one finding.
+type Outcome struct {
+	Finding Finding `json:"finding"`
+	Result  Result  `json:"result"`
+	Error   string  `json:"error,omitempty"`
+}
+
+// CleanupOptions configures Cleanup. The zero value is a dry run.
+type CleanupOptions struct {
+	// Execute deletes the resources. Without it Cleanup only reports what
+	// it would delete and makes no API calls.
+	Execute bool
+	// Checks that delete the findings, matched by Kind. Nil means Checks().
+	Checks []Check
+}
+
+// Cleanup deletes the findings, typically a Report's after a person has
+// reviewed them. Before deleting, it runs each finding's check again in the
+// finding's region and skips any resource the check no longer reports, so a
+// report that has gone stale does not delete resources that are in use
+// again. Outcomes are in the order of findings. If any deletion fails,
+// Cleanup returns a *PartialError as well as the outcomes.
+func Cleanup(ctx context.Context, m *nhncloud.MultiRegion, findings []Finding, opts CleanupOptions) ([]Outcome, error) {
+	checks := opts.Checks
+	if checks == nil {
+		checks = Checks()
+	}
+	byKind := make(map[Kind]Check, len(checks))
+	for _, chk := range checks {
+		byKind[chk.Kind] = chk
+	}
+
+	outcomes := make([]Outcome, len(findings))
+	var failures []Failure
+	fail := func(i int, err error) {
+		f := findings[i]
+		outcomes[i] = Outcome{Finding: f, Result: Failed, Error: err.Error()}
+		failures = append(failures, failure(f.Kind, f.Region, err))
+	}
+
+	// current caches the IDs each check reports now, per kind and region,
+	// and recheckErr the checks that failed.
+	type scope struct {
+		kind   Kind
+		region string
+	}
+	current := make(map[scope]map[string]bool)
+	recheckErr := make(map[scope]error)
+	for i, f := range findings {
+		chk, ok := byKind[f.Kind]
+		if !ok || chk.Delete == nil {
+			fail(i, fmt.Errorf("no check deletes %s", f.Kind))
+			continue
+		}
+		c := m.Client(f.Region)
+		if c == nil {
+			fail(i, fmt.Errorf("region %q is not configured", f.Region))
+			continue
+		}
+		if !opts.Execute {
+			outcomes[i] = Outcome{Finding: f, Result: WouldDelete}
+			continue
+		}
+
+		s := scope{f.Kind, f.Region}
+		ids, ok := current[s]
+		if !ok && recheckErr[s] == nil {
+			found, err := chk.Find(ctx, c)
+			if err != nil {
+				recheckErr[s] = fmt.Errorf("check again: %w", err)
+			} else {
+				ids = make(map[string]bool, len(found))
+				for _, g := range found {
+					ids[g.ID] = true
+				}
+				current[s] = ids
+			}
+		}
+		if err := recheckErr[s]; err != nil {
+			fail(i, err)
+			continue
+		}
+		if !ids[f.ID] {
+			outcomes[i] = Outcome{Finding: f, Result: Skipped}
+			continue
+		}
+		if err := chk.Delete(ctx, c, f); err != nil {
+			fail(i, err)
+			continue
+		}
+		outcomes[i] = Outcome{Finding: f, Result: Deleted}
+	}
+
+	if len(failures) > 0 {
+		return outcomes, &PartialError{Failures: failures}
+	}
+	return outcomes, nil
+}
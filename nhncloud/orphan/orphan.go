@@ -0,0 +1,223 @@
+// Package orphan finds resources that are billed but serve nothing: floating
+// IPs bound to no port, volumes attached to no server, snapshots of deleted
+// volumes, security groups no port uses, load balancers with no members,
+// empty object storage containers and NCR tags that were never pulled.
+//
+// Find runs every Check over the regions where its service is available and
+// returns a Report with the reason each resource was flagged. A check that
+// fails is recorded in Report.Failures and does not stop the others.
+//
+//	mr, _ := nhncloud.NewMultiRegion(cfg, "kr1", "kr2")
+//	report, err := orphan.Find(ctx, mr, orphan.Options{MinAge: 7 * 24 * time.Hour})
+//	if err != nil {
+//	    log.Printf("partial report: %v", err)
+//	}
+//	report.WriteText(os.Stdout)
+//
+// Cleanup deletes the findings. It is a dry run unless CleanupOptions.Execute
+// is set, and it checks each resource again before deleting it, so a floating
+// IP attached since the report was taken is left alone:
+//
+//	outcomes, err := orphan.Cleanup(ctx, mr, report.Findings, orphan.CleanupOptions{Execute: true})
+package orphan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/fanout"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
+// DefaultConcurrency is the number of checks Find runs at once when
+// Options.Concurrency is zero.
+const DefaultConcurrency = 4
+
+// Kind is the kind of waste a finding reports.
+type Kind string
+
+const (
+	UnattachedFloatingIP Kind = "unattached-floating-ip"
+	AvailableVolume      Kind = "available-volume"
+	OrphanedSnapshot     Kind = "orphaned-snapshot"
+	UnusedSecurityGroup  Kind = "unused-security-group"
+	IdleLoadBalancer     Kind = "idle-load-balancer"
+	EmptyContainer       Kind = "empty-container"
+	UnpulledTag          Kind = "unpulled-tag"
+)
+
+// Finding is one resource that looks orphaned.
+type Finding struct {
+	Kind Kind `json:"kind"`
+	// Type is the inventory resource type, such as inventory.TypeFloatingIP.
+	Type   string `json:"type"`
+	ID     string `json:"id"`
+	Name   string `json:"name,omitempty"`
+	Region string `json:"region"`
+	// CreatedAt is zero when the API does not return a creation time.
+	CreatedAt timestamp.Time `json:"createdAt"`
+	// Reason says why the resource was flagged, e.g. "not attached to any
+	// port".
+	Reason string `json:"reason"`
+}
+
+// Failure is a check that failed in one region.
+type Failure struct {
+	Check  Kind   `json:"check"`
+	Region string `json:"region"`
+	Error  string `json:"error"`
+
+	err error
+}
+
+// Unwrap returns the check's error. It is nil for a Failure read back from
+// JSON.
+func (f *Failure) Unwrap() error { return f.err }
+
+// Report lists the findings of Find, ordered by region, kind and ID.
+type Report struct {
+	Findings []Finding `json:"findings"`
+	Failures []Failure `json:"failures,omitempty"`
+}
+
+// PartialError reports the checks that failed during Find, or the deletions
+// that failed during Cleanup.
+type PartialError struct {
+	Failures []Failure
+}
+
+func (e *PartialError) Error() string {
+	msgs := make([]string, len(e.Failures))
+	for i, f := range e.Failures {
+		msgs[i] = fmt.Sprintf("%s in %s: %s", f.Check, f.Region, f.Error)
+	}
+	return "orphan: " + strings.Join(msgs, "; ")
+}
+
+func (e *PartialError) Unwrap() []error {
+	var errs []error
+	for _, f := range e.Failures {
+		if f.err != nil {
+			errs = append(errs, f.err)
+		}
+	}
+	return errs
+}
+
+// Options configures Find.
+type Options struct {
+	// Checks to run. Nil means Checks().
+	Checks []Check
+	// Concurrency bounds the checks in flight. Zero means
+	// DefaultConcurrency.
+	Concurrency int
+	// MinAge leaves out resources created less than MinAge ago, such as a
+	// volume that is about to be attached. Resources without a creation
+	// time, such as containers, are left out too, since their age is
+	// unknown, unless IncludeUndated is set.
+	MinAge time.Duration
+	// IncludeUndated reports resources without a creation time even when
+	// MinAge is set.
+	IncludeUndated bool
+}
+
+// oldEnough reports whether f passes MinAge.
+func (o *Options) oldEnough(f Finding, now time.Time) bool {
+	if o.MinAge <= 0 {
+		return true
+	}
+	if f.CreatedAt.IsZero() {
+		return o.IncludeUndated
+	}
+	return now.Sub(f.CreatedAt.Time) >= o.MinAge
+}
+
+// Find runs the checks over every region of m where their service is
+// available. If any check fails, Find still returns the findings of the
+// others, with a *PartialError; the failures are also recorded in
+// Report.Failures.
+func Find(ctx context.Context, m *nhncloud.MultiRegion, opts Options) (*Report, error) {
+	checks := opts.Checks
+	if checks == nil {
+		checks = Checks()
+	}
+	limit := opts.Concurrency
+	if limit <= 0 {
+		limit = DefaultConcurrency
+	}
+
+	jobs := fanout.Jobs(m, checks, func(c Check) string { return c.Service })
+	results := fanout.Run(ctx, jobs, limit, func(ctx context.Context, j fanout.Job[Check]) ([]Finding, error) {
+		return j.Item.Find(ctx, m.Client(j.Region))
+	})
+
+	now := time.Now()
+	report := &Report{Findings: []Finding{}}
+	for i, res := range results {
+		j := jobs[i]
+		if res.Err != nil {
+			report.Failures = append(report.Failures, failure(j.Item.Kind, j.Region, res.Err))
+			continue
+		}
+		for _, f := range res.Value {
+			if !opts.oldEnough(f, now) {
+				continue
+			}
+			f.Kind = j.Item.Kind
+			f.Region = j.Region
+			report.Findings = append(report.Findings, f)
+		}
+	}
+
+	report.sort()
+	if len(report.Failures) > 0 {
+		return report, &PartialError{Failures: report.Failures}
+	}
+	return report, nil
+}
+
+func failure(kind Kind, region string, err error) Failure {
+	return Failure{Check: kind, Region: region, Error: err.Error(), err: err}
+}
+
+func (r *Report) sort() {
+	fanout.Sort(r.Findings, func(f *Finding) []string { return []string{f.Region, string(f.Kind), f.ID} })
+	fanout.Sort(r.Failures, func(f *Failure) []string { return []string{f.Region, string(f.Check)} })
+}
+
+// Count returns the number of findings of kind.
+func (r *Report) Count(kind Kind) int {
+	n := 0
+	for _, f := range r.Findings {
+		if f.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteText writes the report for people: one line per finding with its
+// reason, the failed checks, and a count of findings at the end.
+func (r *Report) WriteText(w io.Writer) error {
+	return fanout.WriteText(w, func(b *strings.Builder) {
+		for _, f := range r.Findings {
+			fmt.Fprintf(b, "%s %s %s %s: %s\n", f.Region, f.Kind, f.Type, fanout.Label(f.ID, f.Name), f.Reason)
+		}
+		for _, f := range r.Failures {
+			fmt.Fprintf(b, "%s %s failed: %s\n", f.Region, f.Check, f.Error)
+		}
+		fmt.Fprintf(b, "%d findings\n", len(r.Findings))
+	})
+}
@@ -0,0 +1,123 @@
+package orphan
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/port"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/securitygroup"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/storage/block"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
+func newMultiRegion(t *testing.T, regions ...string) *nhncloud.MultiRegion {
+	t.Helper()
+	m, err := nhncloud.NewMultiRegion(&nhncloud.Config{Credentials: credentials.NewStatic("a", "b")}, regions...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return m
+}
+
+func TestFindAndCleanup(t *testing.T) {
+	m := newMultiRegion(t, "kr1")
+	// ip2 is attached between the report and the cleanup.
+	orphaned := map[string]bool{"ip1": true, "ip2": true, "ip3": true}
+	old := timestamp.New(time.Now().Add(-48 * time.Hour))
+	var deleted []string
+	ips := Check{
+		Kind: UnattachedFloatingIP, Service: "network",
+		Find: func(ctx context.Context, c *nhncloud.Client) ([]Finding, error) {
+			var fs []Finding
+			for _, id := range []string{"ip3", "ip1", "ip2"} {
+				if orphaned[id] {
+					fs = append(fs, Finding{Type: "network.floating-ip", ID: id, CreatedAt: old, Reason: "not associated with any port"})
+				}
+			}
+			fs = append(fs, Finding{ID: "new", CreatedAt: timestamp.New(time.Now())}, Finding{ID: "undated"})
+			return fs, nil
+		},
+		Delete: func(ctx context.Context, c *nhncloud.Client, f Finding) error {
+			if f.ID == "ip3" {
+				return errors.New("conflict")
+			}
+			deleted = append(deleted, f.ID)
+			return nil
+		},
+	}
+	down := errors.New("service unavailable")
+	volumes := Check{Kind: AvailableVolume, Service: "block-storage",
+		Find: func(ctx context.Context, c *nhncloud.Client) ([]Finding, error) { return nil, down }}
+
+	report, err := Find(context.Background(), m, Options{Checks: []Check{ips, volumes}, MinAge: time.Hour})
+	if !errors.Is(err, down) || len(report.Failures) != 1 {
+		t.Fatalf("expected the volume check failure, got %v", err)
+	}
+	var ids []string
+	for _, f := range report.Findings {
+		ids = append(ids, f.ID)
+		if f.Kind != UnattachedFloatingIP || f.Region != "kr1" {
+			t.Errorf("finding = %+v", f)
+		}
+	}
+	// "new" is younger than MinAge, and the age of "undated" is unknown.
+	if want := []string{"ip1", "ip2", "ip3"}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("findings = %v, want %v", ids, want)
+	}
+	withUndated, _ := Find(context.Background(), m, Options{Checks: []Check{ips}, MinAge: time.Hour, IncludeUndated: true})
+	if len(withUndated.Findings) != 4 || withUndated.Findings[3].ID != "undated" {
+		t.Errorf("IncludeUndated findings = %+v", withUndated.Findings)
+	}
+	var text bytes.Buffer
+	report.WriteText(&text)
+	if want := "kr1 unattached-floating-ip network.floating-ip ip1: not associated with any port\n"; !bytes.HasPrefix(text.Bytes(), []byte(want)) {
+		t.Errorf("text = %q", text.String())
+	}
+
+	outcomes, err := Cleanup(context.Background(), m, report.Findings, CleanupOptions{Checks: []Check{ips}})
+	if err != nil || len(deleted) != 0 {
+		t.Fatalf("dry run: err = %v, deleted = %v", err, deleted)
+	}
+	for _, o := range outcomes {
+		if o.Result != WouldDelete {
+			t.Errorf("dry run outcome = %+v", o)
+		}
+	}
+
+	orphaned["ip2"] = false
+	outcomes, err = Cleanup(context.Background(), m, report.Findings, CleanupOptions{Execute: true, Checks: []Check{ips}})
+	var pe *PartialError
+	if !errors.As(err, &pe) || len(pe.Failures) != 1 {
+		t.Fatalf("expected the ip3 failure, got %v", err)
+	}
+	var results []Result
+	for _, o := range outcomes {
+		results = append(results, o.Result)
+	}
+	if want := []Result{Deleted, Skipped, Failed}; !reflect.DeepEqual(results, want) {
+		t.Errorf("results = %v, want %v", results, want)
+	}
+	if !reflect.DeepEqual(deleted, []string{"ip1"}) {
+		t.Errorf("deleted = %v", deleted)
+	}
+}
+
+func TestUnboundSecurityGroupsAndOrphanedSnapshots(t *testing.T) {
+	groups := []securitygroup.SecurityGroup{{ID: "sg1", Name: "default"}, {ID: "sg2", Name: "web"}, {ID: "sg3", Name: "old"}}
+	ports := []port.Port{{ID: "p1", SecurityGroups: []string{"sg2"}}}
+	if got := unboundSecurityGroups(groups, ports); len(got) != 1 || got[0].ID != "sg3" {
+		t.Errorf("unbound groups = %+v", got)
+	}
+
+	snaps := []block.Snapshot{{ID: "snap1", VolumeID: "v1"}, {ID: "snap2", VolumeID: "gone"}}
+	vols := []block.Volume{{ID: "v1"}}
+	if got := orphanedSnapshots(snaps, vols); len(got) != 1 || got[0].ID != "snap2" || got[0].Reason != "source volume gone no longer exists" {
+		t.Errorf("orphaned snapshots = %+v", got)
+	}
+}
@@ -0,0 +1,266 @@
+package nhncloud
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
+)
+
+// Environment variables read by LoadProfile. Per-service app keys and
+// tenants follow the same pattern: NHN_CLOUD_MYSQL_APPKEY,
+// NHN_CLOUD_NKS_TENANT_ID, ...
+const (
+	EnvConfigFile      = "NHN_CLOUD_CONFIG_FILE"
+	EnvProfile         = "NHN_CLOUD_PROFILE"
+	EnvRegion          = "NHN_CLOUD_REGION"
+	EnvAccessKeyID     = "NHN_CLOUD_ACCESS_KEY_ID"
+	EnvSecretAccessKey = "NHN_CLOUD_SECRET_ACCESS_KEY"
+	EnvUsername        = "NHN_CLOUD_USERNAME"
+	EnvPassword        = "NHN_CLOUD_PASSWORD"
+	EnvTenantID        = "NHN_CLOUD_TENANT_ID"
+	EnvAppKey          = "NHN_CLOUD_APPKEY"
+)
+
+// DefaultProfile is the profile LoadProfile reads when none is named.
+const DefaultProfile = "default"
+
+// serviceAliases maps the service names used in config file keys and
+// environment variables to Config.AppKeys keys.
+var serviceAliases = map[string]string{
+	"mysql":      "rds-mysql",
+	"mariadb":    "rds-mariadb",
+	"postgresql": "rds-postgresql",
+	"postgres":   "rds-postgresql",
+	"obs":        "object-storage",
+}
+
+// Profile is a named set of settings from the shared config file
+// (~/.nhncloud/credentials), with environment variables applied on top. See
+// docs/CONFIGURATION.md for the file format.
+type Profile struct {
+	Name   string
+	Region string
+
+	AccessKeyID     string
+	SecretAccessKey secret.String
+
+	Username    string
+	APIPassword secret.String
+	TenantID    string
+
+	// AppKey is the default app key for services without their own.
+	AppKey string
+	// AppKeys are per-service app keys, keyed like Config.AppKeys.
+	AppKeys map[string]string
+	// TenantIDs are per-service tenants for services that live in another
+	// project, keyed like Config.AppKeys ("nks", "object-storage").
+	TenantIDs map[string]string
+}
+
+// LoadProfile reads profile name from the shared config file and applies
+// the NHN_CLOUD_* environment variables over it. An empty name selects
+// NHN_CLOUD_PROFILE, or "default". The file is optional unless a profile
+// other than the default is requested; its path is NHN_CLOUD_CONFIG_FILE,
+// or ~/.nhncloud/credentials.
+func LoadProfile(name string) (*Profile, error) {
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+	if name == "" {
+		name = DefaultProfile
+	}
+	p := &Profile{Name: name, AppKeys: map[string]string{}, TenantIDs: map[string]string{}}
+
+	path := os.Getenv(EnvConfigFile)
+	if path == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, ".nhncloud", "credentials")
+		}
+	}
+	sections, err := readINI(path)
+	switch {
+	case os.IsNotExist(err):
+		if name != DefaultProfile {
+			return nil, fmt.Errorf("nhncloud: profile %q: %s does not exist", name, path)
+		}
+	case err != nil:
+		return nil, fmt.Errorf("nhncloud: read %s: %w", path, err)
+	default:
+		section, ok := sections[name]
+		if !ok && name != DefaultProfile {
+			return nil, fmt.Errorf("nhncloud: profile %q not found in %s", name, path)
+		}
+		for key, value := range section {
+			p.set(key, value)
+		}
+	}
+
+	p.applyEnv(os.Environ())
+	return p, nil
+}
+
+// set applies one config file key.
+func (p *Profile) set(key, value string) {
+	switch key {
+	case "region":
+		p.Region = value
+	case "access_key_id", "access_key":
+		p.AccessKeyID = value
+	case "secret_access_key", "secret_key":
+		p.SecretAccessKey = secret.String(value)
+	case "username":
+		p.Username = value
+	case "api_password", "password":
+		p.APIPassword = secret.String(value)
+	case "tenant_id":
+		p.TenantID = value
+	case "appkey", "app_key":
+		p.AppKey = value
+	default:
+		if service, ok := cutSuffixes(key, "_appkey", "_app_key"); ok {
+			p.AppKeys[serviceName(service)] = value
+		} else if service, ok := cutSuffixes(key, "_tenant_id"); ok {
+			p.TenantIDs[serviceName(service)] = value
+		}
+	}
+}
+
+// applyEnv applies NHN_CLOUD_* variables, falling back to the NHNCLOUD_*
+// names of the credentials package for the credentials themselves.
+func (p *Profile) applyEnv(environ []string) {
+	env := make(map[string]string, len(environ))
+	for _, kv := range environ {
+		if k, v, ok := strings.Cut(kv, "="); ok && v != "" {
+			env[k] = v
+		}
+	}
+	first := func(keys ...string) string {
+		for _, k := range keys {
+			if v := env[k]; v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+	for _, f := range []struct {
+		dst  *string
+		keys []string
+	}{
+		{&p.Region, []string{EnvRegion}},
+		{&p.AccessKeyID, []string{EnvAccessKeyID, credentials.EnvAccessKeyID}},
+		{(*string)(&p.SecretAccessKey), []string{EnvSecretAccessKey, credentials.EnvSecretAccessKey}},
+		{&p.Username, []string{EnvUsername, credentials.EnvUsername}},
+		{(*string)(&p.APIPassword), []string{EnvPassword, credentials.EnvPassword}},
+		{&p.TenantID, []string{EnvTenantID, credentials.EnvTenantID}},
+		{&p.AppKey, []string{EnvAppKey}},
+	} {
+		if v := first(f.keys...); v != "" {
+			*f.dst = v
+		}
+	}
+	for k, v := range env {
+		rest, ok := strings.CutPrefix(k, "NHN_CLOUD_")
+		if !ok {
+			continue
+		}
+		rest = strings.ToLower(rest)
+		if service, ok := cutSuffixes(rest, "_appkey", "_app_key"); ok {
+			p.AppKeys[serviceName(service)] = v
+		} else if service, ok := cutSuffixes(rest, "_tenant_id"); ok {
+			p.TenantIDs[serviceName(service)] = v
+		}
+	}
+}
+
+// Config returns a Config for the profile. The default app key fills every
+// app key service without its own.
+func (p *Profile) Config() *Config {
+	cfg := &Config{
+		Region:  p.Region,
+		AppKeys: make(map[string]string, len(appKeyServices)),
+	}
+	if p.AccessKeyID != "" || p.SecretAccessKey != "" {
+		cfg.Credentials = credentials.NewStatic(p.AccessKeyID, p.SecretAccessKey.Reveal())
+	}
+	if p.Username != "" || p.APIPassword != "" || p.TenantID != "" {
+		cfg.IdentityCredentials = credentials.NewStaticIdentity(p.Username, p.APIPassword.Reveal(), p.TenantID)
+	}
+	if p.AppKey != "" {
+		for _, service := range appKeyServices {
+			cfg.AppKeys[service] = p.AppKey
+		}
+	}
+	for service, key := range p.AppKeys {
+		cfg.AppKeys[service] = key
+	}
+	return cfg
+}
+
+// ConfigFor is like Config but uses the profile's tenant override for
+// service, if any, in IdentityCredentials.
+func (p *Profile) ConfigFor(service string) *Config {
+	cfg := p.Config()
+	if tenant := p.TenantIDs[service]; tenant != "" {
+		cfg.IdentityCredentials = credentials.NewStaticIdentity(p.Username, p.APIPassword.Reveal(), tenant)
+	}
+	return cfg
+}
+
+func serviceName(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "_", "-")
+	if alias, ok := serviceAliases[name]; ok {
+		return alias
+	}
+	return name
+}
+
+func cutSuffixes(s string, suffixes ...string) (string, bool) {
+	for _, suffix := range suffixes {
+		if rest, ok := strings.CutSuffix(s, suffix); ok && rest != "" {
+			return rest, true
+		}
+	}
+	return "", false
+}
+
+// readINI parses an INI file into sections of lower-cased keys. Lines
+// starting with # or ; are comments.
+func readINI(path string) (map[string]map[string]string, error) {
+	if path == "" {
+		return nil, os.ErrNotExist
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sections := make(map[string]map[string]string)
+	var current map[string]string
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || line[0] == '#' || line[0] == ';':
+		case line[0] == '[' && line[len(line)-1] == ']':
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			name = strings.TrimSpace(strings.TrimPrefix(name, "profile "))
+			if sections[name] == nil {
+				sections[name] = make(map[string]string)
+			}
+			current = sections[name]
+		default:
+			key, value, ok := strings.Cut(line, "=")
+			if !ok || current == nil {
+				return nil, fmt.Errorf("line %d: expected [profile] or key = value", n)
+			}
+			current[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+		}
+	}
+	return sections, scanner.Err()
+}
@@ -0,0 +1,71 @@
+package nhncloud
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	err := os.WriteFile(path, []byte(`
+# shared settings
+[default]
+region = kr1
+username = api-user@example.com
+api_password = default-password
+tenant_id = default-tenant
+appkey = project-appkey
+
+[profile prod]
+region = kr2
+access_key_id = prod-key
+secret_access_key = prod-secret
+mysql_appkey = prod-mysql
+nks_tenant_id = prod-nks-tenant
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(EnvConfigFile, path)
+	t.Setenv(EnvProfile, "")
+	for _, k := range []string{EnvRegion, EnvAccessKeyID, EnvSecretAccessKey, "NHNCLOUD_ACCESS_KEY_ID", "NHNCLOUD_SECRET_ACCESS_KEY"} {
+		t.Setenv(k, "")
+	}
+	t.Setenv("NHN_CLOUD_POSTGRESQL_APPKEY", "env-pg")
+
+	p, err := LoadProfile("prod")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.Region != "kr2" || p.AccessKeyID != "prod-key" || p.AppKeys["rds-mysql"] != "prod-mysql" {
+		t.Errorf("profile = %+v", p)
+	}
+	if p.AppKeys["rds-postgresql"] != "env-pg" {
+		t.Errorf("environment app key not applied: %v", p.AppKeys)
+	}
+
+	cfg := p.ConfigFor("nks")
+	if cfg.Credentials == nil || cfg.Credentials.GetAccessKeyID() != "prod-key" {
+		t.Error("Credentials not set from the profile")
+	}
+	if got := cfg.IdentityCredentials.GetTenantID(); got != "prod-nks-tenant" {
+		t.Errorf("nks tenant = %q", got)
+	}
+
+	t.Setenv(EnvRegion, "jp1")
+	def, err := LoadProfile("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if def.Region != "jp1" {
+		t.Errorf("region = %q, want the environment's jp1", def.Region)
+	}
+	if cfg := def.Config(); cfg.AppKeys["dnsplus"] != "project-appkey" || cfg.Credentials != nil {
+		t.Errorf("default config = %+v", cfg)
+	}
+
+	if _, err := LoadProfile("missing"); err == nil {
+		t.Error("expected error for an unknown profile")
+	}
+}
@@ -0,0 +1,286 @@
+package nhncloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cache"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/circuitbreaker"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/iam"
+)
+
+// appKeyServices are the Config.AppKeys keys of services that authenticate
+// with an app key.
+var appKeyServices = []string{
+	"rds-mysql", "rds-mariadb", "rds-postgresql",
+	"ncr", "ncs",
+	"dnsplus", "keymanager", "certmanager", "cloudtrail", "resourcewatcher", "apigw",
+}
+
+// Project is the per-project part of a Config: what differs between the
+// projects of an organization.
+type Project struct {
+	ID   string
+	Name string
+
+	// AppKeys are merged over the registry's base AppKeys. Services left
+	// without a key get the project's integrated app key from IAM, when the
+	// registry has org-level credentials.
+	AppKeys map[string]string
+	// AppKeyName selects the integrated app key by name when the project
+	// has more than one. Without it, such a project fails in Client rather
+	// than getting an arbitrary key.
+	AppKeyName string
+
+	// IdentityCredentials is the project's API user. If nil and TenantID is
+	// set, the base Config's username and API password are used with
+	// TenantID.
+	IdentityCredentials credentials.IdentityCredentials
+	TenantID            string
+
+	// Credentials overrides the base Config's User Access Key, if set.
+	Credentials credentials.Credentials
+}
+
+// ProjectRegistry builds one Client per NHN Cloud project from a shared base
+// Config and caches it. Projects are registered explicitly with Register or
+// discovered from an organization with Discover:
+//
+//	reg, _ := nhncloud.NewProjectRegistry(&nhncloud.Config{
+//	    Region:      "kr1",
+//	    Credentials: orgCreds,
+//	})
+//	reg.Discover(ctx, orgID)
+//	for _, id := range reg.Projects() {
+//	    c, err := reg.Client(ctx, id)
+//	    ...
+//	}
+//
+// Settings of the base Config other than AppKeys and credentials (region,
+// HTTP client, drift) are shared by every project. Each project gets its own
+// circuit breaker and cache, configured like the base Config's, so that one
+// project's failures and cached catalogs stay out of the others.
+type ProjectRegistry struct {
+	base *Config
+
+	mu          sync.Mutex
+	projects    map[string]*projectEntry
+	iam         *iam.Client
+	noAppKeyIAM bool
+}
+
+// projectEntry is a registration and its Client, once built. Register
+// replaces the entry, which drops the Client.
+type projectEntry struct {
+	project Project
+	client  *Client
+}
+
+// NewProjectRegistry returns an empty registry over base. base.Credentials,
+// the org-level User Access Key, is used for IAM lookups and by every
+// project that does not override it.
+func NewProjectRegistry(base *Config) (*ProjectRegistry, error) {
+	if base == nil {
+		return nil, ErrCredentialsRequired
+	}
+	if err := base.validate(); err != nil {
+		return nil, err
+	}
+	return &ProjectRegistry{
+		base:     base,
+		projects: make(map[string]*projectEntry),
+	}, nil
+}
+
+// Register adds p, replacing any project with the same ID and dropping its
+// cached Client.
+func (r *ProjectRegistry) Register(p Project) error {
+	if p.ID == "" {
+		return fmt.Errorf("nhncloud: project ID is required")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.projects[p.ID] = &projectEntry{project: p}
+	return nil
+}
+
+// Discover registers every project of the organization orgID that is not
+// registered yet, using the base credentials, and returns the IDs it added.
+func (r *ProjectRegistry) Discover(ctx context.Context, orgID string) ([]string, error) {
+	out, err := r.iamClient().ListProjects(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("discover projects of %s: %w", orgID, err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var added []string
+	for _, p := range out.Projects {
+		if _, ok := r.projects[p.ID]; ok {
+			continue
+		}
+		r.projects[p.ID] = &projectEntry{project: Project{ID: p.ID, Name: p.Name}}
+		added = append(added, p.ID)
+	}
+	return added, nil
+}
+
+// Projects returns the registered project IDs in sorted order.
+func (r *ProjectRegistry) Projects() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ids := make([]string, 0, len(r.projects))
+	for id := range r.projects {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Project returns the registration of projectID.
+func (r *ProjectRegistry) Project(projectID string) (Project, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.projects[projectID]
+	if !ok {
+		return Project{}, false
+	}
+	return e.project, true
+}
+
+// SetAppKeyLookup turns the IAM app key lookup in Client on or off. It is
+// on by default; turn it off when the base credentials cannot read project
+// app keys and every project's AppKeys are registered by hand.
+func (r *ProjectRegistry) SetAppKeyLookup(enabled bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.noAppKeyIAM = !enabled
+}
+
+// Client returns the Client for projectID, building it on first use. If any
+// app key service is left without a key, the project's integrated app key
+// is looked up with iam.ListProjectAppKeys: the only one, or the one named
+// Project.AppKeyName. A failed lookup fails the call and is retried on the
+// next one.
+func (r *ProjectRegistry) Client(ctx context.Context, projectID string) (*Client, error) {
+	r.mu.Lock()
+	e, ok := r.projects[projectID]
+	var c *Client
+	if ok {
+		c = e.client
+	}
+	lookup := !r.noAppKeyIAM
+	r.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("nhncloud: project %s is not registered", projectID)
+	}
+	if c != nil {
+		return c, nil
+	}
+
+	cfg := r.projectConfig(e.project)
+	if lookup && missingAppKeys(cfg.AppKeys) {
+		out, err := r.iamClient().ListProjectAppKeys(ctx, projectID)
+		if err != nil {
+			return nil, fmt.Errorf("app keys of project %s: %w", projectID, err)
+		}
+		key, err := integratedAppKey(out.ProjectAppKeys, e.project.AppKeyName)
+		if err != nil {
+			return nil, fmt.Errorf("app keys of project %s: %w", projectID, err)
+		}
+		if key != "" {
+			for _, service := range appKeyServices {
+				if cfg.AppKeys[service] == "" {
+					cfg.AppKeys[service] = key
+				}
+			}
+		}
+	}
+
+	c, err := New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("project %s: %w", projectID, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// Another caller may have built one meanwhile.
+	if e.client != nil {
+		return e.client, nil
+	}
+	e.client = c
+	return c, nil
+}
+
+func (r *ProjectRegistry) projectConfig(p Project) *Config {
+	cfg := *r.base
+	cfg.AppKeys = make(map[string]string, len(r.base.AppKeys)+len(p.AppKeys))
+	for k, v := range r.base.AppKeys {
+		cfg.AppKeys[k] = v
+	}
+	for k, v := range p.AppKeys {
+		cfg.AppKeys[k] = v
+	}
+	if r.base.CircuitBreaker != nil {
+		cfg.CircuitBreaker = circuitbreaker.New(r.base.CircuitBreaker.Config())
+	}
+	if r.base.Cache != nil {
+		cfg.Cache = cache.New(r.base.Cache.Config())
+	}
+	if p.Credentials != nil {
+		cfg.Credentials = p.Credentials
+	}
+	switch {
+	case p.IdentityCredentials != nil:
+		cfg.IdentityCredentials = p.IdentityCredentials
+	case p.TenantID != "" && r.base.IdentityCredentials != nil:
+		base := r.base.IdentityCredentials
+		cfg.IdentityCredentials = credentials.NewStaticIdentity(base.GetUsername(), base.GetPassword(), p.TenantID)
+	}
+	return &cfg
+}
+
+func (r *ProjectRegistry) iamClient() *iam.Client {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.iam == nil {
+		r.iam = iam.NewClient(r.base.Region, r.base.Credentials, r.base.httpClient(), r.base.Debug, r.base.options()...)
+	}
+	return r.iam
+}
+
+// integratedAppKey picks the app key named name from keys, or the only key
+// when name is empty. It returns "" when the project has no app key.
+func integratedAppKey(keys []iam.ProjectAppKey, name string) (string, error) {
+	if name != "" {
+		for _, k := range keys {
+			if k.AppKeyName == name {
+				return k.AppKey, nil
+			}
+		}
+		return "", fmt.Errorf("no app key named %q", name)
+	}
+	switch len(keys) {
+	case 0:
+		return "", nil
+	case 1:
+		return keys[0].AppKey, nil
+	}
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		names[i] = k.AppKeyName
+	}
+	return "", fmt.Errorf("%d app keys (%s); set Project.AppKeyName to pick one", len(keys), strings.Join(names, ", "))
+}
+
+func missingAppKeys(appKeys map[string]string) bool {
+	for _, service := range appKeyServices {
+		if appKeys[service] == "" {
+			return true
+		}
+	}
+	return false
+}
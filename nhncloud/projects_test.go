@@ -0,0 +1,131 @@
+package nhncloud
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/cache"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/circuitbreaker"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/cloudtest"
+)
+
+func TestProjectRegistryBuildsPerProjectClients(t *testing.T) {
+	reg, err := NewProjectRegistry(&Config{
+		Region:              "kr1",
+		Credentials:         credentials.NewStatic("org-key", "org-secret"),
+		IdentityCredentials: credentials.NewStaticIdentity("api-user", "api-password", "base-tenant"),
+		AppKeys:             map[string]string{"dnsplus": "shared-dns"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	reg.SetAppKeyLookup(false)
+
+	if err := reg.Register(Project{ID: "p1", TenantID: "tenant-1", AppKeys: map[string]string{"rds-mysql": "mysql-1"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := reg.Register(Project{ID: "p2", TenantID: "tenant-2"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := reg.Projects(); len(got) != 2 || got[0] != "p1" || got[1] != "p2" {
+		t.Fatalf("Projects() = %v", got)
+	}
+
+	ctx := context.Background()
+	c1, err := reg.Client(ctx, "p1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if again, _ := reg.Client(ctx, "p1"); again != c1 {
+		t.Error("Client is not cached")
+	}
+	if got := c1.config.IdentityCredentials.GetTenantID(); got != "tenant-1" {
+		t.Errorf("tenant = %q, want tenant-1", got)
+	}
+	if got := c1.config.IdentityCredentials.GetUsername(); got != "api-user" {
+		t.Errorf("username = %q, want the base API user", got)
+	}
+	if c1.config.AppKeys["rds-mysql"] != "mysql-1" || c1.config.AppKeys["dnsplus"] != "shared-dns" {
+		t.Errorf("AppKeys = %v", c1.config.AppKeys)
+	}
+
+	c2, err := reg.Client(ctx, "p2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := c2.config.AppKeys["rds-mysql"]; ok {
+		t.Error("project app keys leaked into another project")
+	}
+
+	if err := reg.Register(Project{ID: "p1", TenantID: "tenant-1b"}); err != nil {
+		t.Fatal(err)
+	}
+	if c, _ := reg.Client(ctx, "p1"); c == c1 || c.config.IdentityCredentials.GetTenantID() != "tenant-1b" {
+		t.Error("Register did not replace the cached Client")
+	}
+
+	if _, err := reg.Client(ctx, "unknown"); err == nil {
+		t.Error("expected error for an unregistered project")
+	}
+}
+
+func TestProjectRegistryIntegratedAppKey(t *testing.T) {
+	srv := cloudtest.New(t)
+	srv.HandleJSON("core.api.nhncloudservice.com/v1/authentications/projects/multi/project-appkeys", `{
+		"header": {"isSuccessful": true},
+		"projectAppKeyList": [{"appKey": "key-a", "appKeyName": "legacy"}, {"appKey": "key-b", "appKeyName": "integrated"}]
+	}`)
+	srv.HandleJSON("core.api.nhncloudservice.com/v1/authentications/projects/single/project-appkeys", `{
+		"header": {"isSuccessful": true},
+		"projectAppKeyList": [{"appKey": "key-s", "appKeyName": "integrated"}]
+	}`)
+	base := &Config{
+		Region:         "kr1",
+		Credentials:    credentials.NewStatic("org-key", "org-secret"),
+		HTTPClient:     srv.Client(),
+		CircuitBreaker: circuitbreaker.New(circuitbreaker.Config{FailureThreshold: 2}),
+		Cache:          cache.New(cache.Config{MaxEntries: 10}),
+	}
+	reg, err := NewProjectRegistry(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	reg.Register(Project{ID: "multi"})
+	if _, err := reg.Client(ctx, "multi"); err == nil || !strings.Contains(err.Error(), "AppKeyName") {
+		t.Errorf("expected an ambiguous app key error, got %v", err)
+	}
+	reg.Register(Project{ID: "multi", AppKeyName: "integrated"})
+	multi, err := reg.Client(ctx, "multi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := multi.config.AppKeys["rds-mysql"]; got != "key-b" {
+		t.Errorf("app key = %q, want the named key-b", got)
+	}
+
+	reg.Register(Project{ID: "single"})
+	single, err := reg.Client(ctx, "single")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := single.config.AppKeys["keymanager"]; got != "key-s" {
+		t.Errorf("app key = %q, want key-s", got)
+	}
+
+	// Each project gets its own breaker and cache, configured like the base.
+	for _, c := range []*Client{multi, single} {
+		if c.config.CircuitBreaker == base.CircuitBreaker || c.config.Cache == base.Cache {
+			t.Error("a project shares the base breaker or cache")
+		}
+		if c.config.CircuitBreaker.Config().FailureThreshold != 2 || c.config.Cache.Config().MaxEntries != 10 {
+			t.Error("a project's breaker or cache lost the base configuration")
+		}
+	}
+	if multi.config.CircuitBreaker == single.config.CircuitBreaker || multi.config.Cache == single.config.Cache {
+		t.Error("projects share a breaker or cache")
+	}
+}
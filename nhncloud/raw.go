@@ -0,0 +1,267 @@
+package nhncloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/endpoint"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/transport"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+)
+
+// RawAuth is the authentication scheme Raw applies to a service.
+type RawAuth int
+
+const (
+	// RawAuthIdentity sends an Identity token (X-Auth-Token) issued from
+	// Config.IdentityCredentials. The base URL comes from the service catalog.
+	RawAuthIdentity RawAuth = iota
+	// RawAuthSwift is RawAuthIdentity against the tenant's Object Storage
+	// account URL (.../v1/AUTH_{tenantId}).
+	RawAuthSwift
+	// RawAuthOAuth sends an OAuth2 bearer token (X-NHN-AUTHORIZATION) issued
+	// from the User Access Key in Config.Credentials.
+	RawAuthOAuth
+	// RawAuthAppKey sends the app key and the User Access Key as
+	// X-TC-APP-KEY / X-TC-AUTHENTICATION-* headers.
+	RawAuthAppKey
+)
+
+func (a RawAuth) String() string {
+	switch a {
+	case RawAuthIdentity:
+		return "identity"
+	case RawAuthSwift:
+		return "swift"
+	case RawAuthOAuth:
+		return "oauth"
+	case RawAuthAppKey:
+		return "appkey"
+	default:
+		return "unknown"
+	}
+}
+
+// rawService describes how to reach a service. Identity and Swift services
+// resolve their base URL from the service catalog (catalogType) and fall
+// back to baseURL; the others use baseURL directly.
+type rawService struct {
+	auth        RawAuth
+	catalogType string
+	baseURL     func(cfg *Config, appKey string) string
+}
+
+func staticURL(service endpoint.Service) func(*Config, string) string {
+	return func(cfg *Config, _ string) string { return endpoint.Resolve(service, cfg.Region) }
+}
+
+// appKeyURL formats a base URL with the region as %[1]s and the app key as
+// %[2]s.
+func appKeyURL(format string) func(*Config, string) string {
+	return func(cfg *Config, appKey string) string {
+		return fmt.Sprintf(format, strings.ToLower(cfg.Region), appKey)
+	}
+}
+
+func appKeyEndpoint(service endpoint.Service) func(*Config, string) string {
+	return func(cfg *Config, appKey string) string {
+		return endpoint.ResolveWithAppKey(service, cfg.Region, appKey)
+	}
+}
+
+func rdsURL(service endpoint.Service) func(*Config, string) string {
+	return func(cfg *Config, _ string) string {
+		return endpoint.ResolveVersion(service, cfg.Region, cfg.APIVersions[string(service)])
+	}
+}
+
+// rawServices maps the service names accepted by Raw, the same keys used in
+// Config.AppKeys, to their endpoint and auth scheme.
+var rawServices = map[string]rawService{
+	"iam":            {auth: RawAuthOAuth, baseURL: staticURL(endpoint.ServiceIAM)},
+	"rds-mysql":      {auth: RawAuthAppKey, baseURL: rdsURL(endpoint.ServiceRDSMySQL)},
+	"rds-mariadb":    {auth: RawAuthAppKey, baseURL: rdsURL(endpoint.ServiceRDSMariaDB)},
+	"rds-postgresql": {auth: RawAuthOAuth, baseURL: rdsURL(endpoint.ServiceRDSPostgreSQL)},
+	"ncr":            {auth: RawAuthOAuth, baseURL: appKeyEndpoint(endpoint.ServiceNCR)},
+	"ncs":            {auth: RawAuthOAuth, baseURL: appKeyEndpoint(endpoint.ServiceNCS)},
+
+	"keymanager":      {auth: RawAuthAppKey, baseURL: appKeyURL("https://api-keymanager.nhncloudservice.com/keymanager/v1.2/appkey/%[2]s")},
+	"certmanager":     {auth: RawAuthAppKey, baseURL: appKeyURL("https://certmanager.api.nhncloudservice.com/certmanager/v1.0/appkeys/%[2]s")},
+	"cloudtrail":      {auth: RawAuthAppKey, baseURL: appKeyURL("https://cloud-trail.api.nhncloudservice.com/cloud-trail/v2.0/appkeys/%[2]s")},
+	"resourcewatcher": {auth: RawAuthAppKey, baseURL: appKeyURL("https://resource-watcher.api.nhncloudservice.com/resource-watcher/v2.0/appkeys/%[2]s")},
+	"dnsplus":         {auth: RawAuthAppKey, baseURL: appKeyURL("https://dnsplus.api.nhncloudservice.com/dnsplus/v1.0/appkeys/%[2]s")},
+	"apigw":           {auth: RawAuthAppKey, baseURL: appKeyURL("https://%[1]s-apigateway.api.nhncloudservice.com/v1.0/appkeys/%[2]s")},
+
+	"compute":        {auth: RawAuthIdentity, catalogType: "compute"},
+	"image":          {auth: RawAuthIdentity, catalogType: "image"},
+	"network":        {auth: RawAuthIdentity, catalogType: "network", baseURL: staticURL(endpoint.ServiceVPC)},
+	"vpc":            {auth: RawAuthIdentity, catalogType: "network", baseURL: staticURL(endpoint.ServiceVPC)},
+	"security-group": {auth: RawAuthIdentity, catalogType: "network", baseURL: staticURL(endpoint.ServiceSecurityGroup)},
+	"floating-ip":    {auth: RawAuthIdentity, catalogType: "network", baseURL: staticURL(endpoint.ServiceFloatingIP)},
+	"load-balancer":  {auth: RawAuthIdentity, catalogType: "network", baseURL: staticURL(endpoint.ServiceLoadBalancer)},
+	"block-storage":  {auth: RawAuthIdentity, catalogType: "volumev2"},
+	"nks":            {auth: RawAuthIdentity, catalogType: "container-infra"},
+	"nas": {auth: RawAuthIdentity, baseURL: func(cfg *Config, _ string) string {
+		return fmt.Sprintf("https://%s-api-nas-infrastructure.nhncloudservice.com", strings.ToLower(cfg.Region))
+	}},
+	"object-storage": {auth: RawAuthSwift, catalogType: "object-store", baseURL: func(cfg *Config, _ string) string {
+		return fmt.Sprintf("https://%s-api-object-storage.nhncloudservice.com/v1/AUTH_%s", strings.ToLower(cfg.Region), cfg.IdentityCredentials.GetTenantID())
+	}},
+}
+
+// RawClient calls endpoints of one service that the SDK does not wrap yet,
+// with the service's auth scheme, the shared retry policy, circuit breaker
+// and error mapping. Obtain one with Client.Raw.
+type RawClient struct {
+	service string
+	def     rawService
+	known   bool
+	config  *Config
+
+	mu      sync.Mutex
+	baseURL string
+	tc      *transport.Client
+}
+
+// Raw returns a RawClient for service, named like the Config.AppKeys keys
+// ("compute", "rds-mysql", "object-storage", ...). Paths passed to Do are
+// relative to the service's base URL, which already contains the API
+// version and the app key segment where the service has them:
+//
+//	var out struct {
+//	    Servers []struct{ ID string `json:"id"` } `json:"servers"`
+//	}
+//	err := c.Raw("compute").Do(ctx, "GET", "/servers", nil, &out)
+//
+// An unknown service yields a RawClient whose Do always fails.
+func (c *Client) Raw(service string) *RawClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if rc, ok := c.rawClients[service]; ok {
+		return rc
+	}
+	def, known := rawServices[service]
+	rc := &RawClient{service: service, def: def, known: known, config: c.config}
+	if c.rawClients == nil {
+		c.rawClients = make(map[string]*RawClient)
+	}
+	c.rawClients[service] = rc
+	return rc
+}
+
+// Auth returns the authentication scheme used for the service. RDS for
+// MySQL and MariaDB use RawAuthOAuth when Config.APIVersions selects an API
+// version after v3.0.
+func (r *RawClient) Auth() RawAuth {
+	if r.def.auth == RawAuthAppKey && strings.HasPrefix(r.service, "rds-") {
+		if v := r.config.APIVersions[r.service]; v != "" && v != "v3.0" {
+			return RawAuthOAuth
+		}
+	}
+	return r.def.auth
+}
+
+// BaseURL resolves and returns the base URL paths are joined to. For
+// Identity and Swift services this authenticates to read the catalog.
+func (r *RawClient) BaseURL(ctx context.Context) (string, error) {
+	if err := r.init(ctx); err != nil {
+		return "", err
+	}
+	return r.baseURL, nil
+}
+
+// Do sends method to path with body encoded as JSON (nil for none) and
+// decodes the response into out. out may be nil to discard the body, or a
+// *[]byte to receive it undecoded.
+func (r *RawClient) Do(ctx context.Context, method, path string, body, out interface{}) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+	resp, err := r.tc.Do(ctx, &transport.Request{Method: strings.ToUpper(method), Path: path, Body: body})
+	if err != nil {
+		return err
+	}
+	switch v := out.(type) {
+	case nil:
+		return nil
+	case *[]byte:
+		*v = resp.Body
+		return nil
+	default:
+		if len(resp.Body) == 0 {
+			return nil
+		}
+		return json.Unmarshal(resp.Body, out)
+	}
+}
+
+func (r *RawClient) init(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.tc != nil {
+		return nil
+	}
+	if !r.known {
+		return fmt.Errorf("nhncloud: no raw endpoint for service %q", r.service)
+	}
+
+	cfg := r.config
+	appKey := cfg.AppKeys[r.service]
+	opts := []transport.ClientOption{
+		transport.WithHTTPClient(cfg.httpClient()),
+		transport.WithDebug(cfg.Debug),
+	}
+
+	var baseURL string
+	switch r.Auth() {
+	case RawAuthIdentity, RawAuthSwift:
+		ids := cfg.IdentityCredentials
+		if ids == nil || ids.GetTenantID() == "" {
+			return ErrTenantIDRequired
+		}
+		tp := client.NewIdentityTokenProvider(ids.GetTenantID(), ids.GetUsername(), ids.GetPassword())
+		tp.SetHTTPClient(cfg.httpClient())
+		if r.def.catalogType != "" {
+			if _, err := tp.GetToken(ctx); err != nil {
+				return fmt.Errorf("authenticate: %w", err)
+			}
+			baseURL, _ = tp.GetServiceEndpoint(r.def.catalogType, cfg.Region)
+		}
+		if baseURL == "" && r.def.baseURL != nil {
+			baseURL = r.def.baseURL(cfg, appKey)
+		}
+		if baseURL == "" {
+			return fmt.Errorf("nhncloud: resolve %s endpoint: not in service catalog for region %s", r.service, cfg.Region)
+		}
+		opts = append(opts, transport.WithRequestAuth(tp))
+	case RawAuthOAuth:
+		baseURL = r.def.baseURL(cfg, appKey)
+		headerAppKey := ""
+		if strings.HasPrefix(r.service, "rds-") {
+			if appKey == "" {
+				return ErrAppKeyRequired
+			}
+			headerAppKey = appKey
+		}
+		tp := credentials.NewTokenProvider(credentials.UserAccessKey(cfg.Credentials))
+		tp.SetHTTPClient(cfg.httpClient())
+		opts = append(opts, transport.WithDynamicBearerAuth(headerAppKey, tp))
+	case RawAuthAppKey:
+		if appKey == "" {
+			return ErrAppKeyRequired
+		}
+		baseURL = r.def.baseURL(cfg, appKey)
+		accessKeyID, secretAccessKey := credentials.UserAccessKey(cfg.Credentials)
+		opts = append(opts, transport.WithAppKeyAuth(appKey, accessKeyID, secretAccessKey))
+	}
+
+	opts = append(opts, transport.Options(option.Apply(cfg.options()...), r.service)...)
+	r.baseURL = baseURL
+	r.tc = transport.NewClient(baseURL, opts...)
+	return nil
+}
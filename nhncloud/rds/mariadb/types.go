@@ -1,18 +1,66 @@
 package mariadb
 
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/rdscore"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
 // ResponseHeader represents common API response header
-type ResponseHeader struct {
-	ResultCode    int    `json:"resultCode"`
-	ResultMessage string `json:"resultMessage"`
-	IsSuccessful  bool   `json:"isSuccessful"`
-}
+type ResponseHeader = rdscore.ResponseHeader
+
+// InstanceStatus, ProgressStatus and BackupStatus are the documented status
+// values, with IsTerminal, IsFailed and IsTransitioning helpers. Unknown
+// values are kept verbatim.
+type (
+	InstanceStatus = rdscore.InstanceStatus
+	ProgressStatus = rdscore.ProgressStatus
+	BackupStatus   = rdscore.BackupStatus
+)
+
+const (
+	InstanceStatusAvailable       = rdscore.InstanceStatusAvailable
+	InstanceStatusBeforeCreate    = rdscore.InstanceStatusBeforeCreate
+	InstanceStatusStorageFull     = rdscore.InstanceStatusStorageFull
+	InstanceStatusFailToCreate    = rdscore.InstanceStatusFailToCreate
+	InstanceStatusFailToConnect   = rdscore.InstanceStatusFailToConnect
+	InstanceStatusReplicationStop = rdscore.InstanceStatusReplicationStop
+	InstanceStatusFailover        = rdscore.InstanceStatusFailover
+	InstanceStatusShutdown        = rdscore.InstanceStatusShutdown
+	InstanceStatusDeleted         = rdscore.InstanceStatusDeleted
+)
+
+const (
+	ProgressStatusNone                   = rdscore.ProgressStatusNone
+	ProgressStatusCreating               = rdscore.ProgressStatusCreating
+	ProgressStatusModifying              = rdscore.ProgressStatusModifying
+	ProgressStatusStarting               = rdscore.ProgressStatusStarting
+	ProgressStatusStopping               = rdscore.ProgressStatusStopping
+	ProgressStatusRestarting             = rdscore.ProgressStatusRestarting
+	ProgressStatusForceRestarting        = rdscore.ProgressStatusForceRestarting
+	ProgressStatusBackingUp              = rdscore.ProgressStatusBackingUp
+	ProgressStatusRestoring              = rdscore.ProgressStatusRestoring
+	ProgressStatusReplicating            = rdscore.ProgressStatusReplicating
+	ProgressStatusPromoting              = rdscore.ProgressStatusPromoting
+	ProgressStatusFailingOver            = rdscore.ProgressStatusFailingOver
+	ProgressStatusApplyingParameterGroup = rdscore.ProgressStatusApplyingParameterGroup
+	ProgressStatusDeleting               = rdscore.ProgressStatusDeleting
+)
+
+const (
+	BackupStatusBackingUp = rdscore.BackupStatusBackingUp
+	BackupStatusCompleted = rdscore.BackupStatusCompleted
+	BackupStatusDeleting  = rdscore.BackupStatusDeleting
+	BackupStatusDeleted   = rdscore.BackupStatusDeleted
+	BackupStatusError     = rdscore.BackupStatusError
+)
 
 // DatabaseInstanceGroup represents a MariaDB database instance group
 type DatabaseInstanceGroup struct {
-	DBInstanceGroupID string `json:"dbInstanceGroupId"`
-	ReplicationType   string `json:"replicationType"`
-	CreatedYmdt       string `json:"createdYmdt"`
-	UpdatedYmdt       string `json:"updatedYmdt"`
+	DBInstanceGroupID string         `json:"dbInstanceGroupId"`
+	ReplicationType   string         `json:"replicationType"`
+	CreatedYmdt       timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt       timestamp.Time `json:"updatedYmdt"`
 }
 
 // DatabaseInstanceGroupsResponse represents the response for listing instance groups
@@ -23,12 +71,13 @@ type DatabaseInstanceGroupsResponse struct {
 
 // DatabaseInstance represents a MariaDB database instance
 type DatabaseInstance struct {
-	DBInstanceID     string `json:"dbInstanceId"`
-	DBInstanceName   string `json:"dbInstanceName"`
-	DBInstanceStatus string `json:"dbInstanceStatus"`
-	Description      string `json:"description,omitempty"`
-	DBVersion        string `json:"dbVersion"`
-	DBPort           int    `json:"dbPort"`
+	DBInstanceID     string         `json:"dbInstanceId"`
+	DBInstanceName   string         `json:"dbInstanceName"`
+	DBInstanceStatus InstanceStatus `json:"dbInstanceStatus"`
+	ProgressStatus   ProgressStatus `json:"progressStatus,omitempty"`
+	Description      string         `json:"description,omitempty"`
+	DBVersion        string         `json:"dbVersion"`
+	DBPort           int            `json:"dbPort"`
 
 	// Storage
 	StorageType string `json:"storageType"`
@@ -49,8 +98,13 @@ type DatabaseInstance struct {
 	UseDeletionProtection bool `json:"useDeletionProtection,omitempty"`
 
 	// Timestamps
-	CreatedYmdt string `json:"createdYmdt"`
-	UpdatedYmdt string `json:"updatedYmdt"`
+	CreatedYmdt timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt timestamp.Time `json:"updatedYmdt"`
+}
+
+// Status returns the instance's status and the operation running on it.
+func (i DatabaseInstance) Status() (InstanceStatus, ProgressStatus) {
+	return i.DBInstanceStatus, i.ProgressStatus
 }
 
 // DatabaseInstanceResponse represents the response for database instance operations
@@ -91,24 +145,18 @@ type BackupConfig struct {
 }
 
 // RestartInstanceRequest represents a request to restart a database instance
-type RestartInstanceRequest struct {
-	// UseOnlineFailover enables restart using failover (HA instances only)
-	// When true, minimizes downtime by failing over to standby before restart
-	UseOnlineFailover bool `json:"useOnlineFailover,omitempty"`
-	// ExecuteBackup triggers a backup before restart
-	ExecuteBackup bool `json:"executeBackup,omitempty"`
-}
+type RestartInstanceRequest = rdscore.RestartInstanceRequest
 
 // CreateDatabaseInstanceRequest represents MariaDB instance creation request
 type CreateDatabaseInstanceRequest struct {
-	DBInstanceName          string `json:"dbInstanceName"`
-	DBInstanceCandidateName string `json:"dbInstanceCandidateName,omitempty"`
-	Description             string `json:"description,omitempty"`
-	DBFlavorID              string `json:"dbFlavorId"`
-	DBVersion               string `json:"dbVersion"`
-	DBUserName              string `json:"dbUserName"`
-	DBPassword              string `json:"dbPassword"`
-	DBPort                  int    `json:"dbPort,omitempty"`
+	DBInstanceName          string        `json:"dbInstanceName"`
+	DBInstanceCandidateName string        `json:"dbInstanceCandidateName,omitempty"`
+	Description             string        `json:"description,omitempty"`
+	DBFlavorID              string        `json:"dbFlavorId"`
+	DBVersion               string        `json:"dbVersion"`
+	DBUserName              string        `json:"dbUserName"`
+	DBPassword              secret.String `json:"dbPassword"`
+	DBPort                  int           `json:"dbPort,omitempty"`
 
 	// Configuration
 	ParameterGroupID     string   `json:"parameterGroupId,omitempty"`
@@ -151,9 +199,7 @@ type ModifyStorageInfoRequest struct {
 }
 
 // ModifyDeletionProtectionRequest for PUT /v3.0/db-instances/{dbInstanceId}/deletion-protection
-type ModifyDeletionProtectionRequest struct {
-	UseDeletionProtection bool `json:"useDeletionProtection"`
-}
+type ModifyDeletionProtectionRequest = rdscore.ModifyDeletionProtectionRequest
 
 // Parameter Groups
 type Parameter struct {
@@ -168,14 +214,14 @@ type Parameter struct {
 }
 
 type ParameterGroup struct {
-	ParameterGroupID     string      `json:"parameterGroupId"`
-	ParameterGroupName   string      `json:"parameterGroupName"`
-	Description          string      `json:"description,omitempty"`
-	DBVersion            string      `json:"dbVersion"`
-	ParameterGroupStatus string      `json:"parameterGroupStatus"`
-	Parameters           []Parameter `json:"parameters,omitempty"`
-	CreatedYmdt          string      `json:"createdYmdt"`
-	UpdatedYmdt          string      `json:"updatedYmdt"`
+	ParameterGroupID     string         `json:"parameterGroupId"`
+	ParameterGroupName   string         `json:"parameterGroupName"`
+	Description          string         `json:"description,omitempty"`
+	DBVersion            string         `json:"dbVersion"`
+	ParameterGroupStatus string         `json:"parameterGroupStatus"`
+	Parameters           []Parameter    `json:"parameters,omitempty"`
+	CreatedYmdt          timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt          timestamp.Time `json:"updatedYmdt"`
 }
 
 type ParameterGroupsResponse struct {
@@ -188,33 +234,18 @@ type ParameterGroupResponse struct {
 	ParameterGroup                 // Embedded fields
 }
 
-type CreateParameterGroupRequest struct {
-	ParameterGroupName string `json:"parameterGroupName"`
-	Description        string `json:"description,omitempty"`
-	DBVersion          string `json:"dbVersion"`
-}
+type CreateParameterGroupRequest = rdscore.CreateParameterGroupRequest
 
-type CopyParameterGroupRequest struct {
-	ParameterGroupName string `json:"parameterGroupName"`
-	Description        string `json:"description,omitempty"`
-}
+type CopyParameterGroupRequest = rdscore.CopyParameterGroupRequest
 
 type UpdateParameterGroupRequest struct {
 	ParameterGroupName string `json:"parameterGroupName,omitempty"`
 	Description        string `json:"description,omitempty"`
 }
 
-type ModifyParametersRequest struct {
-	ModifiedParameters []struct {
-		ParameterID string `json:"parameterId"`
-		Value       string `json:"value"`
-	} `json:"modifiedParameters"`
-}
+type ModifyParametersRequest = rdscore.ModifyParametersRequest
 
-type ParameterGroupIDResponse struct {
-	Header           *ResponseHeader `json:"header"`
-	ParameterGroupID string          `json:"parameterGroupId"`
-}
+type ParameterGroupIDResponse = rdscore.ParameterGroupIDResponse
 
 // DB Security Groups
 type Port struct {
@@ -224,24 +255,24 @@ type Port struct {
 }
 
 type SecurityRule struct {
-	RuleID      string `json:"ruleId"`
-	Description string `json:"description,omitempty"`
-	Direction   string `json:"direction"`
-	EtherType   string `json:"etherType"`
-	Port        Port   `json:"port"`
-	CIDR        string `json:"cidr"`
-	CreatedYmdt string `json:"createdYmdt"`
-	UpdatedYmdt string `json:"updatedYmdt"`
+	RuleID      string         `json:"ruleId"`
+	Description string         `json:"description,omitempty"`
+	Direction   string         `json:"direction"`
+	EtherType   string         `json:"etherType"`
+	Port        Port           `json:"port"`
+	CIDR        string         `json:"cidr"`
+	CreatedYmdt timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt timestamp.Time `json:"updatedYmdt"`
 }
 
 type DBSecurityGroup struct {
 	DBSecurityGroupID   string         `json:"dbSecurityGroupId"`
 	DBSecurityGroupName string         `json:"dbSecurityGroupName"`
 	Description         string         `json:"description,omitempty"`
-	ProgressStatus      string         `json:"progressStatus"`
+	ProgressStatus      ProgressStatus `json:"progressStatus"`
 	Rules               []SecurityRule `json:"rules,omitempty"`
-	CreatedYmdt         string         `json:"createdYmdt"`
-	UpdatedYmdt         string         `json:"updatedYmdt"`
+	CreatedYmdt         timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt         timestamp.Time `json:"updatedYmdt"`
 }
 
 type DBSecurityGroupsResponse struct {
@@ -299,14 +330,14 @@ type UpdateDBSecurityGroupRuleRequest struct {
 
 // DB Users
 type DBUser struct {
-	DBUserID             string `json:"dbUserId"`
-	DBUserName           string `json:"dbUserName"`
-	Host                 string `json:"host"`
-	AuthorityType        string `json:"authorityType"`
-	DBUserStatus         string `json:"dbUserStatus"`
-	AuthenticationPlugin string `json:"authenticationPlugin,omitempty"`
-	CreatedYmdt          string `json:"createdYmdt"`
-	UpdatedYmdt          string `json:"updatedYmdt"`
+	DBUserID             string         `json:"dbUserId"`
+	DBUserName           string         `json:"dbUserName"`
+	Host                 string         `json:"host"`
+	AuthorityType        string         `json:"authorityType"`
+	DBUserStatus         string         `json:"dbUserStatus"`
+	AuthenticationPlugin string         `json:"authenticationPlugin,omitempty"`
+	CreatedYmdt          timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt          timestamp.Time `json:"updatedYmdt"`
 }
 
 type DBUsersResponse struct {
@@ -315,21 +346,18 @@ type DBUsersResponse struct {
 }
 
 type CreateDBUserRequest struct {
-	DBUserName           string `json:"dbUserName"`
-	DBPassword           string `json:"dbPassword"`
-	Host                 string `json:"host"`
-	AuthorityType        string `json:"authorityType"`
-	AuthenticationPlugin string `json:"authenticationPlugin,omitempty"`
+	DBUserName           string        `json:"dbUserName"`
+	DBPassword           secret.String `json:"dbPassword"`
+	Host                 string        `json:"host"`
+	AuthorityType        string        `json:"authorityType"`
+	AuthenticationPlugin string        `json:"authenticationPlugin,omitempty"`
 }
 
 type UpdateDBUserRequest struct {
 	AuthorityType string `json:"authorityType,omitempty"`
 }
 
-type JobIDResponse struct {
-	Header *ResponseHeader `json:"header"`
-	JobID  string          `json:"jobId"`
-}
+type JobIDResponse = rdscore.JobIDResponse
 
 // NetworkSubnet represents a RDS-specific network subnet
 type NetworkSubnet struct {
@@ -348,15 +376,15 @@ type NetworkSubnetsResponse struct {
 
 // Backups
 type Backup struct {
-	BackupID     string `json:"backupId"`
-	BackupName   string `json:"backupName"`
-	BackupStatus string `json:"backupStatus"`
-	DBInstanceID string `json:"dbInstanceId"`
-	DBVersion    string `json:"dbVersion"`
-	BackupType   string `json:"backupType"`
-	BackupSize   int64  `json:"backupSize"`
-	CreatedYmdt  string `json:"createdYmdt"`
-	UpdatedYmdt  string `json:"updatedYmdt"`
+	BackupID     string         `json:"backupId"`
+	BackupName   string         `json:"backupName"`
+	BackupStatus BackupStatus   `json:"backupStatus"`
+	DBInstanceID string         `json:"dbInstanceId"`
+	DBVersion    string         `json:"dbVersion"`
+	BackupType   string         `json:"backupType"`
+	BackupSize   int64          `json:"backupSize"`
+	CreatedYmdt  timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt  timestamp.Time `json:"updatedYmdt"`
 }
 
 type BackupsResponse struct {
@@ -365,17 +393,9 @@ type BackupsResponse struct {
 	Backups     []Backup        `json:"backups"`
 }
 
-type CreateBackupRequest struct {
-	BackupName string `json:"backupName"`
-}
+type CreateBackupRequest = rdscore.CreateBackupRequest
 
-type BackupToObjectStorageRequest struct {
-	TenantID        string `json:"tenantId"`
-	Username        string `json:"username"`
-	Password        string `json:"password"`
-	TargetContainer string `json:"targetContainer"`
-	ObjectPath      string `json:"objectPath"`
-}
+type BackupToObjectStorageRequest = rdscore.BackupToObjectStorageRequest
 
 type RestoreBackupRequest struct {
 	DBInstanceName        string   `json:"dbInstanceName"`
@@ -392,11 +412,11 @@ type RestoreBackupRequest struct {
 }
 
 type ExportBackupRequest struct {
-	TenantID        string `json:"tenantId"`
-	Username        string `json:"username"`
-	Password        string `json:"password"`
-	TargetContainer string `json:"targetContainer"`
-	ObjectPath      string `json:"objectPath"`
+	TenantID        string        `json:"tenantId"`
+	Username        string        `json:"username"`
+	Password        secret.String `json:"password"`
+	TargetContainer string        `json:"targetContainer"`
+	ObjectPath      string        `json:"objectPath"`
 }
 
 // Flavors & Versions
@@ -424,10 +444,7 @@ type DBVersionsResponse struct {
 }
 
 // Storage Types
-type StorageTypesResponse struct {
-	Header       *ResponseHeader `json:"header"`
-	StorageTypes []string        `json:"storageTypes"`
-}
+type StorageTypesResponse = rdscore.StorageTypesResponse
 
 // Notification Groups
 type NotificationGroup struct {
@@ -440,8 +457,8 @@ type NotificationGroup struct {
 		RecipientType string `json:"recipientType"`
 		Recipient     string `json:"recipient"`
 	} `json:"recipients,omitempty"`
-	CreatedYmdt string `json:"createdYmdt"`
-	UpdatedYmdt string `json:"updatedYmdt"`
+	CreatedYmdt timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt timestamp.Time `json:"updatedYmdt"`
 }
 
 type NotificationGroupsResponse struct {
@@ -454,16 +471,7 @@ type NotificationGroupResponse struct {
 	NotificationGroup NotificationGroup `json:"notificationGroup"`
 }
 
-type CreateNotificationGroupRequest struct {
-	NotificationGroupName string `json:"notificationGroupName"`
-	Description           string `json:"description,omitempty"`
-	NotificationType      string `json:"notificationType"`
-	IsEnabled             bool   `json:"isEnabled"`
-	Recipients            []struct {
-		RecipientType string `json:"recipientType"`
-		Recipient     string `json:"recipient"`
-	} `json:"recipients"`
-}
+type CreateNotificationGroupRequest = rdscore.CreateNotificationGroupRequest
 
 type UpdateNotificationGroupRequest struct {
 	NotificationGroupName string `json:"notificationGroupName,omitempty"`
@@ -482,17 +490,9 @@ type NotificationGroupIDResponse struct {
 }
 
 // Log Files
-type LogFile struct {
-	LogFileName string `json:"logFileName"`
-	LogFileSize int64  `json:"logFileSize"`
-	CreatedYmdt string `json:"createdYmdt"`
-	UpdatedYmdt string `json:"updatedYmdt"`
-}
+type LogFile = rdscore.LogFile
 
-type LogFilesResponse struct {
-	Header   *ResponseHeader `json:"header"`
-	LogFiles []LogFile       `json:"logFiles"`
-}
+type LogFilesResponse = rdscore.LogFilesResponse
 
 // Network/Subnets
 type Subnet struct {
@@ -510,9 +510,9 @@ type SubnetsResponse struct {
 
 // DatabaseInstanceInGroup represents an individual instance within a group
 type DatabaseInstanceInGroup struct {
-	DBInstanceID     string `json:"dbInstanceId"`
-	DBInstanceType   string `json:"dbInstanceType"`
-	DBInstanceStatus string `json:"dbInstanceStatus"`
+	DBInstanceID     string         `json:"dbInstanceId"`
+	DBInstanceType   string         `json:"dbInstanceType"`
+	DBInstanceStatus InstanceStatus `json:"dbInstanceStatus"`
 }
 
 // DatabaseInstanceGroupDetail represents detailed information about an instance group
@@ -521,8 +521,8 @@ type DatabaseInstanceGroupDetail struct {
 	DBInstanceGroupID string                    `json:"dbInstanceGroupId"`
 	ReplicationType   string                    `json:"replicationType"`
 	DBInstances       []DatabaseInstanceInGroup `json:"dbInstances"`
-	CreatedYmdt       string                    `json:"createdYmdt"`
-	UpdatedYmdt       string                    `json:"updatedYmdt"`
+	CreatedYmdt       timestamp.Time            `json:"createdYmdt"`
+	UpdatedYmdt       timestamp.Time            `json:"updatedYmdt"`
 }
 
 // DatabaseInstanceGroupResponse represents the response for getting instance group details
@@ -552,10 +552,10 @@ type NetworkInfoResponse struct {
 
 // DB Schemas (Database Management)
 type DBSchema struct {
-	DBSchemaID   string `json:"dbSchemaId"`
-	DBSchemaName string `json:"dbSchemaName"`
-	CreatedYmdt  string `json:"createdYmdt"`
-	UpdatedYmdt  string `json:"updatedYmdt"`
+	DBSchemaID   string         `json:"dbSchemaId"`
+	DBSchemaName string         `json:"dbSchemaName"`
+	CreatedYmdt  timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt  timestamp.Time `json:"updatedYmdt"`
 }
 
 type DBSchemasResponse struct {
@@ -641,8 +641,47 @@ type ModifyHighAvailabilityRequest struct {
 	FailoverReplWaitingTime int  `json:"failoverReplWaitingTime,omitempty"`
 }
 
-type ModifyNetworkInfoRequest struct {
-	UsePublicAccess bool `json:"usePublicAccess"`
+type ModifyNetworkInfoRequest = rdscore.ModifyNetworkInfoRequest
+
+// User Groups
+type UserGroupMember struct {
+	MemberID string `json:"memberId"`
+}
+
+type UserGroup struct {
+	UserGroupID       string            `json:"userGroupId"`
+	UserGroupName     string            `json:"userGroupName"`
+	UserGroupTypeCode string            `json:"userGroupTypeCode,omitempty"`
+	Members           []UserGroupMember `json:"members,omitempty"`
+	CreatedYmdt       timestamp.Time    `json:"createdYmdt,omitempty"`
+	UpdatedYmdt       timestamp.Time    `json:"updatedYmdt,omitempty"`
+}
+
+type UserGroupsResponse struct {
+	Header     *ResponseHeader `json:"header"`
+	UserGroups []UserGroup     `json:"userGroups"`
+}
+
+type UserGroupResponse struct {
+	Header *ResponseHeader `json:"header"`
+	UserGroup
+}
+
+type CreateUserGroupRequest struct {
+	UserGroupName string   `json:"userGroupName"`
+	MemberIDs     []string `json:"memberIds,omitempty"`
+	SelectAllYN   bool     `json:"selectAllYN,omitempty"`
+}
+
+type UpdateUserGroupRequest = rdscore.UpdateUserGroupRequest
+
+type UserGroupIDResponse = rdscore.UserGroupIDResponse
+
+// Storage info
+type StorageInfoResponse struct {
+	Header      *ResponseHeader `json:"header"`
+	StorageType string          `json:"storageType"`
+	StorageSize int             `json:"storageSize"`
 }
 
 type ListInstancesOutput = DatabaseInstancesResponse
@@ -698,3 +737,9 @@ type ModifyStorageInfoInput = ModifyStorageInfoRequest
 type ModifyDeletionProtectionInput = ModifyDeletionProtectionRequest
 type ModifyNetworkInfoInput = ModifyNetworkInfoRequest
 type CreateReplicaInput = CreateReplicaRequest
+type ListUserGroupsOutput = UserGroupsResponse
+type UserGroupOutput = UserGroupResponse
+type CreateUserGroupInput = CreateUserGroupRequest
+type UpdateUserGroupInput = UpdateUserGroupRequest
+type UserGroupIDOutput = UserGroupIDResponse
+type StorageInfoOutput = StorageInfoResponse
@@ -0,0 +1,73 @@
+package mysql_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/cloudtest"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/mysql"
+)
+
+const host = "kr1-rds-mysql.api.nhncloudservice.com"
+
+func TestAPIVersionSelectsPathAndAuth(t *testing.T) {
+	srv := cloudtest.New(t)
+	headers := map[string]http.Header{}
+	srv.Mux.HandleFunc(host+"/", func(w http.ResponseWriter, r *http.Request) {
+		headers[r.URL.Path] = r.Header.Clone()
+		w.Write([]byte(`{"header":{"isSuccessful":true}}`))
+	})
+
+	c := mysql.NewClient("kr1", "mysql-appkey", credentials.NewStatic("access-key", "secret-key"), false,
+		option.WithHTTPClient(srv.Client()))
+	ctx := context.Background()
+
+	if _, err := c.ListInstances(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// Storage info exists only in v4.0 and is pinned to it.
+	if _, err := c.GetStorageInfo(ctx, "db-1"); err != nil {
+		t.Fatal(err)
+	}
+	c.SetAPIVersion(mysql.APIVersionV4)
+	if _, err := c.ListInstances(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	v3 := headers["/v3.0/db-instances"]
+	if v3 == nil {
+		t.Fatalf("no v3.0 request among %v", srv.Requests())
+	}
+	if v3.Get("X-TC-AUTHENTICATION-ID") != "access-key" || v3.Get("X-TC-AUTHENTICATION-SECRET") != "secret-key" || v3.Get("X-NHN-AUTHORIZATION") != "" {
+		t.Errorf("v3.0 headers = %v", v3)
+	}
+
+	for _, path := range []string{"/v4.0/db-instances", "/v4.0/db-instances/db-1/storage-info"} {
+		v4 := headers[path]
+		if v4 == nil {
+			t.Fatalf("no request to %s among %v", path, srv.Requests())
+		}
+		if got := v4.Get("X-NHN-AUTHORIZATION"); got != "Bearer "+cloudtest.AccessToken {
+			t.Errorf("%s: bearer header = %q", path, got)
+		}
+		if v4.Get("X-TC-APP-KEY") != "mysql-appkey" || v4.Get("X-TC-AUTHENTICATION-SECRET") != "" {
+			t.Errorf("%s: headers = %v", path, v4)
+		}
+	}
+
+	if !contains(srv.Requests(), "POST oauth.api.nhncloudservice.com/oauth2/token/create") {
+		t.Errorf("the Bearer token was not requested through the client: %v", srv.Requests())
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
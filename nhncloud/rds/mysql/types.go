@@ -1,18 +1,66 @@
 package mysql
 
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/rdscore"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
 // ResponseHeader represents common API response header
-type ResponseHeader struct {
-	ResultCode    int    `json:"resultCode"`
-	ResultMessage string `json:"resultMessage"`
-	IsSuccessful  bool   `json:"isSuccessful"`
-}
+type ResponseHeader = rdscore.ResponseHeader
+
+// InstanceStatus, ProgressStatus and BackupStatus are the documented status
+// values, with IsTerminal, IsFailed and IsTransitioning helpers. Unknown
+// values are kept verbatim.
+type (
+	InstanceStatus = rdscore.InstanceStatus
+	ProgressStatus = rdscore.ProgressStatus
+	BackupStatus   = rdscore.BackupStatus
+)
+
+const (
+	InstanceStatusAvailable       = rdscore.InstanceStatusAvailable
+	InstanceStatusBeforeCreate    = rdscore.InstanceStatusBeforeCreate
+	InstanceStatusStorageFull     = rdscore.InstanceStatusStorageFull
+	InstanceStatusFailToCreate    = rdscore.InstanceStatusFailToCreate
+	InstanceStatusFailToConnect   = rdscore.InstanceStatusFailToConnect
+	InstanceStatusReplicationStop = rdscore.InstanceStatusReplicationStop
+	InstanceStatusFailover        = rdscore.InstanceStatusFailover
+	InstanceStatusShutdown        = rdscore.InstanceStatusShutdown
+	InstanceStatusDeleted         = rdscore.InstanceStatusDeleted
+)
+
+const (
+	ProgressStatusNone                   = rdscore.ProgressStatusNone
+	ProgressStatusCreating               = rdscore.ProgressStatusCreating
+	ProgressStatusModifying              = rdscore.ProgressStatusModifying
+	ProgressStatusStarting               = rdscore.ProgressStatusStarting
+	ProgressStatusStopping               = rdscore.ProgressStatusStopping
+	ProgressStatusRestarting             = rdscore.ProgressStatusRestarting
+	ProgressStatusForceRestarting        = rdscore.ProgressStatusForceRestarting
+	ProgressStatusBackingUp              = rdscore.ProgressStatusBackingUp
+	ProgressStatusRestoring              = rdscore.ProgressStatusRestoring
+	ProgressStatusReplicating            = rdscore.ProgressStatusReplicating
+	ProgressStatusPromoting              = rdscore.ProgressStatusPromoting
+	ProgressStatusFailingOver            = rdscore.ProgressStatusFailingOver
+	ProgressStatusApplyingParameterGroup = rdscore.ProgressStatusApplyingParameterGroup
+	ProgressStatusDeleting               = rdscore.ProgressStatusDeleting
+)
+
+const (
+	BackupStatusBackingUp = rdscore.BackupStatusBackingUp
+	BackupStatusCompleted = rdscore.BackupStatusCompleted
+	BackupStatusDeleting  = rdscore.BackupStatusDeleting
+	BackupStatusDeleted   = rdscore.BackupStatusDeleted
+	BackupStatusError     = rdscore.BackupStatusError
+)
 
 // DatabaseInstanceGroup represents a MySQL database instance group
 type DatabaseInstanceGroup struct {
-	DBInstanceGroupID string `json:"dbInstanceGroupId"`
-	ReplicationType   string `json:"replicationType"`
-	CreatedYmdt       string `json:"createdYmdt"`
-	UpdatedYmdt       string `json:"updatedYmdt"`
+	DBInstanceGroupID string         `json:"dbInstanceGroupId"`
+	ReplicationType   string         `json:"replicationType"`
+	CreatedYmdt       timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt       timestamp.Time `json:"updatedYmdt"`
 }
 
 // DatabaseInstanceGroupsResponse represents the response for listing instance groups
@@ -23,12 +71,13 @@ type DatabaseInstanceGroupsResponse struct {
 
 // DatabaseInstance represents a MySQL database instance
 type DatabaseInstance struct {
-	DBInstanceID     string `json:"dbInstanceId"`
-	DBInstanceName   string `json:"dbInstanceName"`
-	DBInstanceStatus string `json:"dbInstanceStatus"`
-	Description      string `json:"description,omitempty"`
-	DBVersion        string `json:"dbVersion"`
-	DBPort           int    `json:"dbPort"`
+	DBInstanceID     string         `json:"dbInstanceId"`
+	DBInstanceName   string         `json:"dbInstanceName"`
+	DBInstanceStatus InstanceStatus `json:"dbInstanceStatus"`
+	ProgressStatus   ProgressStatus `json:"progressStatus,omitempty"`
+	Description      string         `json:"description,omitempty"`
+	DBVersion        string         `json:"dbVersion"`
+	DBPort           int            `json:"dbPort"`
 
 	// Storage
 	StorageType string `json:"storageType"`
@@ -50,8 +99,13 @@ type DatabaseInstance struct {
 	UseDeletionProtection bool `json:"useDeletionProtection,omitempty"`
 
 	// Timestamps
-	CreatedYmdt string `json:"createdYmdt"`
-	UpdatedYmdt string `json:"updatedYmdt"`
+	CreatedYmdt timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt timestamp.Time `json:"updatedYmdt"`
+}
+
+// Status returns the instance's status and the operation running on it.
+func (i DatabaseInstance) Status() (InstanceStatus, ProgressStatus) {
+	return i.DBInstanceStatus, i.ProgressStatus
 }
 
 // DatabaseInstanceResponse represents the response for database instance operations
@@ -93,14 +147,14 @@ type BackupConfig struct {
 
 // CreateDatabaseInstanceRequest represents MySQL instance creation request
 type CreateDatabaseInstanceRequest struct {
-	DBInstanceName          string `json:"dbInstanceName"`
-	DBInstanceCandidateName string `json:"dbInstanceCandidateName,omitempty"`
-	Description             string `json:"description,omitempty"`
-	DBFlavorID              string `json:"dbFlavorId"`
-	DBVersion               string `json:"dbVersion"`
-	DBUserName              string `json:"dbUserName"`
-	DBPassword              string `json:"dbPassword"`
-	DBPort                  int    `json:"dbPort,omitempty"`
+	DBInstanceName          string        `json:"dbInstanceName"`
+	DBInstanceCandidateName string        `json:"dbInstanceCandidateName,omitempty"`
+	Description             string        `json:"description,omitempty"`
+	DBFlavorID              string        `json:"dbFlavorId"`
+	DBVersion               string        `json:"dbVersion"`
+	DBUserName              string        `json:"dbUserName"`
+	DBPassword              secret.String `json:"dbPassword"`
+	DBPort                  int           `json:"dbPort,omitempty"`
 
 	// Configuration
 	ParameterGroupID     string   `json:"parameterGroupId"`
@@ -121,6 +175,9 @@ type CreateDatabaseInstanceRequest struct {
 	// MySQL specific
 	AuthenticationPlugin string `json:"authenticationPlugin,omitempty"`
 	TLSOption            string `json:"tlsOption,omitempty"`
+
+	// v4.0 only
+	UseDefaultNotification *bool `json:"useDefaultNotification,omitempty"`
 }
 
 // ModifyDatabaseInstanceRequest represents a request to modify a database instance
@@ -144,25 +201,19 @@ type ModifyHighAvailabilityRequest struct {
 	FailoverReplWaitingTime int  `json:"failoverReplWaitingTime,omitempty"`
 }
 
-// ModifyStorageInfoRequest for PUT /v3.0/db-instances/{dbInstanceId}/storage-info
+// ModifyStorageInfoRequest for PUT /{v3.0,v4.0}/db-instances/{dbInstanceId}/storage-info
 type ModifyStorageInfoRequest struct {
 	StorageSize       int  `json:"storageSize"`
 	UseOnlineFailover bool `json:"useOnlineFailover,omitempty"`
+	// v4.0 only
+	StorageAutoscale *StorageAutoscale `json:"storageAutoscale,omitempty"`
 }
 
 // ModifyDeletionProtectionRequest for PUT /v3.0/db-instances/{dbInstanceId}/deletion-protection
-type ModifyDeletionProtectionRequest struct {
-	UseDeletionProtection bool `json:"useDeletionProtection"`
-}
+type ModifyDeletionProtectionRequest = rdscore.ModifyDeletionProtectionRequest
 
 // RestartInstanceRequest represents a request to restart a database instance
-type RestartInstanceRequest struct {
-	// UseOnlineFailover enables restart using failover (HA instances only)
-	// When true, minimizes downtime by failing over to standby before restart
-	UseOnlineFailover bool `json:"useOnlineFailover,omitempty"`
-	// ExecuteBackup triggers a backup before restart
-	ExecuteBackup bool `json:"executeBackup,omitempty"`
-}
+type RestartInstanceRequest = rdscore.RestartInstanceRequest
 
 // Parameter Groups
 type Parameter struct {
@@ -177,14 +228,14 @@ type Parameter struct {
 }
 
 type ParameterGroup struct {
-	ParameterGroupID     string      `json:"parameterGroupId"`
-	ParameterGroupName   string      `json:"parameterGroupName"`
-	Description          string      `json:"description,omitempty"`
-	DBVersion            string      `json:"dbVersion"`
-	ParameterGroupStatus string      `json:"parameterGroupStatus"`
-	Parameters           []Parameter `json:"parameters,omitempty"`
-	CreatedYmdt          string      `json:"createdYmdt"`
-	UpdatedYmdt          string      `json:"updatedYmdt"`
+	ParameterGroupID     string         `json:"parameterGroupId"`
+	ParameterGroupName   string         `json:"parameterGroupName"`
+	Description          string         `json:"description,omitempty"`
+	DBVersion            string         `json:"dbVersion"`
+	ParameterGroupStatus string         `json:"parameterGroupStatus"`
+	Parameters           []Parameter    `json:"parameters,omitempty"`
+	CreatedYmdt          timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt          timestamp.Time `json:"updatedYmdt"`
 }
 
 type ParameterGroupsResponse struct {
@@ -197,33 +248,18 @@ type ParameterGroupResponse struct {
 	ParameterGroup                 // Embedded fields
 }
 
-type CreateParameterGroupRequest struct {
-	ParameterGroupName string `json:"parameterGroupName"`
-	Description        string `json:"description,omitempty"`
-	DBVersion          string `json:"dbVersion"`
-}
+type CreateParameterGroupRequest = rdscore.CreateParameterGroupRequest
 
-type CopyParameterGroupRequest struct {
-	ParameterGroupName string `json:"parameterGroupName"`
-	Description        string `json:"description,omitempty"`
-}
+type CopyParameterGroupRequest = rdscore.CopyParameterGroupRequest
 
 type UpdateParameterGroupRequest struct {
 	ParameterGroupName string `json:"parameterGroupName,omitempty"`
 	Description        string `json:"description,omitempty"`
 }
 
-type ModifyParametersRequest struct {
-	ModifiedParameters []struct {
-		ParameterID string `json:"parameterId"`
-		Value       string `json:"value"`
-	} `json:"modifiedParameters"`
-}
+type ModifyParametersRequest = rdscore.ModifyParametersRequest
 
-type ParameterGroupIDResponse struct {
-	Header           *ResponseHeader `json:"header"`
-	ParameterGroupID string          `json:"parameterGroupId"`
-}
+type ParameterGroupIDResponse = rdscore.ParameterGroupIDResponse
 
 // DB Security Groups
 type Port struct {
@@ -233,24 +269,24 @@ type Port struct {
 }
 
 type SecurityRule struct {
-	RuleID      string `json:"ruleId"`
-	Description string `json:"description,omitempty"`
-	Direction   string `json:"direction"`
-	EtherType   string `json:"etherType"`
-	Port        Port   `json:"port"`
-	CIDR        string `json:"cidr"`
-	CreatedYmdt string `json:"createdYmdt"`
-	UpdatedYmdt string `json:"updatedYmdt"`
+	RuleID      string         `json:"ruleId"`
+	Description string         `json:"description,omitempty"`
+	Direction   string         `json:"direction"`
+	EtherType   string         `json:"etherType"`
+	Port        Port           `json:"port"`
+	CIDR        string         `json:"cidr"`
+	CreatedYmdt timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt timestamp.Time `json:"updatedYmdt"`
 }
 
 type DBSecurityGroup struct {
 	DBSecurityGroupID   string         `json:"dbSecurityGroupId"`
 	DBSecurityGroupName string         `json:"dbSecurityGroupName"`
 	Description         string         `json:"description,omitempty"`
-	ProgressStatus      string         `json:"progressStatus"`
+	ProgressStatus      ProgressStatus `json:"progressStatus"`
 	Rules               []SecurityRule `json:"rules,omitempty"`
-	CreatedYmdt         string         `json:"createdYmdt"`
-	UpdatedYmdt         string         `json:"updatedYmdt"`
+	CreatedYmdt         timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt         timestamp.Time `json:"updatedYmdt"`
 }
 
 type DBSecurityGroupsResponse struct {
@@ -308,15 +344,15 @@ type UpdateDBSecurityGroupRuleRequest struct {
 
 // DB Users
 type DBUser struct {
-	DBUserID             string `json:"dbUserId"`
-	DBUserName           string `json:"dbUserName"`
-	Host                 string `json:"host"`
-	AuthorityType        string `json:"authorityType"`
-	DBUserStatus         string `json:"dbUserStatus"`
-	AuthenticationPlugin string `json:"authenticationPlugin"`
-	TLSOption            string `json:"tlsOption"`
-	CreatedYmdt          string `json:"createdYmdt"`
-	UpdatedYmdt          string `json:"updatedYmdt"`
+	DBUserID             string         `json:"dbUserId"`
+	DBUserName           string         `json:"dbUserName"`
+	Host                 string         `json:"host"`
+	AuthorityType        string         `json:"authorityType"`
+	DBUserStatus         string         `json:"dbUserStatus"`
+	AuthenticationPlugin string         `json:"authenticationPlugin"`
+	TLSOption            string         `json:"tlsOption"`
+	CreatedYmdt          timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt          timestamp.Time `json:"updatedYmdt"`
 }
 
 type DBUsersResponse struct {
@@ -325,35 +361,32 @@ type DBUsersResponse struct {
 }
 
 type CreateDBUserRequest struct {
-	DBUserName           string `json:"dbUserName"`
-	DBPassword           string `json:"dbPassword"`
-	Host                 string `json:"host"`
-	AuthorityType        string `json:"authorityType"`
-	AuthenticationPlugin string `json:"authenticationPlugin,omitempty"`
-	TLSOption            string `json:"tlsOption,omitempty"`
+	DBUserName           string        `json:"dbUserName"`
+	DBPassword           secret.String `json:"dbPassword"`
+	Host                 string        `json:"host"`
+	AuthorityType        string        `json:"authorityType"`
+	AuthenticationPlugin string        `json:"authenticationPlugin,omitempty"`
+	TLSOption            string        `json:"tlsOption,omitempty"`
 }
 
 type UpdateDBUserRequest struct {
 	AuthorityType string `json:"authorityType,omitempty"`
 }
 
-type JobIDResponse struct {
-	Header *ResponseHeader `json:"header"`
-	JobID  string          `json:"jobId"`
-}
+type JobIDResponse = rdscore.JobIDResponse
 
 // Backups
 type Backup struct {
-	BackupID     string `json:"backupId"`
-	BackupName   string `json:"backupName"`
-	BackupStatus string `json:"backupStatus"`
-	DBInstanceID string `json:"dbInstanceId"`
-	DBVersion    string `json:"dbVersion"`
-	UtilVersion  string `json:"utilVersion"`
-	BackupType   string `json:"backupType"`
-	BackupSize   int64  `json:"backupSize"`
-	CreatedYmdt  string `json:"createdYmdt"`
-	UpdatedYmdt  string `json:"updatedYmdt"`
+	BackupID     string         `json:"backupId"`
+	BackupName   string         `json:"backupName"`
+	BackupStatus BackupStatus   `json:"backupStatus"`
+	DBInstanceID string         `json:"dbInstanceId"`
+	DBVersion    string         `json:"dbVersion"`
+	UtilVersion  string         `json:"utilVersion"`
+	BackupType   string         `json:"backupType"`
+	BackupSize   int64          `json:"backupSize"`
+	CreatedYmdt  timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt  timestamp.Time `json:"updatedYmdt"`
 }
 
 type BackupsResponse struct {
@@ -362,17 +395,9 @@ type BackupsResponse struct {
 	Backups     []Backup        `json:"backups"`
 }
 
-type CreateBackupRequest struct {
-	BackupName string `json:"backupName"`
-}
+type CreateBackupRequest = rdscore.CreateBackupRequest
 
-type BackupToObjectStorageRequest struct {
-	TenantID        string `json:"tenantId"`
-	Username        string `json:"username"`
-	Password        string `json:"password"`
-	TargetContainer string `json:"targetContainer"`
-	ObjectPath      string `json:"objectPath"`
-}
+type BackupToObjectStorageRequest = rdscore.BackupToObjectStorageRequest
 
 type RestoreBackupRequest struct {
 	DBInstanceName        string   `json:"dbInstanceName"`
@@ -389,11 +414,11 @@ type RestoreBackupRequest struct {
 }
 
 type ExportBackupRequest struct {
-	TenantID        string `json:"tenantId"`
-	Username        string `json:"username"`
-	Password        string `json:"password"`
-	TargetContainer string `json:"targetContainer"`
-	ObjectPath      string `json:"objectPath"`
+	TenantID        string        `json:"tenantId"`
+	Username        string        `json:"username"`
+	Password        secret.String `json:"password"`
+	TargetContainer string        `json:"targetContainer"`
+	ObjectPath      string        `json:"objectPath"`
 }
 
 // Flavors & Versions
@@ -431,8 +456,8 @@ type NotificationGroup struct {
 		RecipientType string `json:"recipientType"`
 		Recipient     string `json:"recipient"`
 	} `json:"recipients,omitempty"`
-	CreatedYmdt string `json:"createdYmdt"`
-	UpdatedYmdt string `json:"updatedYmdt"`
+	CreatedYmdt timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt timestamp.Time `json:"updatedYmdt"`
 }
 
 type NotificationGroupsResponse struct {
@@ -445,16 +470,7 @@ type NotificationGroupResponse struct {
 	NotificationGroup NotificationGroup `json:"notificationGroup"`
 }
 
-type CreateNotificationGroupRequest struct {
-	NotificationGroupName string `json:"notificationGroupName"`
-	Description           string `json:"description,omitempty"`
-	NotificationType      string `json:"notificationType"`
-	IsEnabled             bool   `json:"isEnabled"`
-	Recipients            []struct {
-		RecipientType string `json:"recipientType"`
-		Recipient     string `json:"recipient"`
-	} `json:"recipients"`
-}
+type CreateNotificationGroupRequest = rdscore.CreateNotificationGroupRequest
 
 type UpdateNotificationGroupRequest struct {
 	NotificationGroupName string `json:"notificationGroupName,omitempty"`
@@ -473,24 +489,16 @@ type NotificationGroupIDResponse struct {
 }
 
 // Log Files
-type LogFile struct {
-	LogFileName string `json:"logFileName"`
-	LogFileSize int64  `json:"logFileSize"`
-	CreatedYmdt string `json:"createdYmdt"`
-	UpdatedYmdt string `json:"updatedYmdt"`
-}
+type LogFile = rdscore.LogFile
 
-type LogFilesResponse struct {
-	Header   *ResponseHeader `json:"header"`
-	LogFiles []LogFile       `json:"logFiles"`
-}
+type LogFilesResponse = rdscore.LogFilesResponse
 
 // DB Schema Management
 type Schema struct {
-	DBSchemaId   string `json:"dbSchemaId"`
-	DBSchemaName string `json:"dbSchemaName"`
-	CreatedYmdt  string `json:"createdYmdt"`
-	UpdatedYmdt  string `json:"updatedYmdt"`
+	DBSchemaId   string         `json:"dbSchemaId"`
+	DBSchemaName string         `json:"dbSchemaName"`
+	CreatedYmdt  timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt  timestamp.Time `json:"updatedYmdt"`
 }
 
 type SchemasResponse struct {
@@ -544,9 +552,9 @@ type NetworkSubnetsResponse struct {
 
 // DatabaseInstanceInGroup represents an individual instance within a group
 type DatabaseInstanceInGroup struct {
-	DBInstanceID     string `json:"dbInstanceId"`
-	DBInstanceType   string `json:"dbInstanceType"`
-	DBInstanceStatus string `json:"dbInstanceStatus"`
+	DBInstanceID     string         `json:"dbInstanceId"`
+	DBInstanceType   string         `json:"dbInstanceType"`
+	DBInstanceStatus InstanceStatus `json:"dbInstanceStatus"`
 }
 
 // DatabaseInstanceGroupDetail represents detailed information about an instance group
@@ -555,8 +563,8 @@ type DatabaseInstanceGroupDetail struct {
 	DBInstanceGroupID string                    `json:"dbInstanceGroupId"`
 	ReplicationType   string                    `json:"replicationType"`
 	DBInstances       []DatabaseInstanceInGroup `json:"dbInstances"`
-	CreatedYmdt       string                    `json:"createdYmdt"`
-	UpdatedYmdt       string                    `json:"updatedYmdt"`
+	CreatedYmdt       timestamp.Time            `json:"createdYmdt"`
+	UpdatedYmdt       timestamp.Time            `json:"updatedYmdt"`
 }
 
 // DatabaseInstanceGroupResponse represents the response for getting instance group details
@@ -585,10 +593,7 @@ type NetworkInfoResponse struct {
 }
 
 // Storage Types
-type StorageTypesResponse struct {
-	Header       *ResponseHeader `json:"header"`
-	StorageTypes []string        `json:"storageTypes"`
-}
+type StorageTypesResponse = rdscore.StorageTypesResponse
 
 // Metrics Management
 type Metric struct {
@@ -657,6 +662,75 @@ type ReplicaBackupSchedule struct {
 	BackupWndDuration string `json:"backupWndDuration,omitempty"`
 }
 
+// User Groups
+type UserGroupMember struct {
+	MemberID string `json:"memberId"`
+}
+
+type UserGroup struct {
+	UserGroupID       string            `json:"userGroupId"`
+	UserGroupName     string            `json:"userGroupName"`
+	UserGroupTypeCode string            `json:"userGroupTypeCode,omitempty"`
+	Members           []UserGroupMember `json:"members,omitempty"`
+	CreatedYmdt       timestamp.Time    `json:"createdYmdt,omitempty"`
+	UpdatedYmdt       timestamp.Time    `json:"updatedYmdt,omitempty"`
+}
+
+type UserGroupsResponse struct {
+	Header     *ResponseHeader `json:"header"`
+	UserGroups []UserGroup     `json:"userGroups"`
+}
+
+type UserGroupResponse struct {
+	Header *ResponseHeader `json:"header"`
+	UserGroup
+}
+
+type CreateUserGroupRequest struct {
+	UserGroupName string   `json:"userGroupName"`
+	MemberIDs     []string `json:"memberIds,omitempty"`
+	SelectAllYN   bool     `json:"selectAllYN,omitempty"`
+}
+
+type UpdateUserGroupRequest = rdscore.UpdateUserGroupRequest
+
+type UserGroupIDResponse = rdscore.UserGroupIDResponse
+
+// Storage and backup info
+// StorageAutoscale configures automatic storage growth (v4.0 only)
+type StorageAutoscale struct {
+	UseStorageAutoscale *bool `json:"useStorageAutoscale,omitempty"`
+	Threshold           *int  `json:"threshold,omitempty"`      // Usage (%) that triggers growth (50-95)
+	MaxStorageSize      *int  `json:"maxStorageSize,omitempty"` // Upper bound in GB
+	CooldownTime        *int  `json:"cooldownTime,omitempty"`   // Minutes between growths (10-1440)
+}
+
+// StorageInfoResponse for GET /v4.0/db-instances/{dbInstanceId}/storage-info
+type StorageInfoResponse struct {
+	Header           *ResponseHeader   `json:"header"`
+	StorageType      string            `json:"storageType"`
+	StorageSize      int               `json:"storageSize"`
+	StorageAutoscale *StorageAutoscale `json:"storageAutoscale,omitempty"`
+}
+
+// BackupInfoResponse for GET /v4.0/db-instances/{dbInstanceId}/backup-info
+type BackupInfoResponse struct {
+	Header            *ResponseHeader  `json:"header"`
+	BackupPeriod      int              `json:"backupPeriod"`
+	FtwrlWaitTimeout  int              `json:"ftwrlWaitTimeout"`
+	BackupRetryCount  int              `json:"backupRetryCount"`
+	ReplicationRegion *string          `json:"replicationRegion"`
+	UseBackupLock     bool             `json:"useBackupLock"`
+	BackupSchedules   []BackupSchedule `json:"backupSchedules"`
+}
+
+// ModifyBackupInfoRequest for PUT /v4.0/db-instances/{dbInstanceId}/backup-info
+type ModifyBackupInfoRequest struct {
+	BackupPeriod    int              `json:"backupPeriod"` // Days to retain (0-730)
+	UseBackupLock   *bool            `json:"useBackupLock,omitempty"`
+	BackupSchedules []BackupSchedule `json:"backupSchedules,omitempty"`
+}
+
 // ============================================================================
 // Type Aliases for SDK Compatibility
 // Maps legacy type names to SDK-expected names
@@ -741,8 +815,18 @@ type EnableHAInput = ModifyHighAvailabilityRequest
 type ModifyStorageInfoInput = ModifyStorageInfoRequest
 type ModifyDeletionProtectionInput = ModifyDeletionProtectionRequest
 
-type ModifyNetworkInfoRequest struct {
-	UsePublicAccess bool `json:"usePublicAccess"`
-}
+type ModifyNetworkInfoRequest = rdscore.ModifyNetworkInfoRequest
 
 type ModifyNetworkInfoInput = ModifyNetworkInfoRequest
+
+// User group types
+type ListUserGroupsOutput = UserGroupsResponse
+type UserGroupOutput = UserGroupResponse
+type CreateUserGroupInput = CreateUserGroupRequest
+type UpdateUserGroupInput = UpdateUserGroupRequest
+type UserGroupIDOutput = UserGroupIDResponse
+
+// Storage and backup info types
+type StorageInfoOutput = StorageInfoResponse
+type BackupInfoOutput = BackupInfoResponse
+type ModifyBackupInfoInput = ModifyBackupInfoRequest
@@ -0,0 +1,30 @@
+package postgresql_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/cloudtest"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/postgresql"
+)
+
+func TestBearerAuth(t *testing.T) {
+	srv := cloudtest.New(t)
+	var got http.Header
+	srv.Mux.HandleFunc("kr1-rds-postgres.api.nhncloudservice.com/v1.0/db-instances", func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Write([]byte(`{"header":{"isSuccessful":true}}`))
+	})
+
+	c := postgresql.NewClient("kr1", "pg-appkey", credentials.NewStatic("access-key", "secret-key"), false,
+		option.WithHTTPClient(srv.Client()))
+	if _, err := c.ListInstances(context.Background()); err != nil {
+		t.Fatalf("ListInstances: %v (requests %v)", err, srv.Requests())
+	}
+	if got.Get("X-NHN-AUTHORIZATION") != "Bearer "+cloudtest.AccessToken || got.Get("X-TC-APP-KEY") != "pg-appkey" || got.Get("X-TC-AUTHENTICATION-SECRET") != "" {
+		t.Errorf("headers = %v", got)
+	}
+}
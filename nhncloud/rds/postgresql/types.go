@@ -1,13 +1,60 @@
 package postgresql
 
-import "time"
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/rdscore"
+)
 
 // ResponseHeader represents common API response header
-type ResponseHeader struct {
-	ResultCode    int    `json:"resultCode"`
-	ResultMessage string `json:"resultMessage"`
-	IsSuccessful  bool   `json:"isSuccessful"`
-}
+type ResponseHeader = rdscore.ResponseHeader
+
+// InstanceStatus, ProgressStatus and BackupStatus are the documented status
+// values, with IsTerminal, IsFailed and IsTransitioning helpers. Unknown
+// values are kept verbatim.
+type (
+	InstanceStatus = rdscore.InstanceStatus
+	ProgressStatus = rdscore.ProgressStatus
+	BackupStatus   = rdscore.BackupStatus
+)
+
+const (
+	InstanceStatusAvailable       = rdscore.InstanceStatusAvailable
+	InstanceStatusBeforeCreate    = rdscore.InstanceStatusBeforeCreate
+	InstanceStatusStorageFull     = rdscore.InstanceStatusStorageFull
+	InstanceStatusFailToCreate    = rdscore.InstanceStatusFailToCreate
+	InstanceStatusFailToConnect   = rdscore.InstanceStatusFailToConnect
+	InstanceStatusReplicationStop = rdscore.InstanceStatusReplicationStop
+	InstanceStatusFailover        = rdscore.InstanceStatusFailover
+	InstanceStatusShutdown        = rdscore.InstanceStatusShutdown
+	InstanceStatusDeleted         = rdscore.InstanceStatusDeleted
+)
+
+const (
+	ProgressStatusNone                   = rdscore.ProgressStatusNone
+	ProgressStatusCreating               = rdscore.ProgressStatusCreating
+	ProgressStatusModifying              = rdscore.ProgressStatusModifying
+	ProgressStatusStarting               = rdscore.ProgressStatusStarting
+	ProgressStatusStopping               = rdscore.ProgressStatusStopping
+	ProgressStatusRestarting             = rdscore.ProgressStatusRestarting
+	ProgressStatusForceRestarting        = rdscore.ProgressStatusForceRestarting
+	ProgressStatusBackingUp              = rdscore.ProgressStatusBackingUp
+	ProgressStatusRestoring              = rdscore.ProgressStatusRestoring
+	ProgressStatusReplicating            = rdscore.ProgressStatusReplicating
+	ProgressStatusPromoting              = rdscore.ProgressStatusPromoting
+	ProgressStatusFailingOver            = rdscore.ProgressStatusFailingOver
+	ProgressStatusApplyingParameterGroup = rdscore.ProgressStatusApplyingParameterGroup
+	ProgressStatusDeleting               = rdscore.ProgressStatusDeleting
+)
+
+const (
+	BackupStatusBackingUp = rdscore.BackupStatusBackingUp
+	BackupStatusCompleted = rdscore.BackupStatusCompleted
+	BackupStatusDeleting  = rdscore.BackupStatusDeleting
+	BackupStatusDeleted   = rdscore.BackupStatusDeleted
+	BackupStatusError     = rdscore.BackupStatusError
+)
 
 // Project & Region Types
 type Region struct {
@@ -46,10 +93,7 @@ type SubnetsResponse struct {
 	Subnets []Subnet        `json:"subnets"`
 }
 
-type StorageTypesResponse struct {
-	Header       *ResponseHeader `json:"header"`
-	StorageTypes []string        `json:"storageTypes"`
-}
+type StorageTypesResponse = rdscore.StorageTypesResponse
 
 // DB Flavors & Versions
 type DBFlavor struct {
@@ -84,11 +128,11 @@ type BackupSchedule struct {
 
 // DB Instance Group
 type DBInstanceGroup struct {
-	DBInstanceGroupID   string    `json:"dbInstanceGroupId"`
-	DBInstanceGroupName string    `json:"dbInstanceGroupName"`
-	ReplicationType     string    `json:"replicationType"`
-	CreatedYmdt         time.Time `json:"createdYmdt"`
-	UpdatedYmdt         time.Time `json:"updatedYmdt"`
+	DBInstanceGroupID   string         `json:"dbInstanceGroupId"`
+	DBInstanceGroupName string         `json:"dbInstanceGroupName"`
+	ReplicationType     string         `json:"replicationType"`
+	CreatedYmdt         timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt         timestamp.Time `json:"updatedYmdt"`
 }
 
 type DBInstanceGroupsResponse struct {
@@ -102,27 +146,32 @@ type DBInstanceGroupResponse struct {
 }
 
 type DBInstance struct {
-	DBInstanceID              string    `json:"dbInstanceId"`
-	DBInstanceGroupID         string    `json:"dbInstanceGroupId"`
-	DBInstanceName            string    `json:"dbInstanceName"`
-	Description               string    `json:"description,omitempty"`
-	DBVersion                 string    `json:"dbVersion"`
-	DBPort                    int       `json:"dbPort"`
-	DBInstanceType            string    `json:"dbInstanceType"`
-	DBInstanceStatus          string    `json:"dbInstanceStatus"`
-	ProgressStatus            string    `json:"progressStatus"`
-	DBFlavorID                string    `json:"dbFlavorId"`
-	ParameterGroupID          string    `json:"parameterGroupId"`
-	DBSecurityGroupIDs        []string  `json:"dbSecurityGroupIds"`
-	NotificationGroupIDs      []string  `json:"notificationGroupIds"`
-	UseDeletionProtection     bool      `json:"useDeletionProtection"`
-	NeedToApplyParameterGroup bool      `json:"needToApplyParameterGroup"`
-	NeedMigration             bool      `json:"needMigration"`
-	OSVersion                 string    `json:"osVersion,omitempty"`
-	StorageType               string    `json:"storageType,omitempty"`
-	StorageSize               int       `json:"storageSize,omitempty"`
-	CreatedYmdt               time.Time `json:"createdYmdt"`
-	UpdatedYmdt               time.Time `json:"updatedYmdt"`
+	DBInstanceID              string         `json:"dbInstanceId"`
+	DBInstanceGroupID         string         `json:"dbInstanceGroupId"`
+	DBInstanceName            string         `json:"dbInstanceName"`
+	Description               string         `json:"description,omitempty"`
+	DBVersion                 string         `json:"dbVersion"`
+	DBPort                    int            `json:"dbPort"`
+	DBInstanceType            string         `json:"dbInstanceType"`
+	DBInstanceStatus          InstanceStatus `json:"dbInstanceStatus"`
+	ProgressStatus            ProgressStatus `json:"progressStatus"`
+	DBFlavorID                string         `json:"dbFlavorId"`
+	ParameterGroupID          string         `json:"parameterGroupId"`
+	DBSecurityGroupIDs        []string       `json:"dbSecurityGroupIds"`
+	NotificationGroupIDs      []string       `json:"notificationGroupIds"`
+	UseDeletionProtection     bool           `json:"useDeletionProtection"`
+	NeedToApplyParameterGroup bool           `json:"needToApplyParameterGroup"`
+	NeedMigration             bool           `json:"needMigration"`
+	OSVersion                 string         `json:"osVersion,omitempty"`
+	StorageType               string         `json:"storageType,omitempty"`
+	StorageSize               int            `json:"storageSize,omitempty"`
+	CreatedYmdt               timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt               timestamp.Time `json:"updatedYmdt"`
+}
+
+// Status returns the instance's status and the operation running on it.
+func (i DBInstance) Status() (InstanceStatus, ProgressStatus) {
+	return i.DBInstanceStatus, i.ProgressStatus
 }
 
 type DBInstancesResponse struct {
@@ -137,20 +186,20 @@ type DBInstanceResponse struct {
 
 // Create DB Instance Request
 type CreateDBInstanceRequest struct {
-	DBInstanceName          string   `json:"dbInstanceName"`
-	DBInstanceCandidateName string   `json:"dbInstanceCandidateName,omitempty"`
-	Description             string   `json:"description,omitempty"`
-	DBFlavorID              string   `json:"dbFlavorId"`
-	DBVersion               string   `json:"dbVersion"`
-	DBPort                  int      `json:"dbPort,omitempty"`
-	DatabaseName            string   `json:"databaseName"`
-	DBUserName              string   `json:"dbUserName"`
-	DBPassword              string   `json:"dbPassword"`
-	ParameterGroupID        string   `json:"parameterGroupId"`
-	DBSecurityGroupIDs      []string `json:"dbSecurityGroupIds,omitempty"`
-	UserGroupIDs            []string `json:"userGroupIds,omitempty"`
-	UseHighAvailability     bool     `json:"useHighAvailability,omitempty"`
-	UseDeletionProtection   bool     `json:"useDeletionProtection,omitempty"`
+	DBInstanceName          string        `json:"dbInstanceName"`
+	DBInstanceCandidateName string        `json:"dbInstanceCandidateName,omitempty"`
+	Description             string        `json:"description,omitempty"`
+	DBFlavorID              string        `json:"dbFlavorId"`
+	DBVersion               string        `json:"dbVersion"`
+	DBPort                  int           `json:"dbPort,omitempty"`
+	DatabaseName            string        `json:"databaseName"`
+	DBUserName              string        `json:"dbUserName"`
+	DBPassword              secret.String `json:"dbPassword"`
+	ParameterGroupID        string        `json:"parameterGroupId"`
+	DBSecurityGroupIDs      []string      `json:"dbSecurityGroupIds,omitempty"`
+	UserGroupIDs            []string      `json:"userGroupIds,omitempty"`
+	UseHighAvailability     bool          `json:"useHighAvailability,omitempty"`
+	UseDeletionProtection   bool          `json:"useDeletionProtection,omitempty"`
 	Network                 struct {
 		SubnetID         string `json:"subnetId"`
 		AvailabilityZone string `json:"availabilityZone,omitempty"`
@@ -178,29 +227,30 @@ type ModifyDBInstanceRequest struct {
 	ExecuteBackup      bool     `json:"executeBackup,omitempty"`
 }
 
+// StorageInfoResponse for GET /v1.0/db-instances/{dbInstanceId}/storage-info
+type StorageInfoResponse struct {
+	Header      *ResponseHeader `json:"header"`
+	StorageType string          `json:"storageType"`
+	StorageSize int             `json:"storageSize"`
+}
+
 // ModifyStorageInfoRequest for PUT /v1.0/db-instances/{dbInstanceId}/storage-info
 type ModifyStorageInfoRequest struct {
 	StorageSize int `json:"storageSize"`
 }
 
 // RestartInstanceRequest represents a request to restart a database instance
-type RestartInstanceRequest struct {
-	// UseOnlineFailover enables restart using failover (HA instances only)
-	// When true, minimizes downtime by failing over to standby before restart
-	UseOnlineFailover bool `json:"useOnlineFailover,omitempty"`
-	// ExecuteBackup triggers a backup before restart
-	ExecuteBackup bool `json:"executeBackup,omitempty"`
-}
+type RestartInstanceRequest = rdscore.RestartInstanceRequest
 
 // High Availability
 type HighAvailability struct {
-	UseHighAvailability bool      `json:"useHighAvailability"`
-	PingInterval        int       `json:"pingInterval"`
-	ReplicateStatus     string    `json:"replicateStatus"`
-	ReplicateDelay      int       `json:"replicateDelay"`
-	StandbyDBInstanceID string    `json:"standbyDbInstanceId"`
-	CreatedYmdt         time.Time `json:"createdYmdt"`
-	UpdatedYmdt         time.Time `json:"updatedYmdt"`
+	UseHighAvailability bool           `json:"useHighAvailability"`
+	PingInterval        int            `json:"pingInterval"`
+	ReplicateStatus     string         `json:"replicateStatus"`
+	ReplicateDelay      int            `json:"replicateDelay"`
+	StandbyDBInstanceID string         `json:"standbyDbInstanceId"`
+	CreatedYmdt         timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt         timestamp.Time `json:"updatedYmdt"`
 }
 
 type HighAvailabilityResponse struct {
@@ -239,15 +289,15 @@ type InstallExtensionRequest struct {
 
 // Database Management (PostgreSQL specific)
 type Database struct {
-	DatabaseID   string    `json:"databaseId"`
-	DatabaseName string    `json:"databaseName"`
-	Owner        string    `json:"owner"`
-	Encoding     string    `json:"encoding"`
-	Collate      string    `json:"collate"`
-	CType        string    `json:"ctype"`
-	Size         int64     `json:"size"`
-	TableCount   int       `json:"tableCount"`
-	CreatedYmdt  time.Time `json:"createdYmdt"`
+	DatabaseID   string         `json:"databaseId"`
+	DatabaseName string         `json:"databaseName"`
+	Owner        string         `json:"owner"`
+	Encoding     string         `json:"encoding"`
+	Collate      string         `json:"collate"`
+	CType        string         `json:"ctype"`
+	Size         int64          `json:"size"`
+	TableCount   int            `json:"tableCount"`
+	CreatedYmdt  timestamp.Time `json:"createdYmdt"`
 }
 
 type DatabasesResponse struct {
@@ -265,12 +315,12 @@ type CreateDatabaseRequest struct {
 
 // User Management
 type DBUser struct {
-	DBUserID      string    `json:"dbUserId"`
-	DBUserName    string    `json:"dbUserName"`
-	AuthorityType string    `json:"authorityType"`
-	DBUserStatus  string    `json:"dbUserStatus"`
-	CreatedYmdt   time.Time `json:"createdYmdt"`
-	UpdatedYmdt   time.Time `json:"updatedYmdt"`
+	DBUserID      string         `json:"dbUserId"`
+	DBUserName    string         `json:"dbUserName"`
+	AuthorityType string         `json:"authorityType"`
+	DBUserStatus  string         `json:"dbUserStatus"`
+	CreatedYmdt   timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt   timestamp.Time `json:"updatedYmdt"`
 }
 
 type DBUsersResponse struct {
@@ -279,15 +329,15 @@ type DBUsersResponse struct {
 }
 
 type CreateDBUserRequest struct {
-	DBUserName      string `json:"dbUserName"`
-	DBPassword      string `json:"dbPassword"`
-	AuthorityType   string `json:"authorityType,omitempty"`
-	IsSuperuser     bool   `json:"isSuperuser,omitempty"`
-	CanCreateDB     bool   `json:"canCreateDb,omitempty"`
-	CanCreateRole   bool   `json:"canCreateRole,omitempty"`
-	CanLogin        bool   `json:"canLogin,omitempty"`
-	IsReplication   bool   `json:"isReplication,omitempty"`
-	ConnectionLimit int    `json:"connectionLimit,omitempty"`
+	DBUserName      string        `json:"dbUserName"`
+	DBPassword      secret.String `json:"dbPassword"`
+	AuthorityType   string        `json:"authorityType,omitempty"`
+	IsSuperuser     bool          `json:"isSuperuser,omitempty"`
+	CanCreateDB     bool          `json:"canCreateDb,omitempty"`
+	CanCreateRole   bool          `json:"canCreateRole,omitempty"`
+	CanLogin        bool          `json:"canLogin,omitempty"`
+	IsReplication   bool          `json:"isReplication,omitempty"`
+	ConnectionLimit int           `json:"connectionLimit,omitempty"`
 }
 
 // HBA Rules (PostgreSQL specific)
@@ -305,6 +355,21 @@ type HBARule struct {
 	Applicable          bool       `json:"applicable"`
 }
 
+type ModifyHBARuleRequest struct {
+	ConnectionType    *string  `json:"connectionType,omitempty"`
+	DatabaseApplyType *string  `json:"databaseApplyType,omitempty"`
+	DBUserApplyType   *string  `json:"dbUserApplyType,omitempty"`
+	Address           *string  `json:"address,omitempty"`
+	AuthMethod        *string  `json:"authMethod,omitempty"`
+	DatabaseIds       []string `json:"databaseIds,omitempty"`
+	DBUserIds         []string `json:"dbUserIds,omitempty"`
+}
+
+type ReorderHBARulesRequest struct {
+	// HBARuleIDs lists every rule ID of the instance in the new order
+	HBARuleIDs []string `json:"hbaRuleIds"`
+}
+
 type HBARulesResponse struct {
 	Header   *ResponseHeader `json:"header"`
 	HBARules []HBARule       `json:"hbaRules"`
@@ -329,18 +394,18 @@ type CreateHBARuleRequest struct {
 
 // Backup
 type Backup struct {
-	BackupID       string    `json:"backupId"`
-	BackupName     string    `json:"backupName"`
-	DBInstanceID   string    `json:"dbInstanceId"`
-	DBInstanceName string    `json:"dbInstanceName"`
-	BackupType     string    `json:"backupType"`
-	BackupStatus   string    `json:"backupStatus"`
-	BackupSize     int64     `json:"backupSize"`
-	DBVersion      string    `json:"dbVersion"`
-	DBDataSize     int64     `json:"dbDataSize"`
-	BinLogPosition string    `json:"binLogPosition,omitempty"`
-	CreatedYmdt    time.Time `json:"createdYmdt"`
-	UpdatedYmdt    time.Time `json:"updatedYmdt"`
+	BackupID       string         `json:"backupId"`
+	BackupName     string         `json:"backupName"`
+	DBInstanceID   string         `json:"dbInstanceId"`
+	DBInstanceName string         `json:"dbInstanceName"`
+	BackupType     string         `json:"backupType"`
+	BackupStatus   BackupStatus   `json:"backupStatus"`
+	BackupSize     int64          `json:"backupSize"`
+	DBVersion      string         `json:"dbVersion"`
+	DBDataSize     int64          `json:"dbDataSize"`
+	BinLogPosition string         `json:"binLogPosition,omitempty"`
+	CreatedYmdt    timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt    timestamp.Time `json:"updatedYmdt"`
 }
 
 type BackupsResponse struct {
@@ -348,16 +413,16 @@ type BackupsResponse struct {
 	Backups []Backup        `json:"backups"`
 }
 
-type CreateBackupRequest struct {
-	BackupName string `json:"backupName"`
-}
+type CreateBackupRequest = rdscore.CreateBackupRequest
+
+type BackupToObjectStorageRequest = rdscore.BackupToObjectStorageRequest
 
 type ExportBackupRequest struct {
-	TenantID        string `json:"tenantId"`
-	UserName        string `json:"userName"`
-	Password        string `json:"password"`
-	TargetContainer string `json:"targetContainer"`
-	ObjectPath      string `json:"objectPath"`
+	TenantID        string        `json:"tenantId"`
+	UserName        string        `json:"userName"`
+	Password        secret.String `json:"password"`
+	TargetContainer string        `json:"targetContainer"`
+	ObjectPath      string        `json:"objectPath"`
 }
 
 type RestoreBackupRequest struct {
@@ -405,10 +470,10 @@ type DBSecurityGroup struct {
 	DBSecurityGroupName string                `json:"dbSecurityGroupName"`
 	Description         string                `json:"description,omitempty"`
 	IsDefault           bool                  `json:"isDefault"`
-	ProgressStatus      string                `json:"progressStatus"`
+	ProgressStatus      ProgressStatus        `json:"progressStatus"`
 	Rules               []DBSecurityGroupRule `json:"rules,omitempty"`
-	CreatedYmdt         time.Time             `json:"createdYmdt"`
-	UpdatedYmdt         time.Time             `json:"updatedYmdt"`
+	CreatedYmdt         timestamp.Time        `json:"createdYmdt"`
+	UpdatedYmdt         timestamp.Time        `json:"updatedYmdt"`
 }
 
 type DBSecurityGroupsResponse struct {
@@ -422,14 +487,14 @@ type DBSecurityGroupResponse struct {
 }
 
 type DBSecurityGroupRule struct {
-	RuleID      string    `json:"ruleId"`
-	Description string    `json:"description,omitempty"`
-	Direction   string    `json:"direction"`
-	EtherType   string    `json:"etherType"`
-	Port        *PortSpec `json:"port,omitempty"`
-	CIDR        string    `json:"cidr"`
-	CreatedYmdt time.Time `json:"createdYmdt"`
-	UpdatedYmdt time.Time `json:"updatedYmdt"`
+	RuleID      string         `json:"ruleId"`
+	Description string         `json:"description,omitempty"`
+	Direction   string         `json:"direction"`
+	EtherType   string         `json:"etherType"`
+	Port        *PortSpec      `json:"port,omitempty"`
+	CIDR        string         `json:"cidr"`
+	CreatedYmdt timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt timestamp.Time `json:"updatedYmdt"`
 }
 
 type CreateDBSecurityGroupRequest struct {
@@ -437,6 +502,11 @@ type CreateDBSecurityGroupRequest struct {
 	Description         string `json:"description,omitempty"`
 }
 
+type UpdateDBSecurityGroupRequest struct {
+	DBSecurityGroupName *string `json:"dbSecurityGroupName,omitempty"`
+	Description         *string `json:"description,omitempty"`
+}
+
 // PortSpec defines the port specification for security group rules
 type PortSpec struct {
 	PortType string `json:"portType"`
@@ -453,15 +523,23 @@ type CreateDBSecurityGroupRuleRequest struct {
 	CIDR        string    `json:"cidr"`
 }
 
+type UpdateDBSecurityGroupRuleRequest struct {
+	Description *string   `json:"description,omitempty"`
+	Direction   *string   `json:"direction,omitempty"`
+	EtherType   *string   `json:"etherType,omitempty"`
+	Port        *PortSpec `json:"port,omitempty"`
+	CIDR        *string   `json:"cidr,omitempty"`
+}
+
 // Parameter Groups
 type ParameterGroup struct {
-	ParameterGroupID   string    `json:"parameterGroupId"`
-	ParameterGroupName string    `json:"parameterGroupName"`
-	Description        string    `json:"description,omitempty"`
-	DBVersion          string    `json:"dbVersion"`
-	IsDefault          bool      `json:"isDefault"`
-	CreatedYmdt        time.Time `json:"createdYmdt"`
-	UpdatedYmdt        time.Time `json:"updatedYmdt"`
+	ParameterGroupID   string         `json:"parameterGroupId"`
+	ParameterGroupName string         `json:"parameterGroupName"`
+	Description        string         `json:"description,omitempty"`
+	DBVersion          string         `json:"dbVersion"`
+	IsDefault          bool           `json:"isDefault"`
+	CreatedYmdt        timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt        timestamp.Time `json:"updatedYmdt"`
 }
 
 type ParameterGroupsResponse struct {
@@ -489,27 +567,29 @@ type Parameter struct {
 	MaxValue      string `json:"maxValue,omitempty"`
 }
 
-type CreateParameterGroupRequest struct {
-	ParameterGroupName string `json:"parameterGroupName"`
-	Description        string `json:"description,omitempty"`
-	DBVersion          string `json:"dbVersion"`
-}
+type CreateParameterGroupRequest = rdscore.CreateParameterGroupRequest
+
+type CopyParameterGroupRequest = rdscore.CopyParameterGroupRequest
 
-type ModifyParametersRequest struct {
-	ModifiedParameters []struct {
-		ParameterID string `json:"parameterId"`
-		Value       string `json:"value"`
-	} `json:"modifiedParameters"`
+type UpdateParameterGroupRequest struct {
+	ParameterGroupName *string `json:"parameterGroupName,omitempty"`
+	Description        *string `json:"description,omitempty"`
 }
 
+type ParameterGroupIDResponse = rdscore.ParameterGroupIDResponse
+
+type ModifyParametersRequest = rdscore.ModifyParametersRequest
+
 // User Groups
 type UserGroup struct {
-	UserGroupID   string    `json:"userGroupId"`
-	UserGroupName string    `json:"userGroupName"`
-	Description   string    `json:"description,omitempty"`
-	UserIDs       []string  `json:"userIds"`
-	CreatedYmdt   time.Time `json:"createdYmdt"`
-	UpdatedYmdt   time.Time `json:"updatedYmdt"`
+	UserGroupID       string         `json:"userGroupId"`
+	UserGroupName     string         `json:"userGroupName"`
+	UserGroupTypeCode string         `json:"userGroupTypeCode,omitempty"`
+	Description       string         `json:"description,omitempty"`
+	UserIDs           []string       `json:"userIds"`
+	Members           []Member       `json:"members,omitempty"`
+	CreatedYmdt       timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt       timestamp.Time `json:"updatedYmdt"`
 }
 
 type UserGroupsResponse struct {
@@ -525,9 +605,15 @@ type UserGroupResponse struct {
 type CreateUserGroupRequest struct {
 	UserGroupName string   `json:"userGroupName"`
 	Description   string   `json:"description,omitempty"`
-	UserIDs       []string `json:"userIds"`
+	UserIDs       []string `json:"userIds,omitempty"`
+	MemberIDs     []string `json:"memberIds,omitempty"`
+	SelectAllYN   bool     `json:"selectAllYN,omitempty"`
 }
 
+type UpdateUserGroupRequest = rdscore.UpdateUserGroupRequest
+
+type UserGroupIDResponse = rdscore.UserGroupIDResponse
+
 // Notification Groups
 type NotificationGroup struct {
 	NotificationGroupID   string `json:"notificationGroupId"`
@@ -539,8 +625,8 @@ type NotificationGroup struct {
 		RecipientType string `json:"recipientType"`
 		Recipient     string `json:"recipient"`
 	} `json:"recipients"`
-	CreatedYmdt time.Time `json:"createdYmdt"`
-	UpdatedYmdt time.Time `json:"updatedYmdt"`
+	CreatedYmdt timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt timestamp.Time `json:"updatedYmdt"`
 }
 
 type NotificationGroupsResponse struct {
@@ -553,26 +639,24 @@ type NotificationGroupResponse struct {
 	NotificationGroup
 }
 
-type CreateNotificationGroupRequest struct {
-	NotificationGroupName string `json:"notificationGroupName"`
-	Description           string `json:"description,omitempty"`
-	NotificationType      string `json:"notificationType"`
-	IsEnabled             bool   `json:"isEnabled"`
-	Recipients            []struct {
-		RecipientType string `json:"recipientType"`
-		Recipient     string `json:"recipient"`
-	} `json:"recipients"`
+type CreateNotificationGroupRequest = rdscore.CreateNotificationGroupRequest
+
+type UpdateNotificationGroupRequest struct {
+	NotificationGroupName *string  `json:"notificationGroupName,omitempty"`
+	IsEnabled             *bool    `json:"isEnabled,omitempty"`
+	NotifyEmail           []string `json:"notifyEmail,omitempty"`
+	NotifySms             []string `json:"notifySms,omitempty"`
 }
 
 // Watchdog (PostgreSQL specific)
 type Watchdog struct {
-	WatchdogID   string    `json:"watchdogId"`
-	WatchdogName string    `json:"watchdogName"`
-	DBInstanceID string    `json:"dbInstanceId"`
-	IsEnabled    bool      `json:"isEnabled"`
-	QueryTimeout int       `json:"queryTimeout"`
-	CreatedYmdt  time.Time `json:"createdYmdt"`
-	UpdatedYmdt  time.Time `json:"updatedYmdt"`
+	WatchdogID   string         `json:"watchdogId"`
+	WatchdogName string         `json:"watchdogName"`
+	DBInstanceID string         `json:"dbInstanceId"`
+	IsEnabled    bool           `json:"isEnabled"`
+	QueryTimeout int            `json:"queryTimeout"`
+	CreatedYmdt  timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt  timestamp.Time `json:"updatedYmdt"`
 }
 
 type WatchdogsResponse struct {
@@ -600,8 +684,8 @@ type MetricsResponse struct {
 }
 
 type MetricStatistic struct {
-	Timestamp string  `json:"timestamp"`
-	Value     float64 `json:"value"`
+	Timestamp timestamp.Time `json:"timestamp"`
+	Value     float64        `json:"value"`
 }
 
 type MetricStatisticsResponse struct {
@@ -610,15 +694,15 @@ type MetricStatisticsResponse struct {
 }
 
 type Event struct {
-	EventID      string    `json:"eventId"`
-	EventCode    string    `json:"eventCode"`
-	EventName    string    `json:"eventName"`
-	Category     string    `json:"category"`
-	DBInstanceID string    `json:"dbInstanceId,omitempty"`
-	SourceType   string    `json:"sourceType"`
-	SourceID     string    `json:"sourceId,omitempty"`
-	Message      string    `json:"message"`
-	EventYmdt    time.Time `json:"eventYmdt"`
+	EventID      string         `json:"eventId"`
+	EventCode    string         `json:"eventCode"`
+	EventName    string         `json:"eventName"`
+	Category     string         `json:"category"`
+	DBInstanceID string         `json:"dbInstanceId,omitempty"`
+	SourceType   string         `json:"sourceType"`
+	SourceID     string         `json:"sourceId,omitempty"`
+	Message      string         `json:"message"`
+	EventYmdt    timestamp.Time `json:"eventYmdt"`
 }
 
 type EventsResponse struct {
@@ -650,20 +734,17 @@ type MonitoringItemsResponse struct {
 }
 
 // Job Response
-type JobIDResponse struct {
-	Header *ResponseHeader `json:"header"`
-	JobID  string          `json:"jobId"`
-}
+type JobIDResponse = rdscore.JobIDResponse
 
 // Job Details
 type JobDetail struct {
-	JobID        string    `json:"jobId"`
-	JobType      string    `json:"jobType"`
-	JobStatus    string    `json:"jobStatus"`
-	ResourceID   string    `json:"resourceId"`
-	ResourceName string    `json:"resourceName"`
-	CreatedYmdt  time.Time `json:"createdYmdt"`
-	UpdatedYmdt  time.Time `json:"updatedYmdt"`
+	JobID        string         `json:"jobId"`
+	JobType      string         `json:"jobType"`
+	JobStatus    string         `json:"jobStatus"`
+	ResourceID   string         `json:"resourceId"`
+	ResourceName string         `json:"resourceName"`
+	CreatedYmdt  timestamp.Time `json:"createdYmdt"`
+	UpdatedYmdt  timestamp.Time `json:"updatedYmdt"`
 }
 
 type JobDetailResponse struct {
@@ -682,9 +763,9 @@ type NetworkInfoResponse struct {
 	EndPoints []NetworkEndpoint `json:"endPoints"`
 }
 
-type ModifyDeletionProtectionRequest struct {
-	UseDeletionProtection bool `json:"useDeletionProtection"`
-}
+type ModifyNetworkInfoRequest = rdscore.ModifyNetworkInfoRequest
+
+type ModifyDeletionProtectionRequest = rdscore.ModifyDeletionProtectionRequest
 
 type ModifyHighAvailabilityRequest struct {
 	UseHighAvailability bool `json:"useHighAvailability"`
@@ -706,17 +787,9 @@ type CreateReplicaRequest struct {
 	Network               *ReplicaNetwork `json:"network"`
 }
 
-type LogFile struct {
-	LogFileName string    `json:"logFileName"`
-	LogFileSize int64     `json:"logFileSize"`
-	CreatedYmdt time.Time `json:"createdYmdt"`
-	UpdatedYmdt time.Time `json:"updatedYmdt"`
-}
+type LogFile = rdscore.LogFile
 
-type LogFilesResponse struct {
-	Header   *ResponseHeader `json:"header"`
-	LogFiles []LogFile       `json:"logFiles"`
-}
+type LogFilesResponse = rdscore.LogFilesResponse
 
 type CreateInstanceGroupRequest struct {
 	DBInstanceGroupName string `json:"dbInstanceGroupName"`
@@ -795,3 +868,16 @@ type NotificationGroupOutput = NotificationGroupResponse
 type CreateNotificationGroupInput = CreateNotificationGroupRequest
 type NotificationGroupIDOutput = JobIDResponse
 type ListLogFilesOutput = LogFilesResponse
+type UpdateSecurityGroupInput = UpdateDBSecurityGroupRequest
+type CopyParameterGroupInput = CopyParameterGroupRequest
+type UpdateParameterGroupInput = UpdateParameterGroupRequest
+type BackupToObjectStorageInput = BackupToObjectStorageRequest
+type UpdateNotificationGroupInput = UpdateNotificationGroupRequest
+type ModifyNetworkInfoInput = ModifyNetworkInfoRequest
+type StorageInfoOutput = StorageInfoResponse
+type ListUserGroupsOutput = UserGroupsResponse
+type UserGroupOutput = UserGroupResponse
+type CreateUserGroupInput = CreateUserGroupRequest
+type UpdateUserGroupInput = UpdateUserGroupRequest
+type UserGroupIDOutput = UserGroupIDResponse
+type UpdateSecurityGroupRuleInput = UpdateDBSecurityGroupRuleRequest
@@ -9,6 +9,12 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 const DefaultBaseURL = "https://resource-watcher.api.nhncloudservice.com"
@@ -21,26 +27,46 @@ type Client struct {
 	secretKey   string
 	httpClient  *http.Client
 	debug       bool
+	drift       *drift.Detector
 }
 
-// NewClient creates a new Resource Watcher client
-// appKey: The app key for the Resource Watcher service
-// accessKeyID: User Access Key ID for authentication
-// secretKey: Secret Access Key for authentication
-func NewClient(appKey, accessKeyID, secretKey string, httpClient *http.Client, debug bool) *Client {
-	if httpClient == nil {
-		httpClient = &http.Client{Timeout: 30 * time.Second}
+// New creates a Resource Watcher client that authenticates with the User
+// Access Key in creds. creds may be nil for operations that only need the
+// app key. A nil httpClient uses a client with a 30s timeout.
+func New(appKey string, creds credentials.Credentials, httpClient *http.Client, debug bool, opts ...option.Option) *Client {
+	settings := option.Apply(opts...)
+	if settings.HTTPClient == nil {
+		settings.HTTPClient = httpClient
 	}
+	accessKeyID, secretKey := credentials.UserAccessKey(creds)
 	return &Client{
 		baseURL:     DefaultBaseURL,
 		appKey:      appKey,
 		accessKeyID: accessKeyID,
 		secretKey:   secretKey,
-		httpClient:  httpClient,
+		httpClient:  client.HTTPClient(settings, &http.Client{Timeout: 30 * time.Second}),
+		drift:       client.Drift(settings),
 		debug:       debug,
 	}
 }
 
+// NewClient creates a new Resource Watcher client from a User Access Key.
+//
+// Deprecated: Use New, which takes the key as credentials and accepts the
+// shared options.
+func NewClient(appKey, accessKeyID, secretKey string, httpClient *http.Client, debug bool) *Client {
+	return New(appKey, credentials.NewStatic(accessKeyID, secretKey), httpClient, debug)
+}
+
+// decode unmarshals a response body into v, checking it for unmapped
+// fields first when drift detection is enabled.
+func (c *Client) decode(body []byte, v interface{}) error {
+	if err := c.drift.Check("resourcewatcher", "", body, v); err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
 // buildPath constructs the full API path
 func (c *Client) buildPath(version, resource string) string {
 	return fmt.Sprintf("/resource-watcher/%s/appkeys/%s/%s", version, c.appKey, resource)
@@ -56,7 +82,7 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		}
 		reqBody = bytes.NewReader(jsonData)
 		if c.debug {
-			fmt.Printf("[DEBUG] Request body: %s\n", string(jsonData))
+			fmt.Printf("[DEBUG] Request body: %s\n", secret.RedactJSON(jsonData))
 		}
 	}
 
@@ -87,11 +113,11 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 
 	if c.debug {
 		fmt.Printf("[DEBUG] Response status: %d\n", resp.StatusCode)
-		fmt.Printf("[DEBUG] Response body: %s\n", string(respBody))
+		fmt.Printf("[DEBUG] Response body: %s\n", secret.RedactJSON(respBody))
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, secret.RedactJSON(respBody))
 	}
 
 	return respBody, nil
@@ -109,7 +135,7 @@ func (c *Client) CreateEventAlarm(ctx context.Context, input *CreateEventAlarmIn
 	}
 
 	var result CreateEventAlarmOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
@@ -126,7 +152,7 @@ func (c *Client) GetEventAlarm(ctx context.Context, alarmID string) (*GetEventAl
 	}
 
 	var result GetEventAlarmOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
@@ -143,7 +169,7 @@ func (c *Client) SearchEventAlarms(ctx context.Context, input *SearchEventAlarms
 	}
 
 	var result SearchEventAlarmsOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
@@ -160,7 +186,7 @@ func (c *Client) UpdateEventAlarm(ctx context.Context, alarmID string, input *Up
 	}
 
 	var result SimpleOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
@@ -177,7 +203,7 @@ func (c *Client) DeleteEventAlarm(ctx context.Context, alarmID string) (*SimpleO
 	}
 
 	var result SimpleOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
@@ -194,7 +220,7 @@ func (c *Client) DeleteEventAlarms(ctx context.Context, input *DeleteEventAlarms
 	}
 
 	var result SimpleOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
@@ -213,7 +239,7 @@ func (c *Client) GetAlarmHistory(ctx context.Context, alarmID, historyID string)
 	}
 
 	var result GetAlarmHistoryOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
@@ -230,7 +256,7 @@ func (c *Client) SearchAlarmHistory(ctx context.Context, alarmID string, input *
 	}
 
 	var result SearchAlarmHistoryOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
@@ -249,7 +275,7 @@ func (c *Client) ListEvents(ctx context.Context) (*ListEventsOutput, error) {
 	}
 
 	var result ListEventsOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
@@ -266,7 +292,7 @@ func (c *Client) GetEvent(ctx context.Context, productID, eventID string) (*GetE
 	}
 
 	var result GetEventOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
@@ -285,7 +311,7 @@ func (c *Client) ListResourceGroups(ctx context.Context) (*ListResourceGroupsOut
 	}
 
 	var result ListResourceGroupsOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
@@ -304,7 +330,7 @@ func (c *Client) ListResourceTags(ctx context.Context) (*ListResourceTagsOutput,
 	}
 
 	var result ListResourceTagsOutput
-	if err := json.Unmarshal(data, &result); err != nil {
+	if err := c.decode(data, &result); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
@@ -1,6 +1,8 @@
 // Package resourcewatcher provides Resource Watcher (Governance) service client
 package resourcewatcher
 
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
 // Header represents the standard NHN Cloud API response header
 type Header struct {
 	IsSuccessful  bool   `json:"isSuccessful"`
@@ -10,16 +12,16 @@ type Header struct {
 
 // EventAlarm represents an event alarm configuration
 type EventAlarm struct {
-	AlarmID          string        `json:"alarmId"`
-	AlarmName        string        `json:"alarmName"`
-	AlarmDescription string        `json:"alarmDescription,omitempty"`
-	AlarmStatusCode  string        `json:"alarmStatusCode"` // STABLE, DISABLED, CLOSED
-	EventRuleID      string        `json:"eventRuleId,omitempty"`
-	ResourceGroupID  string        `json:"resourceGroupId,omitempty"`
-	ResourceTagID    string        `json:"resourceTagId,omitempty"`
-	Targets          []AlarmTarget `json:"targets,omitempty"`
-	CreatedDateTime  string        `json:"createdDateTime,omitempty"`
-	UpdatedDateTime  string        `json:"updatedDateTime,omitempty"`
+	AlarmID          string         `json:"alarmId"`
+	AlarmName        string         `json:"alarmName"`
+	AlarmDescription string         `json:"alarmDescription,omitempty"`
+	AlarmStatusCode  string         `json:"alarmStatusCode"` // STABLE, DISABLED, CLOSED
+	EventRuleID      string         `json:"eventRuleId,omitempty"`
+	ResourceGroupID  string         `json:"resourceGroupId,omitempty"`
+	ResourceTagID    string         `json:"resourceTagId,omitempty"`
+	Targets          []AlarmTarget  `json:"targets,omitempty"`
+	CreatedDateTime  timestamp.Time `json:"createdDateTime,omitempty"`
+	UpdatedDateTime  timestamp.Time `json:"updatedDateTime,omitempty"`
 }
 
 // AlarmTarget represents an alarm notification target
@@ -96,15 +98,15 @@ type AlarmHistory struct {
 	ProductID        string            `json:"productId"`
 	EventName        string            `json:"eventName"`
 	AlarmSendResults []AlarmSendResult `json:"alarmSendResults,omitempty"`
-	CreatedDateTime  string            `json:"createdDateTime"`
+	CreatedDateTime  timestamp.Time    `json:"createdDateTime"`
 }
 
 // AlarmSendResult represents the result of sending an alarm notification
 type AlarmSendResult struct {
-	TargetType   string `json:"targetType"`
-	TargetID     string `json:"targetId"`
-	SendStatus   string `json:"sendStatus"`
-	SentDateTime string `json:"sentDateTime,omitempty"`
+	TargetType   string         `json:"targetType"`
+	TargetID     string         `json:"targetId"`
+	SendStatus   string         `json:"sendStatus"`
+	SentDateTime timestamp.Time `json:"sentDateTime,omitempty"`
 }
 
 // GetAlarmHistoryOutput represents the response for alarm history API
@@ -115,10 +117,10 @@ type GetAlarmHistoryOutput struct {
 
 // SearchAlarmHistoryInput represents the request body for searching alarm history
 type SearchAlarmHistoryInput struct {
-	StartDateTime string `json:"startDateTime,omitempty"`
-	EndDateTime   string `json:"endDateTime,omitempty"`
-	Page          int    `json:"page,omitempty"`
-	Size          int    `json:"size,omitempty"`
+	StartDateTime timestamp.Time `json:"startDateTime,omitempty"`
+	EndDateTime   timestamp.Time `json:"endDateTime,omitempty"`
+	Page          int            `json:"page,omitempty"`
+	Size          int            `json:"size,omitempty"`
 }
 
 // SearchAlarmHistoryOutput represents the response for alarm history search API
@@ -151,10 +153,10 @@ type GetEventOutput struct {
 
 // ResourceGroup represents a resource group
 type ResourceGroup struct {
-	ResourceGroupID   string `json:"resourceGroupId"`
-	ResourceGroupName string `json:"resourceGroupName"`
-	Description       string `json:"description,omitempty"`
-	CreatedDateTime   string `json:"createdDateTime,omitempty"`
+	ResourceGroupID   string         `json:"resourceGroupId"`
+	ResourceGroupName string         `json:"resourceGroupName"`
+	Description       string         `json:"description,omitempty"`
+	CreatedDateTime   timestamp.Time `json:"createdDateTime,omitempty"`
 }
 
 // ListResourceGroupsOutput represents the response for resource groups list API
@@ -165,11 +167,11 @@ type ListResourceGroupsOutput struct {
 
 // ResourceTag represents a resource tag
 type ResourceTag struct {
-	ResourceTagID   string `json:"resourceTagId"`
-	ResourceTagName string `json:"tagName"`
-	TagKey          string `json:"tagKey"`
-	TagValue        string `json:"tagValue,omitempty"`
-	CreatedDateTime string `json:"createdDateTime,omitempty"`
+	ResourceTagID   string         `json:"resourceTagId"`
+	ResourceTagName string         `json:"tagName"`
+	TagKey          string         `json:"tagKey"`
+	TagValue        string         `json:"tagValue,omitempty"`
+	CreatedDateTime timestamp.Time `json:"createdDateTime,omitempty"`
 }
 
 // ListResourceTagsOutput represents the response for resource tags list API
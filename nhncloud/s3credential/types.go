@@ -1,16 +1,19 @@
 // Package s3credential provides S3 Credential service types and client
 package s3credential
 
-import "time"
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
 
 // S3Credential represents S3 API credential
 type S3Credential struct {
-	Access     string    `json:"access"`
-	Secret     string    `json:"secret"`
-	UserID     string    `json:"user_id"`
-	TenantID   string    `json:"tenant_id"`
-	CreatedAt  time.Time `json:"created_at"`
-	AccessedAt time.Time `json:"accessed_at"`
+	Access     string         `json:"access"`
+	Secret     secret.String  `json:"secret"`
+	UserID     string         `json:"user_id"`
+	TenantID   string         `json:"tenant_id"`
+	CreatedAt  timestamp.Time `json:"created_at"`
+	AccessedAt timestamp.Time `json:"accessed_at"`
 }
 
 // ListCredentialsOutput represents the response for list credentials
@@ -0,0 +1,146 @@
+// Package secret provides the string type used for every credential field
+// in the SDK's request and response types, such as passwords, secret keys
+// and Key Manager secrets.
+//
+// A String prints as "[REDACTED]" with every fmt verb, including %+v and
+// %#v of the structs that hold it, and in log/slog output, so logging an
+// input or output does not leak what it carries. It encodes to JSON as the
+// plain value, so requests send it and responses decode into it as usual;
+// the flip side is that JSON-encoding a struct that holds one, as slog's
+// JSONHandler does with a struct attribute, shows the value. Reveal returns
+// the value:
+//
+//	out, err := client.IAM().CreateUserAccessKey(ctx)
+//	fmt.Printf("%+v\n", out)                           // {... SecretAccessKey:[REDACTED] ...}
+//	save(out.UserAccessKey.SecretAccessKey.Reveal())
+package secret
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+)
+
+// Redacted is what a String prints as.
+const Redacted = "[REDACTED]"
+
+// String is a string that prints as Redacted but encodes to JSON as its
+// value. The zero String is empty and prints as "".
+type String string
+
+// Reveal returns the value.
+func (s String) Reveal() string { return string(s) }
+
+// IsZero reports whether s is empty.
+func (s String) IsZero() bool { return s == "" }
+
+func (s String) String() string {
+	if s == "" {
+		return ""
+	}
+	return Redacted
+}
+
+func (s String) GoString() string { return fmt.Sprintf("%q", s.String()) }
+
+// Format applies to every verb, so %x and %q do not reveal the value either.
+func (s String) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprint(f, s.GoString())
+		return
+	}
+	fmt.Fprint(f, s.String())
+}
+
+func (s String) LogValue() slog.Value { return slog.StringValue(s.String()) }
+
+// MarshalJSON encodes the value itself.
+func (s String) MarshalJSON() ([]byte, error) { return json.Marshal(string(s)) }
+
+// sensitive are the substrings of lower-cased JSON keys and form fields
+// whose values RedactJSON and RedactForm hide.
+var sensitive = []string{"password", "passwd", "secret", "token", "credential", "privatekey", "keyvalue", "plaintext", "plaindatakey"}
+
+// IsSensitive reports whether a field named name, such as "dbPassword" or
+// "secretAccessKey", holds credential material.
+func IsSensitive(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range sensitive {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactJSON returns data with the value of every sensitive key replaced by
+// Redacted, at any depth, for logging request and response bodies and
+// quoting them in errors. Data that is not JSON is returned unchanged.
+func RedactJSON(data []byte) []byte {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil || dec.More() {
+		return data
+	}
+	redacted, changed := redactValue(v)
+	if !changed {
+		return data
+	}
+	// Keep the layout of indented bodies, which debug logging prints.
+	var out []byte
+	var err error
+	if bytes.ContainsRune(bytes.TrimSpace(data), '\n') {
+		out, err = json.MarshalIndent(redacted, "", "  ")
+	} else {
+		out, err = json.Marshal(redacted)
+	}
+	if err != nil {
+		return data
+	}
+	return out
+}
+
+func redactValue(v interface{}) (interface{}, bool) {
+	changed := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, elem := range v {
+			if IsSensitive(k) {
+				if elem != nil && elem != "" {
+					v[k] = Redacted
+					changed = true
+				}
+				continue
+			}
+			if r, ok := redactValue(elem); ok {
+				v[k] = r
+				changed = true
+			}
+		}
+	case []interface{}:
+		for i, elem := range v {
+			if r, ok := redactValue(elem); ok {
+				v[i] = r
+				changed = true
+			}
+		}
+	}
+	return v, changed
+}
+
+// RedactForm returns a copy of values with every sensitive field replaced
+// by Redacted.
+func RedactForm(values url.Values) url.Values {
+	out := make(url.Values, len(values))
+	for k, vs := range values {
+		if IsSensitive(k) {
+			vs = []string{Redacted}
+		}
+		out[k] = vs
+	}
+	return out
+}
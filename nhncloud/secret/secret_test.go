@@ -0,0 +1,90 @@
+package secret
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const value = "hunter2-s3cr3t"
+
+type output struct {
+	Name     string  `json:"name"`
+	Password String  `json:"password"`
+	Token    *String `json:"token,omitempty"`
+}
+
+func TestStringRedacts(t *testing.T) {
+	token := String(value)
+	out := output{Name: "db", Password: value, Token: &token}
+	for _, format := range []string{"%v", "%+v", "%#v", "%s", "%q", "%x", "%X", "%d", "%10.3s"} {
+		if got := fmt.Sprintf(format, out); strings.Contains(got, value) || strings.Contains(got, fmt.Sprintf("%x", value)) {
+			t.Errorf("%s of the struct = %s", format, got)
+		}
+		if got := fmt.Sprintf(format, out.Password); strings.Contains(got, value) || strings.Contains(got, fmt.Sprintf("%x", value)) {
+			t.Errorf("%s = %s", format, got)
+		}
+	}
+	if got := fmt.Sprintf("%+v", out); !strings.Contains(got, "Password:"+Redacted) {
+		t.Errorf("%%+v = %s", got)
+	}
+	if got := fmt.Sprint(String("")); got != "" {
+		t.Errorf("empty String prints %q", got)
+	}
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("created", "password", out.Password)
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("created", "password", out.Password, "out", out)
+	if strings.Contains(buf.String(), value) || !strings.Contains(buf.String(), Redacted) {
+		t.Errorf("slog output = %s", buf.String())
+	}
+}
+
+func TestStringJSON(t *testing.T) {
+	data, err := json.Marshal(output{Name: "db", Password: value})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"name":"db","password":"` + value + `"}`; string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+	var out output
+	if err := json.Unmarshal([]byte(`{"password":"`+value+`","token":"t"}`), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Password.Reveal() != value || out.Token.Reveal() != "t" {
+		t.Errorf("Unmarshal = %q, %q", out.Password.Reveal(), out.Token.Reveal())
+	}
+}
+
+func TestRedactJSON(t *testing.T) {
+	in := `{"header":{"resultMessage":"bad"},"body":{"users":[{"dbUserName":"app","dbPassword":"` + value + `"}],` +
+		`"access":{"token":{"id":"` + value + `"}},"userAccessKey":{"userAccessKeyId":"AK","secretAccessKey":"` + value + `"},"count":12345678901234567890}}`
+	got := string(RedactJSON([]byte(in)))
+	if strings.Contains(got, value) {
+		t.Errorf("RedactJSON = %s", got)
+	}
+	for _, keep := range []string{`"resultMessage":"bad"`, `"dbUserName":"app"`, `"userAccessKeyId":"AK"`, `12345678901234567890`} {
+		if !strings.Contains(got, keep) {
+			t.Errorf("RedactJSON dropped %s: %s", keep, got)
+		}
+	}
+
+	for _, in := range []string{`{"message":"not found"}`, `<html>502</html>`, ``} {
+		if got := string(RedactJSON([]byte(in))); got != in {
+			t.Errorf("RedactJSON(%s) = %s", in, got)
+		}
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}, "client_secret": {value}}
+	if got := RedactForm(form).Encode(); strings.Contains(got, value) || !strings.Contains(got, "grant_type=client_credentials") {
+		t.Errorf("RedactForm = %s", got)
+	}
+	if form.Get("client_secret") != value {
+		t.Error("RedactForm changed its argument")
+	}
+}
@@ -10,6 +10,12 @@ import (
 	"net/url"
 	"path"
 	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 type Client struct {
@@ -19,22 +25,38 @@ type Client struct {
 	userAccessKeyID string
 	secretAccessKey string
 	debug           bool
+	drift           *drift.Detector
 }
 
-func NewClient(region, appKey, userAccessKeyID, secretAccessKey string, debug bool) *Client {
-	baseURL := "https://api-keymanager.nhncloudservice.com"
+// New creates a Secure Key Manager client that authenticates with the User
+// Access Key in creds. Key Manager is a global service, so no region is
+// needed. A nil hc uses a client with a 30s timeout.
+func New(appKey string, creds credentials.Credentials, hc *http.Client, debug bool, opts ...option.Option) *Client {
+	settings := option.Apply(opts...)
+	if settings.HTTPClient == nil {
+		settings.HTTPClient = hc
+	}
+	accessKeyID, secretKey := credentials.UserAccessKey(creds)
 	return &Client{
-		baseURL:         baseURL,
+		baseURL:         "https://api-keymanager.nhncloudservice.com",
 		appKey:          appKey,
-		userAccessKeyID: userAccessKeyID,
-		secretAccessKey: secretAccessKey,
+		userAccessKeyID: accessKeyID,
+		secretAccessKey: secretKey,
 		debug:           debug,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+		httpClient:      client.HTTPClient(settings, &http.Client{Timeout: 30 * time.Second}),
+		drift:           client.Drift(settings),
 	}
 }
 
+// NewClient creates a Secure Key Manager client from a User Access Key. The
+// region is ignored.
+//
+// Deprecated: Use New, which takes the key as credentials and accepts the
+// shared options.
+func NewClient(region, appKey, userAccessKeyID, secretAccessKey string, debug bool) *Client {
+	return New(appKey, credentials.NewStatic(userAccessKeyID, secretAccessKey), nil, debug)
+}
+
 func (c *Client) request(ctx context.Context, method, endpoint string, body interface{}, result interface{}) error {
 	u, err := url.Parse(c.baseURL)
 	if err != nil {
@@ -81,15 +103,18 @@ func (c *Client) request(ctx context.Context, method, endpoint string, body inte
 	if c.debug {
 		fmt.Printf("=== RESPONSE ===\n")
 		fmt.Printf("Status: %s\n", resp.Status)
-		fmt.Printf("Body: %s\n", string(respBody))
+		fmt.Printf("Body: %s\n", secret.RedactJSON(respBody))
 		fmt.Printf("================\n")
 	}
 
 	if resp.StatusCode >= 400 {
-		return fmt.Errorf("API error: %s - %s", resp.Status, string(respBody))
+		return fmt.Errorf("API error: %s - %s", resp.Status, secret.RedactJSON(respBody))
 	}
 
 	if result != nil && len(respBody) > 0 {
+		if err := c.drift.Check("keymanager", "", respBody, result); err != nil {
+			return err
+		}
 		if err := json.Unmarshal(respBody, result); err != nil {
 			return fmt.Errorf("failed to unmarshal response: %w", err)
 		}
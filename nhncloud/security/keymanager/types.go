@@ -1,5 +1,10 @@
 package keymanager
 
+import (
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
 // APIResponse wrapper for Key Manager API responses
 type APIResponse struct {
 	Header struct {
@@ -12,13 +17,13 @@ type APIResponse struct {
 // ============== Key Store Types ==============
 
 type KeyStore struct {
-	KeyStoreID  string `json:"keyStoreId"`
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	CreatedAt   string `json:"createdAt,omitempty"`
-	UpdatedAt   string `json:"updatedAt,omitempty"`
-	KeyCount    int    `json:"keyCount,omitempty"`
-	Status      string `json:"status,omitempty"`
+	KeyStoreID  string         `json:"keyStoreId"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	CreatedAt   timestamp.Time `json:"createdAt,omitempty"`
+	UpdatedAt   timestamp.Time `json:"updatedAt,omitempty"`
+	KeyCount    int            `json:"keyCount,omitempty"`
+	Status      string         `json:"status,omitempty"`
 }
 
 type ListKeyStoresOutput struct {
@@ -38,19 +43,19 @@ type GetKeyStoreOutput struct {
 // ============== Key Types ==============
 
 type Key struct {
-	KeyID          string `json:"keyId"`
-	KeyStoreID     string `json:"keyStoreId,omitempty"`
-	Name           string `json:"name"`
-	Description    string `json:"description,omitempty"`
-	KeyType        string `json:"keyType"` // secrets, symmetric-keys, asymmetric-keys
-	KeyAlgorithm   string `json:"keyAlgorithm,omitempty"`
-	KeySize        int    `json:"keySize,omitempty"`
-	CreatedAt      string `json:"createdAt,omitempty"`
-	UpdatedAt      string `json:"updatedAt,omitempty"`
-	ExpirationDate string `json:"expirationDate,omitempty"`
-	DeletionDate   string `json:"deletionDate,omitempty"`
-	Status         string `json:"status,omitempty"`
-	RotationPeriod int    `json:"rotationPeriod,omitempty"`
+	KeyID          string         `json:"keyId"`
+	KeyStoreID     string         `json:"keyStoreId,omitempty"`
+	Name           string         `json:"name"`
+	Description    string         `json:"description,omitempty"`
+	KeyType        string         `json:"keyType"` // secrets, symmetric-keys, asymmetric-keys
+	KeyAlgorithm   string         `json:"keyAlgorithm,omitempty"`
+	KeySize        int            `json:"keySize,omitempty"`
+	CreatedAt      timestamp.Time `json:"createdAt,omitempty"`
+	UpdatedAt      timestamp.Time `json:"updatedAt,omitempty"`
+	ExpirationDate timestamp.Time `json:"expirationDate,omitempty"`
+	DeletionDate   timestamp.Time `json:"deletionDate,omitempty"`
+	Status         string         `json:"status,omitempty"`
+	RotationPeriod int            `json:"rotationPeriod,omitempty"`
 }
 
 type ListKeysOutput struct {
@@ -72,7 +77,7 @@ type GetKeyOutput struct {
 type GetSecretOutput struct {
 	APIResponse
 	Body struct {
-		Secret string `json:"secret"`
+		Secret secret.String `json:"secret"`
 	} `json:"body"`
 }
 
@@ -81,13 +86,13 @@ type GetSecretOutput struct {
 type GetSymmetricKeyOutput struct {
 	APIResponse
 	Body struct {
-		KeyValue string `json:"keyValue"`
+		KeyValue secret.String `json:"keyValue"`
 	} `json:"body"`
 }
 
 type EncryptInput struct {
-	Plaintext string `json:"plaintext"`
-	AAD       string `json:"aad,omitempty"` // Additional Authenticated Data
+	Plaintext secret.String `json:"plaintext"`
+	AAD       string        `json:"aad,omitempty"` // Additional Authenticated Data
 }
 
 type EncryptOutput struct {
@@ -109,15 +114,15 @@ type DecryptInput struct {
 type DecryptOutput struct {
 	APIResponse
 	Body struct {
-		Plaintext string `json:"plaintext"`
+		Plaintext secret.String `json:"plaintext"`
 	} `json:"body"`
 }
 
 type CreateLocalKeyOutput struct {
 	APIResponse
 	Body struct {
-		PlainDataKey     string `json:"plainDataKey"`
-		EncryptedDataKey string `json:"encryptedDataKey"`
+		PlainDataKey     secret.String `json:"plainDataKey"`
+		EncryptedDataKey string        `json:"encryptedDataKey"`
 	} `json:"body"`
 }
 
@@ -126,7 +131,7 @@ type CreateLocalKeyOutput struct {
 type GetPrivateKeyOutput struct {
 	APIResponse
 	Body struct {
-		PrivateKey string `json:"privateKey"`
+		PrivateKey secret.String `json:"privateKey"`
 	} `json:"body"`
 }
 
@@ -163,14 +168,14 @@ type VerifyOutput struct {
 // ============== Key Management Types ==============
 
 type CreateKeyInput struct {
-	KeyStoreName   string `json:"keyStoreName"`
-	Name           string `json:"name"`
-	Description    string `json:"description,omitempty"`
-	KeyAlgorithm   string `json:"keyAlgorithm,omitempty"` // AES256 for symmetric
-	KeySize        int    `json:"keySize,omitempty"`
-	Algorithm      string `json:"algorithm,omitempty"`      // RSA2048, RSA4096, EC_P256, EC_P384 for asymmetric
-	Secret         string `json:"secret,omitempty"`         // For secrets
-	RotationPeriod int    `json:"rotationPeriod,omitempty"` // days
+	KeyStoreName   string        `json:"keyStoreName"`
+	Name           string        `json:"name"`
+	Description    string        `json:"description,omitempty"`
+	KeyAlgorithm   string        `json:"keyAlgorithm,omitempty"` // AES256 for symmetric
+	KeySize        int           `json:"keySize,omitempty"`
+	Algorithm      string        `json:"algorithm,omitempty"`      // RSA2048, RSA4096, EC_P256, EC_P384 for asymmetric
+	Secret         secret.String `json:"secret,omitempty"`         // For secrets
+	RotationPeriod int           `json:"rotationPeriod,omitempty"` // days
 }
 
 type CreateKeyOutput struct {
@@ -182,14 +187,14 @@ type CreateKeyOutput struct {
 }
 
 type DeleteKeyInput struct {
-	RequestDeletionDate string `json:"requestDeletionDate,omitempty"` // ISO 8601 format
+	RequestDeletionDate timestamp.Time `json:"requestDeletionDate,omitempty"`
 }
 
 type DeleteKeyOutput struct {
 	APIResponse
 	Body struct {
-		KeyID        string `json:"keyId"`
-		DeletionDate string `json:"deletionDate,omitempty"`
+		KeyID        string         `json:"keyId"`
+		DeletionDate timestamp.Time `json:"deletionDate,omitempty"`
 	} `json:"body"`
 }
 
@@ -0,0 +1,270 @@
+package stack
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/compute"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/waiter"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/inventory"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/floatingip"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/loadbalancer"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/securitygroup"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/vpc"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/rds/mysql"
+)
+
+// TypeKeyPair is the resource type of compute keypairs, which the
+// inventory does not collect.
+const TypeKeyPair = "compute.keypair"
+
+// kind creates and deletes the resources of one type.
+type kind struct {
+	// input returns a pointer to the create input that properties are
+	// decoded into.
+	input func() interface{}
+	// create creates the resource and waits until it is ready. It returns
+	// the resource's ID as soon as there is one, even with an error, so
+	// that a half-created resource is rolled back; record is called with
+	// it first. The returned value becomes the resource's attributes.
+	create func(ctx context.Context, c *nhncloud.Client, in interface{}, interval time.Duration, record func(id string)) (interface{}, error)
+	// delete deletes the resource and waits until it is gone.
+	delete func(ctx context.Context, c *nhncloud.Client, id string, interval time.Duration) error
+}
+
+// kinds are the supported resource types.
+var kinds = map[string]kind{
+	inventory.TypeVPC: {
+		input: func() interface{} { return &vpc.CreateVPCInput{} },
+		create: func(ctx context.Context, c *nhncloud.Client, in interface{}, _ time.Duration, record func(string)) (interface{}, error) {
+			out, err := c.VPC().CreateVPC(ctx, in.(*vpc.CreateVPCInput))
+			if err != nil {
+				return nil, err
+			}
+			record(out.VPC.ID)
+			return out.VPC, nil
+		},
+		delete: func(ctx context.Context, c *nhncloud.Client, id string, _ time.Duration) error {
+			return c.VPC().DeleteVPC(ctx, id)
+		},
+	},
+
+	inventory.TypeSubnet: {
+		input: func() interface{} { return &vpc.CreateSubnetInput{} },
+		create: func(ctx context.Context, c *nhncloud.Client, in interface{}, _ time.Duration, record func(string)) (interface{}, error) {
+			out, err := c.VPC().CreateSubnet(ctx, in.(*vpc.CreateSubnetInput))
+			if err != nil {
+				return nil, err
+			}
+			record(out.VPCSubnet.ID)
+			return out.VPCSubnet, nil
+		},
+		delete: func(ctx context.Context, c *nhncloud.Client, id string, _ time.Duration) error {
+			return c.VPC().DeleteSubnet(ctx, id)
+		},
+	},
+
+	inventory.TypeSecurityGroup: {
+		input: func() interface{} { return &securityGroupInput{} },
+		create: func(ctx context.Context, c *nhncloud.Client, in interface{}, _ time.Duration, record func(string)) (interface{}, error) {
+			input := in.(*securityGroupInput)
+			out, err := c.SecurityGroup().CreateSecurityGroup(ctx, &input.CreateSecurityGroupInput)
+			if err != nil {
+				return nil, err
+			}
+			sg := out.SecurityGroup
+			record(sg.ID)
+			for i := range input.Rules {
+				rule := input.Rules[i]
+				rule.SecurityGroupID = sg.ID
+				created, err := c.SecurityGroup().CreateRule(ctx, &rule)
+				if err != nil {
+					return nil, fmt.Errorf("rule %d: %w", i, err)
+				}
+				sg.Rules = append(sg.Rules, created.SecurityGroupRule)
+			}
+			return sg, nil
+		},
+		delete: func(ctx context.Context, c *nhncloud.Client, id string, _ time.Duration) error {
+			return c.SecurityGroup().DeleteSecurityGroup(ctx, id)
+		},
+	},
+
+	TypeKeyPair: {
+		input: func() interface{} { return &compute.CreateKeyPairInput{} },
+		create: func(ctx context.Context, c *nhncloud.Client, in interface{}, _ time.Duration, record func(string)) (interface{}, error) {
+			out, err := c.Compute().CreateKeyPair(ctx, in.(*compute.CreateKeyPairInput))
+			if err != nil {
+				return nil, err
+			}
+			record(out.KeyPair.Name)
+			return out.KeyPair, nil
+		},
+		delete: func(ctx context.Context, c *nhncloud.Client, id string, _ time.Duration) error {
+			return c.Compute().DeleteKeyPair(ctx, id)
+		},
+	},
+
+	inventory.TypeServer: {
+		input: func() interface{} { return &compute.CreateServerInput{} },
+		create: func(ctx context.Context, c *nhncloud.Client, in interface{}, interval time.Duration, record func(string)) (interface{}, error) {
+			out, err := c.Compute().CreateServer(ctx, in.(*compute.CreateServerInput))
+			if err != nil {
+				return nil, err
+			}
+			record(out.Server.ID)
+			got, err := c.Compute().WaitForServer(ctx, out.Server.ID, compute.ServerStatusActive, interval)
+			if err != nil {
+				return nil, err
+			}
+			return got.Server, nil
+		},
+		delete: func(ctx context.Context, c *nhncloud.Client, id string, interval time.Duration) error {
+			if err := c.Compute().DeleteServer(ctx, id); err != nil {
+				return err
+			}
+			_, err := c.Compute().WaitForServer(ctx, id, compute.ServerStatusDeleted, interval)
+			return err
+		},
+	},
+
+	inventory.TypeFloatingIP: {
+		input: func() interface{} { return &floatingIPInput{} },
+		create: func(ctx context.Context, c *nhncloud.Client, in interface{}, _ time.Duration, record func(string)) (interface{}, error) {
+			input := in.(*floatingIPInput)
+			if input.FloatingNetworkID == "" {
+				nets, err := c.InternetGateway().ListExternalNetworks(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if len(nets.Networks) == 0 {
+					return nil, fmt.Errorf("no external network for floating_network_id")
+				}
+				input.FloatingNetworkID = nets.Networks[0].ID
+			}
+			if input.ServerID != "" && input.PortID == "" {
+				ports, err := c.Port().ListPortsByDevice(ctx, input.ServerID)
+				if err != nil {
+					return nil, err
+				}
+				if len(ports.Ports) == 0 {
+					return nil, fmt.Errorf("server %s has no port", input.ServerID)
+				}
+				input.PortID = ports.Ports[0].ID
+			}
+			out, err := c.FloatingIP().CreateFloatingIP(ctx, &input.CreateFloatingIPInput)
+			if err != nil {
+				return nil, err
+			}
+			record(out.FloatingIP.ID)
+			return out.FloatingIP, nil
+		},
+		delete: func(ctx context.Context, c *nhncloud.Client, id string, _ time.Duration) error {
+			return c.FloatingIP().DeleteFloatingIP(ctx, id)
+		},
+	},
+
+	inventory.TypeLoadBalancer: {
+		input: func() interface{} { return &loadbalancer.CreateLoadBalancerInput{} },
+		create: func(ctx context.Context, c *nhncloud.Client, in interface{}, interval time.Duration, record func(string)) (interface{}, error) {
+			out, err := c.LoadBalancer().CreateLoadBalancer(ctx, in.(*loadbalancer.CreateLoadBalancerInput))
+			if err != nil {
+				return nil, err
+			}
+			record(out.LoadBalancer.ID)
+			got, err := c.LoadBalancer().WaitForLoadBalancer(ctx, out.LoadBalancer.ID, loadbalancer.ProvisioningStatusActive, interval)
+			if err != nil {
+				return nil, err
+			}
+			return got.LoadBalancer, nil
+		},
+		delete: func(ctx context.Context, c *nhncloud.Client, id string, interval time.Duration) error {
+			if err := c.LoadBalancer().DeleteLoadBalancer(ctx, id); err != nil {
+				return err
+			}
+			_, err := c.LoadBalancer().WaitForLoadBalancer(ctx, id, loadbalancer.ProvisioningStatusDeleted, interval)
+			return err
+		},
+	},
+
+	inventory.TypeMySQLInstance: {
+		input: func() interface{} { return &mysql.CreateInstanceInput{} },
+		create: func(ctx context.Context, c *nhncloud.Client, in interface{}, interval time.Duration, record func(string)) (interface{}, error) {
+			input := in.(*mysql.CreateInstanceInput)
+			// Creation is a job; the new instance is found in the list by
+			// its name, so the name must not be taken already.
+			before, err := c.MySQL().ListInstances(ctx)
+			if err != nil {
+				return nil, err
+			}
+			existing := map[string]bool{}
+			for _, db := range before.DBInstances {
+				if db.DBInstanceName == input.DBInstanceName {
+					return nil, fmt.Errorf("an instance named %q already exists (%s)", input.DBInstanceName, db.DBInstanceID)
+				}
+				existing[db.DBInstanceID] = true
+			}
+			if _, err := c.MySQL().CreateInstance(ctx, input); err != nil {
+				return nil, err
+			}
+			var id string
+			err = waiter.Poll(ctx, interval, func(ctx context.Context) (bool, error) {
+				out, err := c.MySQL().ListInstances(ctx)
+				if err != nil {
+					return false, err
+				}
+				for _, db := range out.DBInstances {
+					if db.DBInstanceName == input.DBInstanceName && !existing[db.DBInstanceID] {
+						id = db.DBInstanceID
+						return true, nil
+					}
+				}
+				return false, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			record(id)
+			got, err := c.MySQL().WaitForInstance(ctx, id, mysql.InstanceStatusAvailable, interval)
+			if err != nil {
+				return nil, err
+			}
+			return got.DatabaseInstance, nil
+		},
+		delete: func(ctx context.Context, c *nhncloud.Client, id string, interval time.Duration) error {
+			if _, err := c.MySQL().DeleteInstance(ctx, id); err != nil {
+				return err
+			}
+			_, err := c.MySQL().WaitForInstance(ctx, id, mysql.InstanceStatusDeleted, interval)
+			return err
+		},
+	},
+}
+
+// securityGroupInput creates a security group with its rules; the rules'
+// security_group_id is filled in.
+type securityGroupInput struct {
+	securitygroup.CreateSecurityGroupInput
+	Rules []securitygroup.CreateRuleInput `json:"rules,omitempty"`
+}
+
+// floatingIPInput creates a floating IP, associated with the first port
+// of server_id if given. An empty floating_network_id means the first
+// external network.
+type floatingIPInput struct {
+	floatingip.CreateFloatingIPInput
+	ServerID string `json:"server_id,omitempty"`
+}
+
+// Types returns the supported resource types.
+func Types() []string {
+	types := make([]string, 0, len(kinds))
+	for t := range kinds {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
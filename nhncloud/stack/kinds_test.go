@@ -0,0 +1,234 @@
+package stack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/cloudtest"
+)
+
+const mysqlHost = "kr1-rds-mysql.api.nhncloudservice.com"
+
+func newTestClient(t *testing.T, srv *cloudtest.Server) *nhncloud.Client {
+	t.Helper()
+	c, err := nhncloud.New(&nhncloud.Config{
+		Region:              "kr1",
+		Credentials:         credentials.NewStatic("access-key", "secret-key"),
+		IdentityCredentials: credentials.NewStaticIdentity("user", "pass", "tenant"),
+		AppKeys:             map[string]string{"rds-mysql": "mysql-appkey"},
+		HTTPClient:          srv.Client(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestNetworkKinds(t *testing.T) {
+	srv := cloudtest.New(t)
+	srv.Mux.HandleFunc("network.test/v2.0/vpcs", func(w http.ResponseWriter, r *http.Request) {
+		var req struct{ VPC map[string]string }
+		json.NewDecoder(r.Body).Decode(&req)
+		cloudtest.WriteJSON(w, http.StatusCreated, map[string]interface{}{
+			"vpc": map[string]string{"id": "vpc-1", "name": req.VPC["name"], "cidrv4": req.VPC["cidrv4"]},
+		})
+	})
+	srv.Mux.HandleFunc("network.test/v2.0/vpcsubnets", func(w http.ResponseWriter, r *http.Request) {
+		var req struct{ VPCSubnet map[string]string }
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.VPCSubnet["vpc_id"] != "vpc-1" {
+			t.Errorf("subnet vpc_id = %q", req.VPCSubnet["vpc_id"])
+		}
+		cloudtest.WriteJSON(w, http.StatusCreated, map[string]interface{}{"vpcsubnet": map[string]string{"id": "subnet-1"}})
+	})
+	noContent := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) }
+	srv.Mux.HandleFunc("network.test/v2.0/vpcs/vpc-1", noContent)
+	srv.Mux.HandleFunc("network.test/v2.0/vpcsubnets/subnet-1", noContent)
+
+	tmpl, err := ParseTemplate([]byte(`
+resources:
+  net:
+    type: network.vpc
+    properties: {name: web, cidrv4: 10.0.0.0/16}
+  subnet:
+    type: network.subnet
+    properties: {name: web-1, vpc_id: "${net}", cidr: 10.0.1.0/24}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newTestClient(t, srv)
+	state, err := Create(context.Background(), c, "web", tmpl, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(state.Resources) != 2 || state.Resources[0].Attributes["cidrv4"] != "10.0.0.0/16" || state.Resources[1].ID != "subnet-1" {
+		t.Fatalf("state = %+v", state.Resources)
+	}
+	if err := Delete(context.Background(), c, state, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := deletes(srv.Requests()); got != "network.test/v2.0/vpcsubnets/subnet-1 network.test/v2.0/vpcs/vpc-1" {
+		t.Errorf("deleted %s", got)
+	}
+}
+
+// mysqlServer serves a MySQL instance list that gains the instance a POST
+// creates.
+type mysqlServer struct {
+	mu        sync.Mutex
+	instances []map[string]string
+}
+
+func (m *mysqlServer) register(srv *cloudtest.Server) {
+	srv.Mux.HandleFunc(mysqlHost+"/v3.0/db-instances", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if r.Method == http.MethodPost {
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			m.instances = append(m.instances, map[string]string{
+				"dbInstanceId": "db-new", "dbInstanceName": req["dbInstanceName"].(string),
+				"dbInstanceStatus": "AVAILABLE", "progressStatus": "NONE",
+			})
+			cloudtest.WriteJSON(w, http.StatusOK, map[string]interface{}{"header": map[string]bool{"isSuccessful": true}, "jobId": "job-1"})
+			return
+		}
+		cloudtest.WriteJSON(w, http.StatusOK, map[string]interface{}{"header": map[string]bool{"isSuccessful": true}, "dbInstances": m.instances})
+	})
+	srv.Mux.HandleFunc(mysqlHost+"/v3.0/db-instances/db-new", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if r.Method == http.MethodDelete {
+			m.instances = m.instances[:len(m.instances)-1]
+			cloudtest.WriteJSON(w, http.StatusOK, map[string]interface{}{"header": map[string]bool{"isSuccessful": true}, "jobId": "job-2"})
+			return
+		}
+		for _, db := range m.instances {
+			if db["dbInstanceId"] == "db-new" {
+				out := map[string]interface{}{"header": map[string]bool{"isSuccessful": true}}
+				for k, v := range db {
+					out[k] = v
+				}
+				cloudtest.WriteJSON(w, http.StatusOK, out)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
+
+const mysqlTemplate = `
+resources:
+  db:
+    type: rds-mysql.instance
+    properties: {dbInstanceName: orders, dbFlavorId: flavor-1, dbVersion: MYSQL_V8033}
+`
+
+func TestMySQLKind(t *testing.T) {
+	srv := cloudtest.New(t)
+	db := &mysqlServer{instances: []map[string]string{
+		{"dbInstanceId": "db-other", "dbInstanceName": "billing", "dbInstanceStatus": "AVAILABLE"},
+	}}
+	db.register(srv)
+	tmpl, err := ParseTemplate([]byte(mysqlTemplate))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newTestClient(t, srv)
+	opts := Options{Interval: time.Millisecond}
+
+	state, err := Create(context.Background(), c, "orders", tmpl, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(state.Resources) != 1 || state.Resources[0].ID != "db-new" || state.Resources[0].Attributes["dbInstanceStatus"] != "AVAILABLE" {
+		t.Fatalf("state = %+v", state.Resources)
+	}
+	if err := Delete(context.Background(), c, state, opts); err != nil {
+		t.Fatal(err)
+	}
+	if got := deletes(srv.Requests()); got != mysqlHost+"/v3.0/db-instances/db-new" {
+		t.Errorf("deleted %s", got)
+	}
+}
+
+func TestMySQLKindNameTaken(t *testing.T) {
+	srv := cloudtest.New(t)
+	db := &mysqlServer{instances: []map[string]string{
+		{"dbInstanceId": "db-old", "dbInstanceName": "orders", "dbInstanceStatus": "AVAILABLE"},
+	}}
+	db.register(srv)
+	tmpl, err := ParseTemplate([]byte(mysqlTemplate))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := Create(context.Background(), newTestClient(t, srv), "orders", tmpl, Options{Interval: time.Millisecond})
+	if err == nil || !strings.Contains(err.Error(), "db-old") {
+		t.Fatalf("expected a name collision error, got %v", err)
+	}
+	// Nothing was created, so the existing instance is not adopted or
+	// rolled back.
+	if len(state.Resources) != 0 {
+		t.Errorf("state = %+v", state.Resources)
+	}
+	for _, r := range srv.Requests() {
+		if !strings.HasPrefix(r, "GET ") && !strings.Contains(r, "token") {
+			t.Errorf("unexpected request %s", r)
+		}
+	}
+}
+
+func TestRollbackOutlivesCanceledContext(t *testing.T) {
+	registerFake(t, map[string]bool{"web-1": true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	k := kinds["test.thing"]
+	create, del := k.create, k.delete
+	k.create = func(ctx context.Context, c *nhncloud.Client, in interface{}, interval time.Duration, record func(string)) (interface{}, error) {
+		if in.(*fakeInput).Name == "web-1" {
+			cancel()
+		}
+		return create(ctx, c, in, interval, record)
+	}
+	k.delete = func(ctx context.Context, c *nhncloud.Client, id string, interval time.Duration) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return del(ctx, c, id, interval)
+	}
+	kinds["test.thing"] = k
+
+	tmpl, err := ParseTemplate([]byte(testTemplate))
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, err := Create(ctx, nil, "web", tmpl, Options{})
+	var cerr *CreateError
+	if !errors.As(err, &cerr) || cerr.RollbackErr != nil {
+		t.Fatalf("expected a clean rollback, got %v", err)
+	}
+	if len(state.Resources) != 0 {
+		t.Errorf("state after rollback = %+v", state.Resources)
+	}
+}
+
+// deletes returns the hosts and paths of the DELETE requests, in order.
+func deletes(requests []string) string {
+	var paths []string
+	for _, r := range requests {
+		if p, ok := strings.CutPrefix(r, "DELETE "); ok {
+			paths = append(paths, p)
+		}
+	}
+	return strings.Join(paths, " ")
+}
@@ -0,0 +1,374 @@
+// Package stack creates and deletes a set of related resources from a
+// declarative template.
+//
+// A template names its resources and gives each a type and the properties
+// of its create call. Properties refer to other resources' attributes as
+// "${NAME.ATTR}" (or "${NAME}" for the ID) and to parameters as
+// "${param.NAME}":
+//
+//	parameters:
+//	  customer: acme
+//	resources:
+//	  net:
+//	    type: network.vpc
+//	    properties: {name: "${param.customer}-vpc", cidrv4: 10.0.0.0/16}
+//	  subnet:
+//	    type: network.subnet
+//	    properties: {name: "${param.customer}-subnet", vpc_id: "${net.id}", cidr: 10.0.1.0/24}
+//
+// Create builds the resources in dependency order, waits for each to be
+// ready, and records them in a State, which it saves after every change
+// when Options.StatePath is set. If a resource fails, Create deletes what it
+// created, in reverse order, unless Options.NoRollback is set. Delete tears
+// a stack down from its state.
+//
+//	t, err := stack.ParseTemplate(data)
+//	state, err := stack.Create(ctx, client, "acme", t, stack.Options{StatePath: "acme.state.json"})
+//	...
+//	state, err = stack.LoadState("acme.state.json")
+//	err = stack.Delete(ctx, client, state, stack.Options{StatePath: "acme.state.json"})
+package stack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/waiter"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
+// State records the resources of a stack in the order they were created.
+// It can hold secrets, such as a generated keypair's private key, so the
+// state file is written readable by its owner only.
+type State struct {
+	Stack     string          `json:"stack"`
+	CreatedAt timestamp.Time  `json:"createdAt"`
+	Resources []ResourceState `json:"resources"`
+}
+
+// ResourceState is a created resource.
+type ResourceState struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	// Attributes are the resource as the API returned it once ready,
+	// decoded into plain JSON values. They are empty for a resource that
+	// failed after it was created.
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// LoadState reads a state file.
+func LoadState(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s State
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("stack: state %s: %w", path, err)
+	}
+	return &s, nil
+}
+
+// Save writes the state file, replacing it atomically.
+func (s *State) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Event reports the progress of Create and Delete.
+type Event struct {
+	// Action is "create", "created", "delete", "deleted" or "failed".
+	Action   string
+	Resource string
+	Type     string
+	ID       string
+	Err      error
+}
+
+func (e Event) String() string {
+	s := fmt.Sprintf("%-7s %s %s", e.Action, e.Type, e.Resource)
+	if e.ID != "" {
+		s += " " + e.ID
+	}
+	if e.Err != nil {
+		s += ": " + e.Err.Error()
+	}
+	return s
+}
+
+// DefaultRollbackTimeout is the rollback timeout when
+// Options.RollbackTimeout is zero.
+const DefaultRollbackTimeout = 30 * time.Minute
+
+// Options configures Create and Delete.
+type Options struct {
+	// Params override the template's parameter defaults.
+	Params map[string]interface{}
+	// StatePath, if set, is where the state is saved after every resource
+	// created or deleted. Delete removes the file once the stack is gone.
+	StatePath string
+	// Interval is how often waits poll. Zero means the waiters' default.
+	Interval time.Duration
+	// NoRollback keeps the resources created before a failure, for
+	// debugging. Delete the stack from its state afterwards.
+	NoRollback bool
+	// RollbackTimeout bounds the rollback after a failed Create. The
+	// rollback runs even when Create's context is canceled. Zero means
+	// DefaultRollbackTimeout.
+	RollbackTimeout time.Duration
+	// OnEvent, if set, is called as each resource is created or deleted.
+	OnEvent func(Event)
+}
+
+func (o *Options) event(e Event) {
+	if o.OnEvent != nil {
+		o.OnEvent(e)
+	}
+}
+
+// save writes s to StatePath, if set.
+func (o *Options) save(s *State) error {
+	if o.StatePath == "" {
+		return nil
+	}
+	if err := s.Save(o.StatePath); err != nil {
+		return fmt.Errorf("stack: save state: %w", err)
+	}
+	return nil
+}
+
+// CreateError reports the resource that failed during Create and the
+// outcome of the rollback.
+type CreateError struct {
+	Resource string
+	Err      error
+	// RollbackErr is the error of the rollback, or nil if every created
+	// resource was deleted (or NoRollback was set).
+	RollbackErr error
+}
+
+func (e *CreateError) Error() string {
+	msg := fmt.Sprintf("stack: create %s: %v", e.Resource, e.Err)
+	if e.RollbackErr != nil {
+		msg += fmt.Sprintf(" (rollback: %v)", e.RollbackErr)
+	}
+	return msg
+}
+
+func (e *CreateError) Unwrap() error { return e.Err }
+
+// DeleteError lists the resources Delete could not delete. They remain in
+// the state.
+type DeleteError struct {
+	Failures []ResourceError
+}
+
+// ResourceError is a resource that could not be deleted.
+type ResourceError struct {
+	Resource string
+	ID       string
+	Err      error
+}
+
+func (e *DeleteError) Error() string {
+	msgs := make([]string, len(e.Failures))
+	for i, f := range e.Failures {
+		msgs[i] = fmt.Sprintf("%s (%s): %v", f.Resource, f.ID, f.Err)
+	}
+	return "stack: delete " + strings.Join(msgs, "; ")
+}
+
+func (e *DeleteError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, f := range e.Failures {
+		errs[i] = f.Err
+	}
+	return errs
+}
+
+// Create creates the template's resources with c, in dependency order, and
+// returns the stack's state. On failure it returns a *CreateError and the
+// state of what remains after the rollback.
+func Create(ctx context.Context, c *nhncloud.Client, name string, t *Template, opts Options) (*State, error) {
+	order, err := t.Order()
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]interface{}, len(t.Parameters))
+	for k, v := range t.Parameters {
+		params[k] = v
+	}
+	for k, v := range opts.Params {
+		if _, ok := t.Parameters[k]; !ok {
+			return nil, fmt.Errorf("stack: unknown parameter %q", k)
+		}
+		params[k] = v
+	}
+
+	state := &State{Stack: name, CreatedAt: timestamp.New(time.Now()), Resources: []ResourceState{}}
+	byName := map[string]*ResourceState{}
+	lookup := func(ref string) (interface{}, error) {
+		if strings.HasPrefix(ref, paramPrefix) {
+			return params[strings.TrimPrefix(ref, paramPrefix)], nil
+		}
+		res, path, _ := strings.Cut(ref, ".")
+		rs := byName[res]
+		if path == "" {
+			return rs.ID, nil
+		}
+		v, ok := attribute(rs.Attributes, path)
+		if !ok {
+			return nil, fmt.Errorf("resource %s has no attribute %q", res, path)
+		}
+		return v, nil
+	}
+
+	for _, resName := range order {
+		r := t.Resources[resName]
+		opts.event(Event{Action: "create", Resource: resName, Type: r.Type})
+		err := createResource(ctx, c, state, resName, r, lookup, &opts)
+		if err == nil {
+			rs := &state.Resources[len(state.Resources)-1]
+			byName[resName] = rs
+			opts.event(Event{Action: "created", Resource: resName, Type: r.Type, ID: rs.ID})
+			if err = opts.save(state); err == nil {
+				continue
+			}
+		}
+		opts.event(Event{Action: "failed", Resource: resName, Type: r.Type, Err: err})
+		cerr := &CreateError{Resource: resName, Err: err}
+		if !opts.NoRollback {
+			cerr.RollbackErr = rollback(ctx, c, state, opts)
+		}
+		return state, cerr
+	}
+	return state, nil
+}
+
+// rollback deletes what a failed Create created. It detaches from ctx's
+// cancellation, which is often the reason Create failed, and is bounded by
+// RollbackTimeout instead.
+func rollback(ctx context.Context, c *nhncloud.Client, state *State, opts Options) error {
+	timeout := opts.RollbackTimeout
+	if timeout == 0 {
+		timeout = DefaultRollbackTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+	return Delete(ctx, c, state, opts)
+}
+
+// createResource creates one resource and appends it to the state as soon
+// as it has an ID.
+func createResource(ctx context.Context, c *nhncloud.Client, state *State, name string, r Resource, lookup func(string) (interface{}, error), opts *Options) error {
+	k := kinds[r.Type]
+	props, err := resolve(r.Properties, lookup)
+	if err != nil {
+		return err
+	}
+	in := k.input()
+	data, err := json.Marshal(props)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(in); err != nil {
+		return fmt.Errorf("properties: %w", err)
+	}
+
+	recorded := false
+	record := func(id string) {
+		state.Resources = append(state.Resources, ResourceState{Name: name, Type: r.Type, ID: id})
+		recorded = true
+		// A failed save here is caught by the save after the resource
+		// is ready.
+		opts.save(state)
+	}
+	v, err := k.create(ctx, c, in, opts.Interval, record)
+	if err != nil {
+		return err
+	}
+	if !recorded {
+		return fmt.Errorf("created resource has no ID")
+	}
+	state.Resources[len(state.Resources)-1].Attributes = attributes(v)
+	return nil
+}
+
+// Delete deletes the stack's resources in reverse creation order with c,
+// removing each from the state as it goes. A resource that is already gone
+// counts as deleted. It keeps going past failures; the resources it could
+// not delete remain in the state and are reported in a *DeleteError.
+func Delete(ctx context.Context, c *nhncloud.Client, state *State, opts Options) error {
+	var failures []ResourceError
+	var remaining []ResourceState
+	for i := len(state.Resources) - 1; i >= 0; i-- {
+		rs := state.Resources[i]
+		opts.event(Event{Action: "delete", Resource: rs.Name, Type: rs.Type, ID: rs.ID})
+		err := fmt.Errorf("unknown type %q", rs.Type)
+		if k, ok := kinds[rs.Type]; ok {
+			err = k.delete(ctx, c, rs.ID, opts.Interval)
+		}
+		if err != nil && !waiter.IsNotFound(err) {
+			opts.event(Event{Action: "failed", Resource: rs.Name, Type: rs.Type, ID: rs.ID, Err: err})
+			failures = append(failures, ResourceError{Resource: rs.Name, ID: rs.ID, Err: err})
+			remaining = append([]ResourceState{rs}, remaining...)
+			continue
+		}
+		opts.event(Event{Action: "deleted", Resource: rs.Name, Type: rs.Type, ID: rs.ID})
+		state.Resources = append(state.Resources[:i:i], remaining...)
+		if err := opts.save(state); err != nil {
+			return err
+		}
+	}
+	state.Resources = remaining
+	if len(failures) > 0 {
+		if err := opts.save(state); err != nil {
+			return err
+		}
+		return &DeleteError{Failures: failures}
+	}
+	if opts.StatePath != "" {
+		if err := os.Remove(opts.StatePath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// attributes converts v to plain JSON values.
+func attributes(v interface{}) map[string]interface{} {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil
+	}
+	return m
+}
@@ -0,0 +1,212 @@
+package stack
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+)
+
+const testTemplate = `# a web server behind its own network
+---
+description: |
+  web tier
+parameters:
+  name: web
+  flavor: "u2.c2m4"
+resources:
+  server:
+    type: test.thing
+    properties:
+      name: ${param.name}-1   # trailing comment
+      network: ${subnet.id}
+      tags: [a, "b, c", '${param.flavor}']
+      nested:
+        - key: value
+          other: 2
+        - plain
+  subnet:
+    type: test.thing
+    properties: {name: "${param.name}-subnet", parent: "${net}"}
+  net:
+    type: test.thing
+    properties:
+      name: ${param.name}-net
+      size: 1.5
+      enabled: true
+      script: |-
+        #!/bin/sh
+          echo hi
+`
+
+func TestParseTemplate(t *testing.T) {
+	registerFake(t, nil)
+	tmpl, err := ParseTemplate([]byte(testTemplate))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tmpl.Description != "web tier\n" || tmpl.Parameters["flavor"] != "u2.c2m4" {
+		t.Errorf("template = %+v", tmpl)
+	}
+	server := tmpl.Resources["server"].Properties
+	want := map[string]interface{}{
+		"name":    "${param.name}-1",
+		"network": "${subnet.id}",
+		"tags":    []interface{}{"a", "b, c", "${param.flavor}"},
+		"nested": []interface{}{
+			map[string]interface{}{"key": "value", "other": float64(2)},
+			"plain",
+		},
+	}
+	if !reflect.DeepEqual(server, want) {
+		t.Errorf("server properties = %#v", server)
+	}
+	if got := tmpl.Resources["net"].Properties["script"]; got != "#!/bin/sh\n  echo hi" {
+		t.Errorf("script = %q", got)
+	}
+
+	order, err := tmpl.Order()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(order, []string{"net", "subnet", "server"}) {
+		t.Errorf("order = %v", order)
+	}
+
+	for _, bad := range []string{
+		`resources: {a: {type: test.thing, properties: {x: "${b.id}"}}}`,
+		`resources: {a: {type: test.thing, properties: {x: "${param.nope}"}}}`,
+		`resources: {a: {type: test.thing, depends_on: [b]}, b: {type: test.thing, depends_on: [a]}}`,
+		`resources: {a: {type: no.such}}`,
+		`resources: {a: {type: test.thing, extra: 1}}`,
+		"resources:\n\ta: {}",
+	} {
+		if _, err := ParseTemplate([]byte(bad)); err == nil {
+			t.Errorf("expected an error for %q", bad)
+		}
+	}
+}
+
+type fakeInput struct {
+	Name    string      `json:"name"`
+	Network string      `json:"network,omitempty"`
+	Parent  string      `json:"parent,omitempty"`
+	Tags    []string    `json:"tags,omitempty"`
+	Nested  interface{} `json:"nested,omitempty"`
+	Size    float64     `json:"size,omitempty"`
+	Enabled bool        `json:"enabled,omitempty"`
+	Script  string      `json:"script,omitempty"`
+}
+
+// registerFake adds the "test.thing" type, which records the inputs it is
+// given and fails for the names in fail.
+func registerFake(t *testing.T, fail map[string]bool) (created *[]fakeInput, deleted *[]string) {
+	created, deleted = &[]fakeInput{}, &[]string{}
+	kinds["test.thing"] = kind{
+		input: func() interface{} { return &fakeInput{} },
+		create: func(ctx context.Context, c *nhncloud.Client, in interface{}, _ time.Duration, record func(string)) (interface{}, error) {
+			input := *in.(*fakeInput)
+			*created = append(*created, input)
+			record("id-" + input.Name)
+			if fail[input.Name] {
+				return nil, errors.New("quota exceeded")
+			}
+			return map[string]interface{}{"id": "id-" + input.Name, "name": input.Name}, nil
+		},
+		delete: func(ctx context.Context, c *nhncloud.Client, id string, _ time.Duration) error {
+			*deleted = append(*deleted, id)
+			return nil
+		},
+	}
+	t.Cleanup(func() { delete(kinds, "test.thing") })
+	return created, deleted
+}
+
+func TestCreateResolvesAndRollsBack(t *testing.T) {
+	created, deleted := registerFake(t, map[string]bool{"app-1": true})
+	tmpl, err := ParseTemplate([]byte(testTemplate))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "web.state.json")
+	opts := Options{Params: map[string]interface{}{"name": "app"}, StatePath: path}
+
+	state, err := Create(context.Background(), nil, "web", tmpl, opts)
+	var cerr *CreateError
+	if !errors.As(err, &cerr) || cerr.Resource != "server" || cerr.RollbackErr != nil {
+		t.Fatalf("expected a CreateError for server, got %v", err)
+	}
+	if len(*created) != 3 {
+		t.Fatalf("created %d resources", len(*created))
+	}
+	if sub := (*created)[1]; sub.Name != "app-subnet" || sub.Parent != "id-app-net" {
+		t.Errorf("subnet input = %+v", sub)
+	}
+	if srv := (*created)[2]; srv.Network != "id-app-subnet" || !reflect.DeepEqual(srv.Tags, []string{"a", "b, c", "u2.c2m4"}) {
+		t.Errorf("server input = %+v", srv)
+	}
+	// The failed server had an ID, so it is rolled back too.
+	if want := []string{"id-app-1", "id-app-subnet", "id-app-net"}; !reflect.DeepEqual(*deleted, want) {
+		t.Errorf("deleted = %v, want %v", *deleted, want)
+	}
+	if len(state.Resources) != 0 {
+		t.Errorf("state after rollback = %+v", state.Resources)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("state file after rollback: %v", err)
+	}
+
+	if _, err := Create(context.Background(), nil, "web", tmpl, Options{Params: map[string]interface{}{"nope": 1}}); err == nil {
+		t.Error("expected an error for an unknown parameter")
+	}
+}
+
+func TestCreateSavesStateForDelete(t *testing.T) {
+	_, deleted := registerFake(t, nil)
+	tmpl, err := ParseTemplate([]byte(testTemplate))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "web.state.json")
+	if _, err := Create(context.Background(), nil, "web", tmpl, Options{StatePath: path}); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("state file mode = %v", info.Mode())
+	}
+
+	state, err := LoadState(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(state.Resources) != 3 || state.Resources[2].Name != "server" || state.Resources[0].Attributes["name"] != "web-net" {
+		t.Fatalf("state = %+v", state)
+	}
+
+	// A resource of a type this build does not know stays in the state.
+	state.Resources = append([]ResourceState{{Name: "old", Type: "gone.type", ID: "x"}}, state.Resources...)
+	err = Delete(context.Background(), nil, state, Options{StatePath: path})
+	var derr *DeleteError
+	if !errors.As(err, &derr) || len(derr.Failures) != 1 || derr.Failures[0].Resource != "old" {
+		t.Fatalf("expected a DeleteError for old, got %v", err)
+	}
+	if want := []string{"id-web-1", "id-web-subnet", "id-web-net"}; !reflect.DeepEqual(*deleted, want) {
+		t.Errorf("deleted = %v", *deleted)
+	}
+	left, err := LoadState(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left.Resources) != 1 || left.Resources[0].Name != "old" {
+		t.Errorf("state left = %+v", left.Resources)
+	}
+}
@@ -0,0 +1,247 @@
+package stack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/internal/yaml"
+)
+
+// Template describes the resources of a stack.
+type Template struct {
+	Description string `json:"description,omitempty"`
+	// Parameters are the values "${param.NAME}" refers to, with their
+	// defaults. Options.Params overrides them.
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	Resources  map[string]Resource    `json:"resources"`
+}
+
+// Resource is one resource of a template.
+type Resource struct {
+	// Type is the resource type, such as "network.vpc"; see Types.
+	Type string `json:"type"`
+	// Properties are the create input of the type, by JSON name, and may
+	// contain references.
+	Properties map[string]interface{} `json:"properties,omitempty"`
+	// DependsOn names resources to create first that Properties does not
+	// refer to.
+	DependsOn []string `json:"depends_on,omitempty"`
+}
+
+// paramPrefix starts the references to parameters.
+const paramPrefix = "param."
+
+var (
+	refPattern  = regexp.MustCompile(`\$\{([^}]*)\}`)
+	namePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+)
+
+// ParseTemplate parses a JSON or YAML template and checks its types,
+// references and dependencies. YAML is the subset internal/yaml.Parse reads;
+// a document that starts with "{" is read as JSON.
+func ParseTemplate(data []byte) (*Template, error) {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) == 0 || trimmed[0] != '{' {
+		v, err := yaml.Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("stack: %w", err)
+		}
+		if data, err = json.Marshal(v); err != nil {
+			return nil, fmt.Errorf("stack: %w", err)
+		}
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var t Template
+	if err := dec.Decode(&t); err != nil {
+		return nil, fmt.Errorf("stack: template: %w", err)
+	}
+	if _, err := t.Order(); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// Order checks the template and returns its resource names in creation
+// order: each after the resources it refers to or depends on, and
+// otherwise by name.
+func (t *Template) Order() ([]string, error) {
+	if len(t.Resources) == 0 {
+		return nil, fmt.Errorf("stack: template has no resources")
+	}
+	deps := make(map[string][]string, len(t.Resources))
+	for name, r := range t.Resources {
+		if !namePattern.MatchString(name) || name == "param" {
+			return nil, fmt.Errorf("stack: invalid resource name %q", name)
+		}
+		if _, ok := kinds[r.Type]; !ok {
+			return nil, fmt.Errorf("stack: resource %s: unknown type %q", name, r.Type)
+		}
+		refs, err := references(r.Properties)
+		if err != nil {
+			return nil, fmt.Errorf("stack: resource %s: %w", name, err)
+		}
+		for _, ref := range refs {
+			if strings.HasPrefix(ref, paramPrefix) {
+				if _, ok := t.Parameters[strings.TrimPrefix(ref, paramPrefix)]; !ok {
+					return nil, fmt.Errorf("stack: resource %s: undefined parameter in ${%s}", name, ref)
+				}
+				continue
+			}
+			deps[name] = append(deps[name], strings.SplitN(ref, ".", 2)[0])
+		}
+		deps[name] = append(deps[name], r.DependsOn...)
+		for _, dep := range deps[name] {
+			if _, ok := t.Resources[dep]; !ok {
+				return nil, fmt.Errorf("stack: resource %s refers to undefined resource %q", name, dep)
+			}
+			if dep == name {
+				return nil, fmt.Errorf("stack: resource %s refers to itself", name)
+			}
+		}
+	}
+
+	var order []string
+	done := make(map[string]bool, len(t.Resources))
+	for len(order) < len(t.Resources) {
+		var ready []string
+		for name := range t.Resources {
+			if done[name] {
+				continue
+			}
+			blocked := false
+			for _, dep := range deps[name] {
+				if !done[dep] {
+					blocked = true
+					break
+				}
+			}
+			if !blocked {
+				ready = append(ready, name)
+			}
+		}
+		if len(ready) == 0 {
+			var cycle []string
+			for name := range t.Resources {
+				if !done[name] {
+					cycle = append(cycle, name)
+				}
+			}
+			sort.Strings(cycle)
+			return nil, fmt.Errorf("stack: dependency cycle among %s", strings.Join(cycle, ", "))
+		}
+		sort.Strings(ready)
+		for _, name := range ready {
+			done[name] = true
+		}
+		order = append(order, ready...)
+	}
+	return order, nil
+}
+
+// references returns the "${...}" references in v.
+func references(v interface{}) ([]string, error) {
+	var refs []string
+	var walk func(v interface{}) error
+	walk = func(v interface{}) error {
+		switch v := v.(type) {
+		case string:
+			for _, m := range refPattern.FindAllStringSubmatch(v, -1) {
+				if m[1] == "" || strings.HasSuffix(m[1], ".") {
+					return fmt.Errorf("invalid reference %q", m[0])
+				}
+				refs = append(refs, m[1])
+			}
+		case map[string]interface{}:
+			for _, e := range v {
+				if err := walk(e); err != nil {
+					return err
+				}
+			}
+		case []interface{}:
+			for _, e := range v {
+				if err := walk(e); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	return refs, walk(v)
+}
+
+// resolve replaces the references in v. A string that is a single
+// reference becomes the referenced value itself, so "${web.id}" can stand
+// for a number or a list; references within longer strings are formatted
+// into them.
+func resolve(v interface{}, lookup func(ref string) (interface{}, error)) (interface{}, error) {
+	switch v := v.(type) {
+	case string:
+		if m := refPattern.FindStringSubmatch(v); m != nil && m[0] == v {
+			return lookup(m[1])
+		}
+		var err error
+		out := refPattern.ReplaceAllStringFunc(v, func(s string) string {
+			val, lerr := lookup(s[2 : len(s)-1])
+			if lerr != nil {
+				err = lerr
+				return s
+			}
+			if str, ok := val.(string); ok {
+				return str
+			}
+			b, _ := json.Marshal(val)
+			return string(b)
+		})
+		return out, err
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			r, err := resolve(e, lookup)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = r
+		}
+		return out, nil
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, e := range v {
+			r, err := resolve(e, lookup)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = r
+		}
+		return out, nil
+	}
+	return v, nil
+}
+
+// attribute looks up a dotted path in a resource's attributes; list
+// elements are addressed by index, as in "addresses.0".
+func attribute(attrs map[string]interface{}, path string) (interface{}, bool) {
+	var v interface{} = attrs
+	for _, seg := range strings.Split(path, ".") {
+		switch cur := v.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if v, ok = cur[seg]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(seg)
+			if err != nil || i < 0 || i >= len(cur) {
+				return nil, false
+			}
+			v = cur[i]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
@@ -0,0 +1,129 @@
+package block
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/waiter"
+)
+
+// VolumeStatus is a volume's status. Any "error" or "error_*" value counts
+// as failed, so failures the SDK does not list are still recognized; other
+// unknown values report false from every helper.
+type VolumeStatus string
+
+const (
+	VolumeStatusCreating         VolumeStatus = "creating"
+	VolumeStatusAvailable        VolumeStatus = "available"
+	VolumeStatusReserved         VolumeStatus = "reserved"
+	VolumeStatusAttaching        VolumeStatus = "attaching"
+	VolumeStatusDetaching        VolumeStatus = "detaching"
+	VolumeStatusInUse            VolumeStatus = "in-use"
+	VolumeStatusMaintenance      VolumeStatus = "maintenance"
+	VolumeStatusDeleting         VolumeStatus = "deleting"
+	VolumeStatusAwaitingTransfer VolumeStatus = "awaiting-transfer"
+	VolumeStatusBackingUp        VolumeStatus = "backing-up"
+	VolumeStatusRestoringBackup  VolumeStatus = "restoring-backup"
+	VolumeStatusDownloading      VolumeStatus = "downloading"
+	VolumeStatusUploading        VolumeStatus = "uploading"
+	VolumeStatusRetyping         VolumeStatus = "retyping"
+	VolumeStatusExtending        VolumeStatus = "extending"
+	VolumeStatusError            VolumeStatus = "error"
+	VolumeStatusErrorDeleting    VolumeStatus = "error_deleting"
+	VolumeStatusErrorBackingUp   VolumeStatus = "error_backing-up"
+	VolumeStatusErrorRestoring   VolumeStatus = "error_restoring"
+	VolumeStatusErrorExtending   VolumeStatus = "error_extending"
+)
+
+// IsTerminal reports whether the volume has settled.
+func (s VolumeStatus) IsTerminal() bool {
+	switch s {
+	case VolumeStatusAvailable, VolumeStatusInUse, VolumeStatusAwaitingTransfer:
+		return true
+	}
+	return s.IsFailed()
+}
+
+// IsFailed reports whether the last operation on the volume failed.
+func (s VolumeStatus) IsFailed() bool {
+	return s == VolumeStatusError || strings.HasPrefix(string(s), "error_")
+}
+
+// IsTransitioning reports whether an operation on the volume is running.
+func (s VolumeStatus) IsTransitioning() bool {
+	switch s {
+	case VolumeStatusCreating, VolumeStatusReserved, VolumeStatusAttaching, VolumeStatusDetaching,
+		VolumeStatusMaintenance, VolumeStatusDeleting, VolumeStatusBackingUp, VolumeStatusRestoringBackup,
+		VolumeStatusDownloading, VolumeStatusUploading, VolumeStatusRetyping, VolumeStatusExtending:
+		return true
+	}
+	return false
+}
+
+// SnapshotStatus is a snapshot's status, classified like VolumeStatus.
+type SnapshotStatus string
+
+const (
+	SnapshotStatusCreating      SnapshotStatus = "creating"
+	SnapshotStatusAvailable     SnapshotStatus = "available"
+	SnapshotStatusBackingUp     SnapshotStatus = "backing-up"
+	SnapshotStatusDeleting      SnapshotStatus = "deleting"
+	SnapshotStatusDeleted       SnapshotStatus = "deleted"
+	SnapshotStatusRestoring     SnapshotStatus = "restoring"
+	SnapshotStatusUnmanaging    SnapshotStatus = "unmanaging"
+	SnapshotStatusError         SnapshotStatus = "error"
+	SnapshotStatusErrorDeleting SnapshotStatus = "error_deleting"
+)
+
+// IsTerminal reports whether the snapshot has settled.
+func (s SnapshotStatus) IsTerminal() bool {
+	return s == SnapshotStatusAvailable || s == SnapshotStatusDeleted || s.IsFailed()
+}
+
+// IsFailed reports whether the last operation on the snapshot failed.
+func (s SnapshotStatus) IsFailed() bool {
+	return s == SnapshotStatusError || strings.HasPrefix(string(s), "error_")
+}
+
+// IsTransitioning reports whether an operation on the snapshot is running.
+func (s SnapshotStatus) IsTransitioning() bool {
+	switch s {
+	case SnapshotStatusCreating, SnapshotStatusBackingUp, SnapshotStatusDeleting,
+		SnapshotStatusRestoring, SnapshotStatusUnmanaging:
+		return true
+	}
+	return false
+}
+
+// WaitForVolume polls GetVolume every interval (10s when zero) until the
+// volume is in want, which must be a settled status such as
+// VolumeStatusAvailable or VolumeStatusInUse. A failed status ends the wait
+// with *errors.FailedStateError. Bound the wait with a context deadline.
+func (c *Client) WaitForVolume(ctx context.Context, volumeID string, want VolumeStatus, interval time.Duration) (*GetVolumeOutput, error) {
+	if !want.IsTerminal() || want.IsFailed() {
+		return nil, &errors.ValidationError{Field: "want", Reason: fmt.Sprintf("%q is not a settled volume status", want)}
+	}
+
+	var last *GetVolumeOutput
+	err := waiter.Poll(ctx, interval, func(ctx context.Context) (bool, error) {
+		out, err := c.GetVolume(ctx, volumeID)
+		if err != nil {
+			return false, err
+		}
+		last = out
+		switch s := out.Volume.Status; {
+		case s == want:
+			return true, nil
+		case s.IsFailed():
+			return false, &errors.FailedStateError{Resource: "volume", ID: volumeID, Status: string(s)}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return last, fmt.Errorf("wait for volume %s to be %s: %w", volumeID, want, err)
+	}
+	return last, nil
+}
@@ -1,9 +1,11 @@
 package block
 
+import "github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+
 type Volume struct {
 	ID               string             `json:"id"`
 	Name             string             `json:"name"`
-	Status           string             `json:"status"`
+	Status           VolumeStatus       `json:"status"`
 	Size             int                `json:"size"`
 	VolumeType       string             `json:"volume_type"`
 	Bootable         string             `json:"bootable"`
@@ -14,28 +16,28 @@ type Volume struct {
 	Description      string             `json:"description,omitempty"`
 	Attachments      []VolumeAttachment `json:"attachments,omitempty"`
 	Metadata         map[string]string  `json:"metadata,omitempty"`
-	CreatedAt        string             `json:"created_at"`
-	UpdatedAt        string             `json:"updated_at,omitempty"`
+	CreatedAt        timestamp.Time     `json:"created_at"`
+	UpdatedAt        timestamp.Time     `json:"updated_at,omitempty"`
 }
 
 type VolumeAttachment struct {
-	ID         string `json:"id"`
-	VolumeID   string `json:"volume_id"`
-	ServerID   string `json:"server_id"`
-	Device     string `json:"device"`
-	AttachedAt string `json:"attached_at"`
+	ID         string         `json:"id"`
+	VolumeID   string         `json:"volume_id"`
+	ServerID   string         `json:"server_id"`
+	Device     string         `json:"device"`
+	AttachedAt timestamp.Time `json:"attached_at"`
 }
 
 type Snapshot struct {
 	ID          string            `json:"id"`
 	Name        string            `json:"name"`
-	Status      string            `json:"status"`
+	Status      SnapshotStatus    `json:"status"`
 	Size        int               `json:"size"`
 	VolumeID    string            `json:"volume_id"`
 	Description string            `json:"description,omitempty"`
 	Metadata    map[string]string `json:"metadata,omitempty"`
-	CreatedAt   string            `json:"created_at"`
-	UpdatedAt   string            `json:"updated_at,omitempty"`
+	CreatedAt   timestamp.Time    `json:"created_at"`
+	UpdatedAt   timestamp.Time    `json:"updated_at,omitempty"`
 }
 
 type VolumeType struct {
@@ -10,9 +10,15 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/waiter"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
 )
 
 // Client handles NAS API operations
@@ -22,27 +28,31 @@ type Client struct {
 	httpClient    *http.Client
 	tokenProvider *client.IdentityTokenProvider
 	debug         bool
+	drift         *drift.Detector
 }
 
 // NewClient creates a new NAS client
-func NewClient(region string, creds credentials.IdentityCredentials, hc *http.Client, debug bool) *Client {
+func NewClient(region string, creds credentials.IdentityCredentials, hc *http.Client, debug bool, opts ...option.Option) *Client {
+	settings := option.Apply(opts...)
+	if settings.HTTPClient == nil {
+		settings.HTTPClient = hc
+	}
+
 	c := &Client{
 		region:      region,
 		credentials: creds,
-		httpClient:  hc,
+		httpClient:  client.HTTPClient(settings, http.DefaultClient),
+		drift:       client.Drift(settings),
 		debug:       debug,
 	}
 
-	if hc == nil {
-		c.httpClient = http.DefaultClient
-	}
-
 	if creds != nil {
 		c.tokenProvider = client.NewIdentityTokenProvider(
 			creds.GetTenantID(),
 			creds.GetUsername(),
 			creds.GetPassword(),
 		)
+		c.tokenProvider.SetHTTPClient(settings.HTTPClient)
 	}
 
 	return c
@@ -76,7 +86,7 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		reqBody = bytes.NewReader(jsonBody)
 
 		if c.debug {
-			fmt.Printf("[DEBUG] NAS Request Body: %s\n", string(jsonBody))
+			fmt.Printf("[DEBUG] NAS Request Body: %s\n", secret.RedactJSON(jsonBody))
 		}
 	}
 
@@ -108,14 +118,17 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 	}
 
 	if c.debug && len(respBody) > 0 {
-		fmt.Printf("[DEBUG] NAS Response Body: %s\n", string(respBody))
+		fmt.Printf("[DEBUG] NAS Response Body: %s\n", secret.RedactJSON(respBody))
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("API error: %d %s - %s", resp.StatusCode, resp.Status, string(respBody))
+		return fmt.Errorf("API error: %d %s - %s", resp.StatusCode, resp.Status, secret.RedactJSON(respBody))
 	}
 
 	if result != nil && len(respBody) > 0 {
+		if err := c.drift.Check("nas", "", respBody, result); err != nil {
+			return err
+		}
 		if err := json.Unmarshal(respBody, result); err != nil {
 			return fmt.Errorf("decode response: %w", err)
 		}
@@ -178,6 +191,37 @@ func (c *Client) GetVolume(ctx context.Context, volumeID string) (*GetVolumeOutp
 	return &out, nil
 }
 
+// WaitForVolume polls GetVolume every interval (10s when zero) until the
+// volume is in want. VolumeStatusAvailable is the only status a volume
+// settles in, so any other want is a *errors.ValidationError.
+// VolumeStatusError ends the wait with *errors.FailedStateError. Bound the
+// wait with a context deadline.
+func (c *Client) WaitForVolume(ctx context.Context, volumeID string, want VolumeStatus, interval time.Duration) (*GetVolumeOutput, error) {
+	if want != VolumeStatusAvailable {
+		return nil, &errors.ValidationError{Field: "want", Reason: fmt.Sprintf("%q is not %q, the only settled volume status", want, VolumeStatusAvailable)}
+	}
+
+	var last *GetVolumeOutput
+	err := waiter.Poll(ctx, interval, func(ctx context.Context) (bool, error) {
+		out, err := c.GetVolume(ctx, volumeID)
+		if err != nil {
+			return false, err
+		}
+		last = out
+		switch s := out.Volume.Status; {
+		case s == want:
+			return true, nil
+		case s.IsFailed():
+			return false, &errors.FailedStateError{Resource: "NAS volume", ID: volumeID, Status: string(s)}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return last, fmt.Errorf("wait for NAS volume %s to be %s: %w", volumeID, want, err)
+	}
+	return last, nil
+}
+
 // CreateVolume creates a new NAS volume
 func (c *Client) CreateVolume(ctx context.Context, input *CreateVolumeInput) (*CreateVolumeOutput, error) {
 	req := map[string]interface{}{"volume": input}
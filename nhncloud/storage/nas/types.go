@@ -2,7 +2,7 @@
 package nas
 
 import (
-	"time"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
 )
 
 // Header represents API response header
@@ -33,34 +33,34 @@ type Encryption struct {
 
 // Interface represents volume interface configuration
 type Interface struct {
-	ID       string `json:"id"`
-	Path     string `json:"path"`
-	Status   string `json:"status"`
-	SubnetID string `json:"subnetId"`
-	TenantID string `json:"tenantId"`
+	ID       string          `json:"id"`
+	Path     string          `json:"path"`
+	Status   InterfaceStatus `json:"status"`
+	SubnetID string          `json:"subnetId"`
+	TenantID string          `json:"tenantId"`
 }
 
 // VolumeMirror represents volume replication configuration
 type VolumeMirror struct {
-	ID                 string    `json:"id"`
-	DirectionChangedAt *string   `json:"directionChangedAt"`
-	DstProjectID       string    `json:"dstProjectId"`
-	DstRegion          string    `json:"dstRegion"`
-	DstTenantID        string    `json:"dstTenantId"`
-	DstVolumeID        string    `json:"dstVolumeId"`
-	DstVolumeName      string    `json:"dstVolumeName"`
-	SrcProjectID       string    `json:"srcProjectId"`
-	SrcRegion          string    `json:"srcRegion"`
-	SrcTenantID        string    `json:"srcTenantId"`
-	SrcVolumeID        string    `json:"srcVolumeId"`
-	SrcVolumeName      string    `json:"srcVolumeName"`
-	CreatedAt          time.Time `json:"createdAt"`
+	ID                 string          `json:"id"`
+	DirectionChangedAt *timestamp.Time `json:"directionChangedAt"`
+	DstProjectID       string          `json:"dstProjectId"`
+	DstRegion          string          `json:"dstRegion"`
+	DstTenantID        string          `json:"dstTenantId"`
+	DstVolumeID        string          `json:"dstVolumeId"`
+	DstVolumeName      string          `json:"dstVolumeName"`
+	SrcProjectID       string          `json:"srcProjectId"`
+	SrcRegion          string          `json:"srcRegion"`
+	SrcTenantID        string          `json:"srcTenantId"`
+	SrcVolumeID        string          `json:"srcVolumeId"`
+	SrcVolumeName      string          `json:"srcVolumeName"`
+	CreatedAt          timestamp.Time  `json:"createdAt"`
 }
 
 // SnapshotSchedule represents snapshot scheduling configuration
 type SnapshotSchedule struct {
-	Time       string `json:"time"`
-	TimeOffset string `json:"timeOffset"`
+	Time       timestamp.Time `json:"time"`
+	TimeOffset string         `json:"timeOffset"`
 }
 
 // SnapshotPolicy represents volume snapshot policy
@@ -80,7 +80,7 @@ type MountProtocol struct {
 type Volume struct {
 	ID             string         `json:"id"`
 	Name           string         `json:"name"`
-	Status         string         `json:"status"`
+	Status         VolumeStatus   `json:"status"`
 	Description    *string        `json:"description"`
 	SizeGB         int            `json:"sizeGb"`
 	ProjectID      string         `json:"projectId"`
@@ -91,8 +91,8 @@ type Volume struct {
 	Mirrors        []VolumeMirror `json:"mirrors"`
 	MountProtocol  MountProtocol  `json:"mountProtocol"`
 	SnapshotPolicy SnapshotPolicy `json:"snapshotPolicy"`
-	CreatedAt      time.Time      `json:"createdAt"`
-	UpdatedAt      time.Time      `json:"updatedAt"`
+	CreatedAt      timestamp.Time `json:"createdAt"`
+	UpdatedAt      timestamp.Time `json:"updatedAt"`
 }
 
 // Usage represents volume usage information
@@ -103,33 +103,33 @@ type Usage struct {
 
 // Snapshot represents a volume snapshot
 type Snapshot struct {
-	ID               string    `json:"id"`
-	Name             string    `json:"name"`
-	Preserved        bool      `json:"preserved"`
-	Size             int       `json:"size"`
-	ReclaimableSpace *int      `json:"reclaimableSpace,omitempty"`
-	CreatedAt        time.Time `json:"createdAt"`
+	ID               string         `json:"id"`
+	Name             string         `json:"name"`
+	Preserved        bool           `json:"preserved"`
+	Size             int            `json:"size"`
+	ReclaimableSpace *int           `json:"reclaimableSpace,omitempty"`
+	CreatedAt        timestamp.Time `json:"createdAt"`
 }
 
 // RestoreHistory represents snapshot restore history
 type RestoreHistory struct {
-	RequestedAt   time.Time  `json:"requestedAt"`
-	RequestedIP   string     `json:"requestedIp"`
-	RequestedUser string     `json:"requestedUser"`
-	RestoredAt    *time.Time `json:"restoredAt"`
-	Result        string     `json:"result"`
-	SnapshotID    string     `json:"snapshotId"`
-	SnapshotName  string     `json:"snapshotName"`
-	VolumeID      string     `json:"volumeId"`
+	RequestedAt   timestamp.Time  `json:"requestedAt"`
+	RequestedIP   string          `json:"requestedIp"`
+	RequestedUser string          `json:"requestedUser"`
+	RestoredAt    *timestamp.Time `json:"restoredAt"`
+	Result        string          `json:"result"`
+	SnapshotID    string          `json:"snapshotId"`
+	SnapshotName  string          `json:"snapshotName"`
+	VolumeID      string          `json:"volumeId"`
 }
 
 // VolumeMirrorStat represents volume mirror statistics
 type VolumeMirrorStat struct {
-	LastSuccessTransferBytes   int       `json:"lastSuccessTransferBytes"`
-	LastSuccessTransferEndTime time.Time `json:"lastSuccessTransferEndTime"`
-	LastTransferBytes          int       `json:"lastTransferBytes"`
-	LastTransferEndTime        time.Time `json:"lastTransferEndTime"`
-	LastTransferStatus         string    `json:"lastTransferStatus"`
+	LastSuccessTransferBytes   int            `json:"lastSuccessTransferBytes"`
+	LastSuccessTransferEndTime timestamp.Time `json:"lastSuccessTransferEndTime"`
+	LastTransferBytes          int            `json:"lastTransferBytes"`
+	LastTransferEndTime        timestamp.Time `json:"lastTransferEndTime"`
+	LastTransferStatus         MirrorStatus   `json:"lastTransferStatus"`
 }
 
 // --- Input Types ---
@@ -280,32 +280,82 @@ type GetVolumeMirrorStatOutput struct {
 
 // --- Constants ---
 
+// VolumeStatus is a volume's status. Values the SDK does not know are kept
+// verbatim and report false from every helper.
+type VolumeStatus string
+
 // Volume status constants
 const (
-	VolumeStatusCreating  = "creating"
-	VolumeStatusAvailable = "available"
-	VolumeStatusDeleting  = "deleting"
-	VolumeStatusError     = "error"
-	VolumeStatusModifying = "modifying"
+	VolumeStatusCreating  VolumeStatus = "creating"
+	VolumeStatusAvailable VolumeStatus = "available"
+	VolumeStatusDeleting  VolumeStatus = "deleting"
+	VolumeStatusError     VolumeStatus = "error"
+	VolumeStatusModifying VolumeStatus = "modifying"
 )
 
+// IsTerminal reports whether the volume has settled.
+func (s VolumeStatus) IsTerminal() bool {
+	return s == VolumeStatusAvailable || s == VolumeStatusError
+}
+
+// IsFailed reports whether the volume is in an error state.
+func (s VolumeStatus) IsFailed() bool { return s == VolumeStatusError }
+
+// IsTransitioning reports whether the volume is being created, modified or
+// deleted.
+func (s VolumeStatus) IsTransitioning() bool {
+	return s == VolumeStatusCreating || s == VolumeStatusModifying || s == VolumeStatusDeleting
+}
+
+// InterfaceStatus is a volume interface's status, classified like
+// VolumeStatus.
+type InterfaceStatus string
+
 // Interface status constants
 const (
-	InterfaceStatusCreating  = "creating"
-	InterfaceStatusAvailable = "available"
-	InterfaceStatusDeleting  = "deleting"
-	InterfaceStatusError     = "error"
+	InterfaceStatusCreating  InterfaceStatus = "creating"
+	InterfaceStatusAvailable InterfaceStatus = "available"
+	InterfaceStatusDeleting  InterfaceStatus = "deleting"
+	InterfaceStatusError     InterfaceStatus = "error"
 )
 
+// IsTerminal reports whether the interface has settled.
+func (s InterfaceStatus) IsTerminal() bool {
+	return s == InterfaceStatusAvailable || s == InterfaceStatusError
+}
+
+// IsFailed reports whether the interface is in an error state.
+func (s InterfaceStatus) IsFailed() bool { return s == InterfaceStatusError }
+
+// IsTransitioning reports whether the interface is being created or
+// deleted.
+func (s InterfaceStatus) IsTransitioning() bool {
+	return s == InterfaceStatusCreating || s == InterfaceStatusDeleting
+}
+
 // Mount protocol constants
 const (
 	ProtocolNFS  = "NFS"
 	ProtocolCIFS = "CIFS"
 )
 
+// MirrorStatus is the status of a volume mirror transfer.
+type MirrorStatus string
+
 // Mirror status constants
 const (
-	MirrorStatusSuccess    = "success"
-	MirrorStatusInProgress = "in-progress"
-	MirrorStatusFailed     = "failed"
+	MirrorStatusSuccess    MirrorStatus = "success"
+	MirrorStatusInProgress MirrorStatus = "in-progress"
+	MirrorStatusFailed     MirrorStatus = "failed"
 )
+
+// IsTerminal reports whether the transfer has finished.
+func (s MirrorStatus) IsTerminal() bool {
+	return s == MirrorStatusSuccess || s == MirrorStatusFailed
+}
+
+// IsFailed reports whether the transfer failed.
+func (s MirrorStatus) IsFailed() bool { return s == MirrorStatusFailed }
+
+// IsTransitioning reports whether the transfer is running.
+func (s MirrorStatus) IsTransitioning() bool { return s == MirrorStatusInProgress }
@@ -9,9 +9,14 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/drift"
 	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/client"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/option"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/secret"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
 )
 
 type Client struct {
@@ -21,12 +26,22 @@ type Client struct {
 	tokenProvider *client.IdentityTokenProvider
 	baseURL       string
 	debug         bool
+	drift         *drift.Detector
 }
 
-func NewClient(region string, creds credentials.IdentityCredentials, hc *http.Client, debug bool) *Client {
+// NewClient creates an Object Storage client. Transfers have no timeout
+// unless hc sets one; bound them with the context instead.
+func NewClient(region string, creds credentials.IdentityCredentials, hc *http.Client, debug bool, opts ...option.Option) *Client {
+	settings := option.Apply(opts...)
+	if settings.HTTPClient == nil {
+		settings.HTTPClient = hc
+	}
+
 	c := &Client{
 		region:      region,
 		credentials: creds,
+		httpClient:  client.HTTPClient(settings, http.DefaultClient),
+		drift:       client.Drift(settings),
 		debug:       debug,
 	}
 
@@ -36,6 +51,7 @@ func NewClient(region string, creds credentials.IdentityCredentials, hc *http.Cl
 			creds.GetUsername(),
 			creds.GetPassword(),
 		)
+		c.tokenProvider.SetHTTPClient(settings.HTTPClient)
 	}
 
 	return c
@@ -88,7 +104,7 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body io.Rea
 		fmt.Printf("--- api request ---\n")
 		fmt.Printf("%s %s\n", req.Method, req.URL.String())
 		for k, v := range req.Header {
-			fmt.Printf("%s: %s\n", k, strings.Join(v, ","))
+			fmt.Printf("%s: %s\n", k, debugHeader(k, v))
 		}
 		fmt.Printf("-------------------\n")
 	}
@@ -107,7 +123,7 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body io.Rea
 		fmt.Printf("--- api response ---\n")
 		fmt.Printf("Status: %s\n", resp.Status)
 		for k, v := range resp.Header {
-			fmt.Printf("%s: %s\n", k, strings.Join(v, ","))
+			fmt.Printf("%s: %s\n", k, debugHeader(k, v))
 		}
 		// Note: Body reading would consume it, so we don't dump body here unless we buffer it.
 		// For now header/status is enough to debug 403.
@@ -117,6 +133,30 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body io.Rea
 	return resp, nil
 }
 
+// decode reads a JSON response body into v, checking it for unmapped
+// fields first when drift detection is enabled. Object listings are XML
+// and are not checked.
+func (c *Client) decode(r io.Reader, v interface{}) error {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if err := c.drift.Check("object-storage", "", body, v); err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+// debugHeader returns the header values to log, masking the auth token and
+// temp URL keys.
+func debugHeader(name string, values []string) string {
+	lower := strings.ToLower(name)
+	if lower == "authorization" || strings.Contains(lower, "temp-url-key") || secret.IsSensitive(lower) {
+		return "***"
+	}
+	return strings.Join(values, ",")
+}
+
 func (c *Client) GetAccountInfo(ctx context.Context) (*AccountInfo, error) {
 	resp, err := c.doRequest(ctx, http.MethodHead, "", nil, nil)
 	if err != nil {
@@ -163,11 +203,26 @@ func (c *Client) ListContainers(ctx context.Context, input *ListContainersInput)
 		return nil, fmt.Errorf("list containers: status %d", resp.StatusCode)
 	}
 
-	var containers []Container
-	if err := json.NewDecoder(resp.Body).Decode(&containers); err != nil {
+	var result []struct {
+		Name         string `json:"name"`
+		Count        int64  `json:"count"`
+		Bytes        int64  `json:"bytes"`
+		LastModified string `json:"last_modified"`
+	}
+	if err := c.decode(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("list containers: decode: %w", err)
 	}
 
+	containers := make([]Container, len(result))
+	for i, r := range result {
+		containers[i] = Container{
+			Name:         r.Name,
+			Count:        r.Count,
+			Bytes:        r.Bytes,
+			LastModified: listingTime(r.LastModified),
+		}
+	}
+
 	return &ListContainersOutput{Containers: containers}, nil
 }
 
@@ -397,7 +452,7 @@ func (c *Client) ListObjects(ctx context.Context, containerName string, input *L
 			Hash:         o.Hash,
 			Bytes:        o.Bytes,
 			ContentType:  o.ContentType,
-			LastModified: o.LastModified,
+			LastModified: listingTime(o.LastModified),
 		}
 	}
 
@@ -440,7 +495,8 @@ func (c *Client) PutObject(ctx context.Context, input *PutObjectInput) (*PutObje
 	}
 
 	return &PutObjectOutput{
-		ETag: resp.Header.Get("Etag"),
+		ETag:         resp.Header.Get("Etag"),
+		LastModified: headerTime(resp.Header.Get("Last-Modified")),
 	}, nil
 }
 
@@ -457,13 +513,13 @@ func (c *Client) GetObjectInfo(ctx context.Context, containerName, objectName st
 	}
 
 	contentLength, _ := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64)
-	timestamp, _ := strconv.ParseInt(resp.Header.Get("X-Timestamp"), 10, 64)
 
 	info := &ObjectInfo{
 		ContentType:       resp.Header.Get("Content-Type"),
 		ContentLength:     contentLength,
 		ETag:              resp.Header.Get("Etag"),
-		Timestamp:         timestamp,
+		LastModified:      headerTime(resp.Header.Get("Last-Modified")),
+		Timestamp:         headerTime(resp.Header.Get("X-Timestamp")),
 		ObjectManifest:    resp.Header.Get("X-Object-Manifest"),
 		StaticLargeObject: resp.Header.Get("X-Static-Large-Object") == "True",
 		ManifestETag:      resp.Header.Get("X-Manifest-Etag"),
@@ -471,11 +527,11 @@ func (c *Client) GetObjectInfo(ctx context.Context, containerName, objectName st
 	}
 
 	if deleteAt := resp.Header.Get("X-Delete-At"); deleteAt != "" {
-		v, _ := strconv.ParseInt(deleteAt, 10, 64)
+		v := headerTime(deleteAt)
 		info.DeleteAt = &v
 	}
 	if wormRetain := resp.Header.Get("X-Object-Worm-Retain-Until"); wormRetain != "" {
-		v, _ := strconv.ParseInt(wormRetain, 10, 64)
+		v := headerTime(wormRetain)
 		info.WormRetainUntil = &v
 	}
 
@@ -514,6 +570,7 @@ func (c *Client) GetObject(ctx context.Context, containerName, objectName string
 		ContentType:   resp.Header.Get("Content-Type"),
 		ContentLength: contentLength,
 		ETag:          resp.Header.Get("Etag"),
+		LastModified:  headerTime(resp.Header.Get("Last-Modified")),
 		Metadata:      metadata,
 	}, nil
 }
@@ -600,7 +657,8 @@ func (c *Client) UploadSegment(ctx context.Context, input *UploadSegmentInput) (
 	}
 
 	return &PutObjectOutput{
-		ETag: resp.Header.Get("Etag"),
+		ETag:         resp.Header.Get("Etag"),
+		LastModified: headerTime(resp.Header.Get("Last-Modified")),
 	}, nil
 }
 
@@ -666,9 +724,24 @@ func (c *Client) GetSLOManifest(ctx context.Context, containerName, objectName s
 	}
 
 	var segments []SLOSegment
-	if err := json.NewDecoder(resp.Body).Decode(&segments); err != nil {
+	if err := c.decode(resp.Body, &segments); err != nil {
 		return nil, fmt.Errorf("get SLO manifest %s/%s: decode: %w", containerName, objectName, err)
 	}
 
 	return &GetSLOManifestOutput{Segments: segments}, nil
 }
+
+// listingTime parses a last_modified value from a container or object
+// listing. Swift writes these in UTC without a zone, unlike the KST that
+// timestamp.Parse assumes. Unparseable values yield the zero time.
+func listingTime(s string) timestamp.Time {
+	t, _ := timestamp.ParseInLocation(s, time.UTC)
+	return t
+}
+
+// headerTime parses an HTTP date (Last-Modified) or epoch seconds
+// (X-Timestamp, X-Delete-At) header. Unparseable values yield the zero time.
+func headerTime(s string) timestamp.Time {
+	t, _ := timestamp.Parse(s)
+	return t
+}
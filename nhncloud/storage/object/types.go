@@ -2,6 +2,8 @@ package object
 
 import (
 	"io"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
 )
 
 // Storage Classes
@@ -21,10 +23,10 @@ type AccountInfo struct {
 // ==================== Container Types ====================
 // Container represents a storage container
 type Container struct {
-	Name         string `json:"name"`
-	Count        int64  `json:"count"`
-	Bytes        int64  `json:"bytes"`
-	LastModified string `json:"last_modified,omitempty"`
+	Name         string         `json:"name"`
+	Count        int64          `json:"count"`
+	Bytes        int64          `json:"bytes"`
+	LastModified timestamp.Time `json:"last_modified,omitempty"`
 }
 
 // ListContainersInput contains parameters for listing containers
@@ -104,12 +106,12 @@ type UpdateContainerInput struct {
 // ==================== Object Types ====================
 // Object represents an object in a container
 type Object struct {
-	Name         string `json:"name"`
-	Hash         string `json:"hash"`
-	Bytes        int64  `json:"bytes"`
-	ContentType  string `json:"content_type"`
-	LastModified string `json:"last_modified"`
-	Subdir       string `json:"subdir,omitempty"` // for delimiter queries
+	Name         string         `json:"name"`
+	Hash         string         `json:"hash"`
+	Bytes        int64          `json:"bytes"`
+	ContentType  string         `json:"content_type"`
+	LastModified timestamp.Time `json:"last_modified"`
+	Subdir       string         `json:"subdir,omitempty"` // for delimiter queries
 }
 
 // ListObjectsInput contains parameters for listing objects
@@ -132,10 +134,10 @@ type ObjectInfo struct {
 	ContentType       string
 	ContentLength     int64
 	ETag              string
-	LastModified      string
-	Timestamp         int64
-	DeleteAt          *int64
-	WormRetainUntil   *int64
+	LastModified      timestamp.Time
+	Timestamp         timestamp.Time  // X-Timestamp, when the object was written
+	DeleteAt          *timestamp.Time // scheduled expiry
+	WormRetainUntil   *timestamp.Time
 	ObjectManifest    string // DLO segment path
 	StaticLargeObject bool   // SLO indicator
 	ManifestETag      string // SLO manifest ETag
@@ -156,7 +158,7 @@ type PutObjectInput struct {
 // PutObjectOutput contains the result of object upload
 type PutObjectOutput struct {
 	ETag         string
-	LastModified string
+	LastModified timestamp.Time
 }
 
 // GetObjectOutput contains the result of object download
@@ -165,7 +167,7 @@ type GetObjectOutput struct {
 	ContentType   string
 	ContentLength int64
 	ETag          string
-	LastModified  string
+	LastModified  timestamp.Time
 	Metadata      map[string]string
 }
 
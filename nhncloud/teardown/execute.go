@@ -0,0 +1,247 @@
+package teardown
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/compute"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/waiter"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/inventory"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/loadbalancer"
+)
+
+// Status is how a step ended.
+type Status string
+
+const (
+	Done   Status = "done"
+	Failed Status = "failed"
+	// Blocked means a step this one waits for failed or was blocked, so it
+	// was not attempted.
+	Blocked Status = "blocked"
+	// Canceled means the context ended before the step started.
+	Canceled Status = "canceled"
+)
+
+// Result is how one step of Execute ended.
+type Result struct {
+	Step   Step   `json:"step"`
+	Status Status `json:"status"`
+	// Error is the step's error, or for a blocked step the key of the step
+	// it waited for.
+	Error string `json:"error,omitempty"`
+
+	err error
+}
+
+// Unwrap returns the error of a failed step.
+func (r *Result) Unwrap() error { return r.err }
+
+func (r Result) String() string {
+	s := fmt.Sprintf("%-7s %s", r.Status, &r.Step)
+	if r.Error != "" {
+		s += ": " + r.Error
+	}
+	return s
+}
+
+// IncompleteError reports the steps of Execute that failed, were blocked or
+// were canceled.
+type IncompleteError struct {
+	Results []Result
+}
+
+func (e *IncompleteError) Error() string {
+	var msgs []string
+	for _, r := range e.Results {
+		if r.Status == Failed {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", &r.Step, r.Error))
+		}
+	}
+	msg := fmt.Sprintf("teardown: %d steps did not finish", len(e.Results))
+	if len(msgs) > 0 {
+		msg += ": " + strings.Join(msgs, "; ")
+	}
+	return msg
+}
+
+func (e *IncompleteError) Unwrap() []error {
+	var errs []error
+	for _, r := range e.Results {
+		if r.err != nil {
+			errs = append(errs, r.err)
+		}
+	}
+	return errs
+}
+
+// ExecuteOptions configures Execute.
+type ExecuteOptions struct {
+	// Interval is how often Execute polls a deleted server, load balancer
+	// or gateway. Zero means the waiters' default.
+	Interval time.Duration
+	// OnStep, if set, is called as each step ends, for progress output.
+	OnStep func(Result)
+}
+
+// Execute deletes the plan's steps in order with c, which must be a client
+// for the VPC's region, and waits for each deletion to finish before the
+// steps that depend on it. A resource that is already gone counts as
+// deleted. Bound the whole teardown with a context deadline.
+//
+// Execute checks ctx before each step: once it ends, the remaining steps
+// are reported as canceled and not attempted.
+//
+// Execute returns a Result for every step. If any step failed, was blocked
+// or was canceled, it also returns an *IncompleteError.
+func (p *Plan) Execute(ctx context.Context, c *nhncloud.Client, opts ExecuteOptions) ([]Result, error) {
+	return p.execute(ctx, func(ctx context.Context, s *Step) error {
+		err := run(ctx, c, s, opts.Interval)
+		if waiter.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}, opts.OnStep)
+}
+
+// execute runs do for each step whose dependencies are done.
+func (p *Plan) execute(ctx context.Context, do func(context.Context, *Step) error, onStep func(Result)) ([]Result, error) {
+	results := make([]Result, 0, len(p.Steps))
+	status := make(map[string]Status, len(p.Steps))
+	var incomplete []Result
+	for i := range p.Steps {
+		s := p.Steps[i]
+		r := Result{Step: s, Status: Done}
+		if err := ctx.Err(); err != nil {
+			r.Status, r.Error, r.err = Canceled, err.Error(), err
+		}
+		for _, dep := range s.After {
+			if st, ok := status[dep]; ok && st != Done && r.Status == Done {
+				r.Status, r.Error = Blocked, "waits for "+dep
+			}
+		}
+		if r.Status == Done {
+			if err := do(ctx, &s); err != nil {
+				r.Status, r.Error, r.err = Failed, err.Error(), err
+			}
+		}
+		status[s.Key()] = r.Status
+		results = append(results, r)
+		if r.Status != Done {
+			incomplete = append(incomplete, r)
+		}
+		if onStep != nil {
+			onStep(r)
+		}
+	}
+	if len(incomplete) > 0 {
+		return results, &IncompleteError{Results: incomplete}
+	}
+	return results, nil
+}
+
+// run deletes the resource of one step and waits until it is gone.
+func run(ctx context.Context, c *nhncloud.Client, s *Step, interval time.Duration) error {
+	switch s.Type {
+	case inventory.TypeFloatingIP:
+		if s.Action == ActionDisassociate {
+			_, err := c.FloatingIP().DisassociateFloatingIP(ctx, s.ID)
+			return err
+		}
+		return c.FloatingIP().DeleteFloatingIP(ctx, s.ID)
+
+	case TypePool, TypeListener:
+		lb := c.LoadBalancer()
+		// The load balancer accepts one change at a time.
+		if _, err := lb.WaitForLoadBalancer(ctx, s.LoadBalancerID, loadbalancer.ProvisioningStatusActive, interval); err != nil {
+			return err
+		}
+		var err error
+		if s.Type == TypePool {
+			err = lb.DeletePool(ctx, s.ID)
+		} else {
+			err = lb.DeleteListener(ctx, s.ID)
+		}
+		if err != nil {
+			return err
+		}
+		_, err = lb.WaitForLoadBalancer(ctx, s.LoadBalancerID, loadbalancer.ProvisioningStatusActive, interval)
+		return err
+
+	case inventory.TypeLoadBalancer:
+		if err := c.LoadBalancer().DeleteLoadBalancer(ctx, s.ID); err != nil {
+			return err
+		}
+		_, err := c.LoadBalancer().WaitForLoadBalancer(ctx, s.ID, loadbalancer.ProvisioningStatusDeleted, interval)
+		return err
+
+	case inventory.TypeServer:
+		if err := c.Compute().DeleteServer(ctx, s.ID); err != nil {
+			return err
+		}
+		_, err := c.Compute().WaitForServer(ctx, s.ID, compute.ServerStatusDeleted, interval)
+		return err
+
+	case TypeNATGateway:
+		if err := c.NATGateway().DeleteNATGateway(ctx, s.ID); err != nil {
+			return err
+		}
+		return waitGone(ctx, interval, func(ctx context.Context) error {
+			_, err := c.NATGateway().GetNATGateway(ctx, s.ID)
+			return err
+		})
+
+	case TypeInternetGateway:
+		if err := c.InternetGateway().DeleteInternetGateway(ctx, s.ID); err != nil {
+			return err
+		}
+		return waitGone(ctx, interval, func(ctx context.Context) error {
+			_, err := c.InternetGateway().GetInternetGateway(ctx, s.ID)
+			return err
+		})
+
+	case inventory.TypePort:
+		if s.Action == ActionDetach {
+			if err := detach(ctx, c, s, interval); err != nil {
+				return err
+			}
+		}
+		return c.Port().DeletePort(ctx, s.ID)
+	case inventory.TypeSubnet:
+		return c.VPC().DeleteSubnet(ctx, s.ID)
+	case TypeRoutingTable:
+		return c.VPC().DeleteRoutingTable(ctx, s.ID)
+	case inventory.TypeVPC:
+		return c.VPC().DeleteVPC(ctx, s.ID)
+	}
+	return fmt.Errorf("teardown: unknown step type %q", s.Type)
+}
+
+// detach detaches the port of a step from its server and waits until the
+// port is free, or gone if the server created it.
+func detach(ctx context.Context, c *nhncloud.Client, s *Step, interval time.Duration) error {
+	if err := c.Compute().DetachInterface(ctx, s.ServerID, s.ID); err != nil {
+		return err
+	}
+	return waiter.Poll(ctx, interval, func(ctx context.Context) (bool, error) {
+		out, err := c.Port().GetPort(ctx, s.ID)
+		if err != nil {
+			return false, err
+		}
+		return out.Port.DeviceID == "", nil
+	})
+}
+
+// waitGone polls get until it reports the resource not found.
+func waitGone(ctx context.Context, interval time.Duration, get func(context.Context) error) error {
+	return waiter.Poll(ctx, interval, func(ctx context.Context) (bool, error) {
+		err := get(ctx)
+		if waiter.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+}
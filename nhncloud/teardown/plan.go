@@ -0,0 +1,365 @@
+package teardown
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/inventory"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/floatingip"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/internetgateway"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/loadbalancer"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/natgateway"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/port"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/vpc"
+)
+
+// Options configures PlanVPC.
+type Options struct {
+	// ReleaseFloatingIPs deletes the floating IPs of the VPC's ports
+	// instead of only disassociating them.
+	ReleaseFloatingIPs bool
+}
+
+// resources are the resources in a VPC, as PlanVPC found them.
+type resources struct {
+	vpc       vpc.VPC
+	subnets   []vpc.Subnet
+	tables    []vpc.RoutingTable
+	ports     []port.Port
+	servers   map[string]string // ID to name
+	kept      map[string]bool   // servers with ports in other VPCs
+	fips      []floatingip.FloatingIP
+	lbs       []loadbalancer.LoadBalancer
+	listeners []loadbalancer.Listener
+	pools     []loadbalancer.Pool
+	nats      []natgateway.NATGateway
+	igws      []internetgateway.InternetGateway
+}
+
+// PlanVPC finds everything attached to the VPC and returns the plan to
+// delete it. It only reads; nothing is deleted until Plan.Execute.
+func PlanVPC(ctx context.Context, c *nhncloud.Client, vpcID string, opts Options) (*Plan, error) {
+	r, err := discover(ctx, c, vpcID)
+	if err != nil {
+		return nil, err
+	}
+	return build(r, opts)
+}
+
+func discover(ctx context.Context, c *nhncloud.Client, vpcID string) (*resources, error) {
+	r := &resources{servers: map[string]string{}, kept: map[string]bool{}}
+	v, err := c.VPC().GetVPC(ctx, vpcID)
+	if err != nil {
+		return nil, err
+	}
+	r.vpc = v.VPC
+
+	subnets, err := c.VPC().ListSubnets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inVPC := map[string]bool{}
+	for _, s := range subnets.Subnets {
+		if s.VPCID == vpcID || s.NetworkID == vpcID {
+			r.subnets = append(r.subnets, s)
+			inVPC[s.ID] = true
+		}
+	}
+
+	tables, err := c.VPC().ListRoutingTables(ctx, vpcID)
+	if err != nil {
+		return nil, err
+	}
+	tableIDs := map[string]bool{}
+	for _, t := range tables.RoutingTables {
+		if t.VPCID == vpcID {
+			r.tables = append(r.tables, t)
+			tableIDs[t.ID] = true
+		}
+	}
+
+	ports, err := c.Port().ListPorts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	portIDs := map[string]bool{}
+	for _, p := range ports.Ports {
+		if !portInVPC(p, vpcID, inVPC) {
+			continue
+		}
+		r.ports = append(r.ports, p)
+		portIDs[p.ID] = true
+		if isServerPort(p) {
+			r.servers[p.DeviceID] = ""
+		}
+	}
+	// A server with a port in another VPC outlives this one.
+	for _, p := range ports.Ports {
+		if _, ok := r.servers[p.DeviceID]; ok && isServerPort(p) && !portInVPC(p, vpcID, inVPC) {
+			delete(r.servers, p.DeviceID)
+			r.kept[p.DeviceID] = true
+		}
+	}
+	if len(r.servers) > 0 {
+		servers, err := c.Compute().ListServers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range servers.Servers {
+			if _, ok := r.servers[s.ID]; ok {
+				r.servers[s.ID] = s.Name
+			}
+		}
+	}
+
+	fips, err := c.FloatingIP().ListFloatingIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, ip := range fips.FloatingIPs {
+		if ip.PortID != nil && portIDs[*ip.PortID] {
+			r.fips = append(r.fips, ip)
+		}
+	}
+
+	if err := discoverLoadBalancers(ctx, c, r, vpcID, inVPC); err != nil {
+		return nil, err
+	}
+
+	nats, err := c.NATGateway().ListNATGateways(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range nats.NATGateways {
+		if n.VPCID == vpcID || inVPC[n.SubnetID] {
+			r.nats = append(r.nats, n)
+		}
+	}
+
+	igws, err := c.InternetGateway().ListInternetGateways(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range igws.InternetGateways {
+		if tableIDs[g.RoutingTableID] {
+			r.igws = append(r.igws, g)
+		}
+	}
+	return r, nil
+}
+
+// isServerPort reports whether p is attached to a server.
+func isServerPort(p port.Port) bool {
+	return strings.HasPrefix(p.DeviceOwner, "compute:") && p.DeviceID != ""
+}
+
+// portInVPC reports whether p is on the VPC's network or has an address in
+// one of its subnets.
+func portInVPC(p port.Port, vpcID string, subnets map[string]bool) bool {
+	if p.NetworkID == vpcID {
+		return true
+	}
+	for _, ip := range p.FixedIPs {
+		if subnets[ip.SubnetID] {
+			return true
+		}
+	}
+	return false
+}
+
+func discoverLoadBalancers(ctx context.Context, c *nhncloud.Client, r *resources, vpcID string, subnets map[string]bool) error {
+	lbs, err := c.LoadBalancer().ListLoadBalancers(ctx)
+	if err != nil {
+		return err
+	}
+	// owner maps the listeners and pools the load balancers name to them.
+	owner := map[string]string{}
+	for _, lb := range lbs.LoadBalancers {
+		if lb.VIPNetworkID != vpcID && !subnets[lb.VIPSubnetID] {
+			continue
+		}
+		r.lbs = append(r.lbs, lb)
+		owner[lb.ID] = lb.ID
+		for _, ref := range lb.Listeners {
+			owner[ref.ID] = lb.ID
+		}
+		for _, ref := range lb.Pools {
+			owner[ref.ID] = lb.ID
+		}
+	}
+	if len(r.lbs) == 0 {
+		return nil
+	}
+
+	listeners, err := c.LoadBalancer().ListListeners(ctx)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners.Listeners {
+		if id := ownerOf(owner, l.LoadBalancerID, l.ID); id != "" {
+			l.LoadBalancerID = id
+			r.listeners = append(r.listeners, l)
+		}
+	}
+	pools, err := c.LoadBalancer().ListPools(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range pools.Pools {
+		if id := ownerOf(owner, p.LoadBalancerID, p.ID); id != "" {
+			p.LoadBalancerID = id
+			r.pools = append(r.pools, p)
+		}
+	}
+	return nil
+}
+
+// ownerOf returns the load balancer of a listener or pool that names
+// lbID, or that a load balancer names, if it is one of the VPC's.
+func ownerOf(owner map[string]string, lbID, id string) string {
+	if lb, ok := owner[lbID]; ok && lbID != "" {
+		return lb
+	}
+	return owner[id]
+}
+
+// build turns the resources into an ordered plan.
+func build(r *resources, opts Options) (*Plan, error) {
+	var steps []Step
+	add := func(typ, id, name, action string, after ...string) *Step {
+		steps = append(steps, Step{Type: typ, ID: id, Name: name, Action: action, After: after})
+		return &steps[len(steps)-1]
+	}
+	key := func(typ, id string) string { return typ + "/" + id }
+
+	fipAction := ActionDisassociate
+	if opts.ReleaseFloatingIPs {
+		fipAction = ActionDelete
+	}
+	fipsOnPort := map[string][]string{}
+	for _, ip := range r.fips {
+		add(inventory.TypeFloatingIP, ip.ID, ip.FloatingIPAddress, fipAction)
+		fipsOnPort[*ip.PortID] = append(fipsOnPort[*ip.PortID], key(inventory.TypeFloatingIP, ip.ID))
+	}
+
+	for _, p := range r.pools {
+		add(TypePool, p.ID, p.Name, ActionDelete).LoadBalancerID = p.LoadBalancerID
+	}
+	for _, l := range r.listeners {
+		var after []string
+		for _, p := range r.pools {
+			if p.ListenerID == l.ID || p.ID == l.DefaultPoolID {
+				after = append(after, key(TypePool, p.ID))
+			}
+		}
+		add(TypeListener, l.ID, l.Name, ActionDelete, after...).LoadBalancerID = l.LoadBalancerID
+	}
+	vipPort := map[string]string{}
+	for _, lb := range r.lbs {
+		var after []string
+		for _, l := range r.listeners {
+			if l.LoadBalancerID == lb.ID {
+				after = append(after, key(TypeListener, l.ID))
+			}
+		}
+		for _, p := range r.pools {
+			if p.LoadBalancerID == lb.ID {
+				after = append(after, key(TypePool, p.ID))
+			}
+		}
+		add(inventory.TypeLoadBalancer, lb.ID, lb.Name, ActionDelete, after...)
+		vipPort[lb.VIPPortID] = lb.ID
+	}
+
+	serverPorts := map[string][]string{}
+	for _, p := range r.ports {
+		if isServerPort(p) {
+			serverPorts[p.DeviceID] = append(serverPorts[p.DeviceID], p.ID)
+		}
+	}
+	for id, name := range r.servers {
+		var after []string
+		for _, portID := range serverPorts[id] {
+			after = append(after, fipsOnPort[portID]...)
+		}
+		add(inventory.TypeServer, id, name, ActionDelete, after...)
+	}
+
+	for _, n := range r.nats {
+		add(TypeNATGateway, n.ID, n.Name, ActionDelete)
+	}
+	for _, g := range r.igws {
+		add(TypeInternetGateway, g.ID, g.Name, ActionDelete)
+	}
+
+	portsInSubnet := map[string][]string{}
+	for _, p := range r.ports {
+		// Ports of the network itself, such as DHCP and router ports, go
+		// with their subnet.
+		if strings.HasPrefix(p.DeviceOwner, "network:") {
+			continue
+		}
+		after := append([]string(nil), fipsOnPort[p.ID]...)
+		action, serverID := ActionDelete, ""
+		if isServerPort(p) {
+			if _, ok := r.servers[p.DeviceID]; ok {
+				after = append(after, key(inventory.TypeServer, p.DeviceID))
+			} else if r.kept[p.DeviceID] {
+				action, serverID = ActionDetach, p.DeviceID
+			}
+		}
+		if lbID, ok := vipPort[p.ID]; ok {
+			after = append(after, key(inventory.TypeLoadBalancer, lbID))
+		}
+		add(inventory.TypePort, p.ID, p.Name, action, after...).ServerID = serverID
+		for _, ip := range p.FixedIPs {
+			portsInSubnet[ip.SubnetID] = append(portsInSubnet[ip.SubnetID], key(inventory.TypePort, p.ID))
+		}
+	}
+
+	var subnetKeys []string
+	for _, s := range r.subnets {
+		after := append([]string(nil), portsInSubnet[s.ID]...)
+		for _, lb := range r.lbs {
+			if lb.VIPSubnetID == s.ID {
+				after = append(after, key(inventory.TypeLoadBalancer, lb.ID))
+			}
+		}
+		for _, n := range r.nats {
+			if n.SubnetID == s.ID {
+				after = append(after, key(TypeNATGateway, n.ID))
+			}
+		}
+		add(inventory.TypeSubnet, s.ID, s.Name, ActionDelete, after...)
+		subnetKeys = append(subnetKeys, key(inventory.TypeSubnet, s.ID))
+	}
+
+	for _, t := range r.tables {
+		// The default routing table goes with the VPC.
+		if t.DefaultTable {
+			continue
+		}
+		// Subnets are associated with a routing table until deleted.
+		after := append([]string(nil), subnetKeys...)
+		for _, g := range r.igws {
+			if g.RoutingTableID == t.ID {
+				after = append(after, key(TypeInternetGateway, g.ID))
+			}
+		}
+		add(TypeRoutingTable, t.ID, t.Name, ActionDelete, after...)
+	}
+
+	all := make([]string, len(steps))
+	for i := range steps {
+		all[i] = steps[i].Key()
+	}
+	add(inventory.TypeVPC, r.vpc.ID, r.vpc.Name, ActionDelete, all...)
+
+	ordered, err := order(steps)
+	if err != nil {
+		return nil, fmt.Errorf("plan teardown of VPC %s: %w", r.vpc.ID, err)
+	}
+	return &Plan{VPCID: r.vpc.ID, Steps: ordered}, nil
+}
@@ -0,0 +1,178 @@
+// Package teardown deletes a VPC and everything in it, in dependency order.
+//
+// vpc.DeleteVPC fails while servers, load balancers, gateways, ports or
+// subnets still use the VPC. PlanVPC finds them and orders them into a Plan
+// in which every resource comes after the resources that refer to it: a
+// floating IP is released before its port, a load balancer's pools before
+// its listeners and the load balancer itself, every port before its subnet,
+// and the VPC last. Execute then deletes the steps in that order, waiting
+// for servers, load balancers and gateways to go away before moving on.
+//
+// A server that also has ports in other VPCs is not deleted: its ports in
+// this VPC are detached from it and deleted instead.
+//
+//	plan, err := teardown.PlanVPC(ctx, client, vpcID, teardown.Options{ReleaseFloatingIPs: true})
+//	if err != nil {
+//	    return err
+//	}
+//	plan.WriteText(os.Stdout)
+//	_, err = plan.Execute(ctx, client, teardown.ExecuteOptions{
+//	    OnStep: func(r teardown.Result) { fmt.Println(r) },
+//	})
+//
+// A step that fails does not stop the teardown: the steps that must wait for
+// it are reported as blocked, and the rest still run.
+package teardown
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/inventory"
+)
+
+// Resource types of steps, beyond the inventory's.
+const (
+	TypeListener        = "network.load-balancer-listener"
+	TypePool            = "network.load-balancer-pool"
+	TypeNATGateway      = "network.nat-gateway"
+	TypeInternetGateway = "network.internet-gateway"
+	TypeRoutingTable    = "network.routing-table"
+)
+
+// Actions of steps.
+const (
+	ActionDelete = "delete"
+	// ActionDisassociate detaches a floating IP from its port and keeps it.
+	ActionDisassociate = "disassociate"
+	// ActionDetach detaches a port from its server, which is kept, and
+	// deletes the port.
+	ActionDetach = "detach"
+)
+
+// Step is one resource to delete.
+type Step struct {
+	// Type is the resource type, such as inventory.TypeServer or
+	// TypeNATGateway.
+	Type   string `json:"type"`
+	ID     string `json:"id"`
+	Name   string `json:"name,omitempty"`
+	Action string `json:"action"`
+	// After are the keys of the steps that must be done first.
+	After []string `json:"after,omitempty"`
+	// LoadBalancerID is the load balancer of a listener or pool, which is
+	// busy for a while after either is deleted.
+	LoadBalancerID string `json:"loadBalancerId,omitempty"`
+	// ServerID is the server a port is detached from.
+	ServerID string `json:"serverId,omitempty"`
+}
+
+// Key identifies the step within its plan.
+func (s *Step) Key() string {
+	return s.Type + "/" + s.ID
+}
+
+func (s *Step) String() string {
+	str := s.Action + " " + s.Type + " " + s.ID
+	if s.Name != "" && s.Name != s.ID {
+		str += " (" + s.Name + ")"
+	}
+	if s.ServerID != "" {
+		str += " from server " + s.ServerID
+	}
+	return str
+}
+
+// Plan is the ordered teardown of a VPC.
+type Plan struct {
+	VPCID string `json:"vpcId"`
+	// Steps are in the order Execute runs them.
+	Steps []Step `json:"steps"`
+}
+
+// WriteText writes the plan for people, one numbered step per line.
+func (p *Plan) WriteText(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Teardown of VPC %s: %d steps\n", p.VPCID, len(p.Steps))
+	for i := range p.Steps {
+		fmt.Fprintf(&b, "%4d. %s\n", i+1, &p.Steps[i])
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// typeRank orders steps that are ready at the same time, so plans are
+// stable and read from the outside of the VPC in.
+var typeRank = map[string]int{
+	inventory.TypeFloatingIP:   0,
+	TypePool:                   1,
+	TypeListener:               2,
+	inventory.TypeLoadBalancer: 3,
+	inventory.TypeServer:       4,
+	TypeNATGateway:             5,
+	TypeInternetGateway:        6,
+	inventory.TypePort:         7,
+	inventory.TypeSubnet:       8,
+	TypeRoutingTable:           9,
+	inventory.TypeVPC:          10,
+}
+
+// order sorts steps so that each comes after the steps in its After. It
+// fails if the steps wait for each other in a cycle.
+func order(steps []Step) ([]Step, error) {
+	byKey := make(map[string]*Step, len(steps))
+	for i := range steps {
+		byKey[steps[i].Key()] = &steps[i]
+	}
+	waiting := make(map[string]int, len(steps))
+	unblocks := make(map[string][]string)
+	for i := range steps {
+		s := &steps[i]
+		for _, dep := range s.After {
+			if _, ok := byKey[dep]; !ok {
+				continue
+			}
+			waiting[s.Key()]++
+			unblocks[dep] = append(unblocks[dep], s.Key())
+		}
+	}
+
+	var ready []*Step
+	for i := range steps {
+		if waiting[steps[i].Key()] == 0 {
+			ready = append(ready, &steps[i])
+		}
+	}
+	ordered := make([]Step, 0, len(steps))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool {
+			a, b := ready[i], ready[j]
+			if typeRank[a.Type] != typeRank[b.Type] {
+				return typeRank[a.Type] < typeRank[b.Type]
+			}
+			return a.ID < b.ID
+		})
+		s := ready[0]
+		ready = ready[1:]
+		ordered = append(ordered, *s)
+		for _, key := range unblocks[s.Key()] {
+			waiting[key]--
+			if waiting[key] == 0 {
+				ready = append(ready, byKey[key])
+			}
+		}
+	}
+	if len(ordered) != len(steps) {
+		var stuck []string
+		for key, n := range waiting {
+			if n > 0 {
+				stuck = append(stuck, key)
+			}
+		}
+		sort.Strings(stuck)
+		return nil, fmt.Errorf("dependency cycle among %s", strings.Join(stuck, ", "))
+	}
+	return ordered, nil
+}
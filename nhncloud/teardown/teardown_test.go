@@ -0,0 +1,287 @@
+package teardown
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/cloudtest"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/floatingip"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/internetgateway"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/loadbalancer"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/natgateway"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/port"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/vpc"
+)
+
+func testResources() *resources {
+	portID := "p-web"
+	return &resources{
+		vpc:     vpc.VPC{ID: "vpc1", Name: "ci"},
+		subnets: []vpc.Subnet{{ID: "sub1", Name: "ci-subnet", VPCID: "vpc1"}},
+		tables: []vpc.RoutingTable{
+			{ID: "rt-default", VPCID: "vpc1", DefaultTable: true},
+			{ID: "rt2", VPCID: "vpc1"},
+		},
+		ports: []port.Port{
+			{ID: "p-web", NetworkID: "vpc1", DeviceID: "s1", DeviceOwner: "compute:kr-pub-a", FixedIPs: []port.FixedIP{{SubnetID: "sub1"}}},
+			{ID: "p-vip", NetworkID: "vpc1", DeviceID: "lb1", DeviceOwner: "Octavia", FixedIPs: []port.FixedIP{{SubnetID: "sub1"}}},
+			{ID: "p-dhcp", NetworkID: "vpc1", DeviceOwner: "network:dhcp", FixedIPs: []port.FixedIP{{SubnetID: "sub1"}}},
+		},
+		servers:   map[string]string{"s1": "web-1"},
+		fips:      []floatingip.FloatingIP{{ID: "fip1", FloatingIPAddress: "133.186.0.1", PortID: &portID}},
+		lbs:       []loadbalancer.LoadBalancer{{ID: "lb1", VIPSubnetID: "sub1", VIPPortID: "p-vip"}},
+		listeners: []loadbalancer.Listener{{ID: "l1", LoadBalancerID: "lb1", DefaultPoolID: "pool1"}},
+		pools:     []loadbalancer.Pool{{ID: "pool1", LoadBalancerID: "lb1"}},
+		nats:      []natgateway.NATGateway{{ID: "nat1", SubnetID: "sub1"}},
+		igws:      []internetgateway.InternetGateway{{ID: "igw1", RoutingTableID: "rt2"}},
+	}
+}
+
+func TestBuildOrdersByDependency(t *testing.T) {
+	plan, err := build(testResources(), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, s := range plan.Steps {
+		keys = append(keys, s.Key())
+	}
+	want := []string{
+		"network.floating-ip/fip1",
+		"network.load-balancer-pool/pool1",
+		"network.load-balancer-listener/l1",
+		"network.load-balancer/lb1",
+		"compute.server/s1",
+		"network.nat-gateway/nat1",
+		"network.internet-gateway/igw1",
+		"network.port/p-vip",
+		"network.port/p-web",
+		"network.subnet/sub1",
+		"network.routing-table/rt2",
+		"network.vpc/vpc1",
+	}
+	if !reflect.DeepEqual(keys, want) {
+		t.Fatalf("steps =\n%s\nwant\n%s", strings.Join(keys, "\n"), strings.Join(want, "\n"))
+	}
+	if plan.Steps[0].Action != ActionDisassociate || plan.Steps[1].LoadBalancerID != "lb1" {
+		t.Errorf("first steps = %+v", plan.Steps[:2])
+	}
+
+	var text bytes.Buffer
+	plan.WriteText(&text)
+	if !strings.Contains(text.String(), "   5. delete compute.server s1 (web-1)\n") {
+		t.Errorf("plan text:\n%s", text.String())
+	}
+
+	if _, err := order([]Step{{Type: "a", ID: "1", After: []string{"b/2"}}, {Type: "b", ID: "2", After: []string{"a/1"}}}); err == nil {
+		t.Error("expected a cycle error")
+	}
+}
+
+func TestExecuteBlocksDependents(t *testing.T) {
+	plan, err := build(testResources(), Options{ReleaseFloatingIPs: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	refused := errors.New("listener still in use")
+	var ran []string
+	results, err := plan.execute(context.Background(), func(ctx context.Context, s *Step) error {
+		ran = append(ran, s.Key())
+		if s.Type == TypeListener {
+			return refused
+		}
+		return nil
+	}, nil)
+
+	var ie *IncompleteError
+	if !errors.As(err, &ie) || !errors.Is(err, refused) {
+		t.Fatalf("expected an IncompleteError wrapping the listener error, got %v", err)
+	}
+	got := map[string]Status{}
+	for _, r := range results {
+		got[r.Step.Key()] = r.Status
+	}
+	// The listener blocks its load balancer, the VIP port, the subnet and
+	// everything after; the server and gateways still go.
+	for key, want := range map[string]Status{
+		"network.load-balancer-listener/l1": Failed,
+		"network.load-balancer/lb1":         Blocked,
+		"network.port/p-vip":                Blocked,
+		"network.subnet/sub1":               Blocked,
+		"network.vpc/vpc1":                  Blocked,
+		"compute.server/s1":                 Done,
+		"network.port/p-web":                Done,
+		"network.internet-gateway/igw1":     Done,
+	} {
+		if got[key] != want {
+			t.Errorf("%s = %s, want %s", key, got[key], want)
+		}
+	}
+	if len(ran) != 7 {
+		t.Errorf("ran %d steps, want 7: %v", len(ran), ran)
+	}
+}
+
+func TestExecuteStopsWhenCanceled(t *testing.T) {
+	plan, err := build(testResources(), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var ran int
+	results, err := plan.execute(ctx, func(ctx context.Context, s *Step) error {
+		if ran++; ran == 2 {
+			cancel()
+		}
+		return nil
+	}, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the context error, got %v", err)
+	}
+	if ran != 2 {
+		t.Errorf("ran %d steps after the cancel", ran-2)
+	}
+	for _, r := range results[2:] {
+		if r.Status != Canceled {
+			t.Errorf("%s = %s, want %s", r.Step.Key(), r.Status, Canceled)
+		}
+	}
+}
+
+// fakeVPC serves vpc1, whose subnet holds web-1 (s1), a port of db-1 (s2)
+// and a DHCP port. db-1 also has a port in vpc2.
+type fakeVPC struct {
+	mu       sync.Mutex
+	deleted  map[string]bool
+	detached bool
+}
+
+func (f *fakeVPC) serve(t *testing.T, srv *cloudtest.Server) {
+	ports := []map[string]interface{}{
+		{"id": "p-web", "network_id": "vpc1", "device_id": "s1", "device_owner": "compute:kr-pub-a", "fixed_ips": []map[string]string{{"subnet_id": "sub1"}}},
+		{"id": "p-db", "network_id": "vpc1", "device_id": "s2", "device_owner": "compute:kr-pub-a", "fixed_ips": []map[string]string{{"subnet_id": "sub1"}}},
+		{"id": "p-dhcp", "network_id": "vpc1", "device_owner": "network:dhcp", "fixed_ips": []map[string]string{{"subnet_id": "sub1"}}},
+		{"id": "p-db-2", "network_id": "vpc2", "device_id": "s2", "device_owner": "compute:kr-pub-a", "fixed_ips": []map[string]string{{"subnet_id": "sub2"}}},
+	}
+	list := map[string]interface{}{
+		"network.test/v2.0/subnets": map[string]interface{}{"subnets": []map[string]string{
+			{"id": "sub1", "vpc_id": "vpc1"}, {"id": "sub2", "vpc_id": "vpc2"},
+		}},
+		"network.test/v2.0/routingtables": map[string]interface{}{"routingtables": []map[string]interface{}{
+			{"id": "rt1", "vpc_id": "vpc1", "default_table": true},
+		}},
+		"network.test/v2.0/ports": map[string]interface{}{"ports": ports},
+		"network.test/v2.0/floatingips": map[string]interface{}{"floatingips": []map[string]string{
+			{"id": "fip1", "floating_ip_address": "133.186.0.1", "port_id": "p-web"},
+		}},
+		"network.test/v2.0/lbaas/loadbalancers": map[string]interface{}{"loadbalancers": []interface{}{}},
+		"network.test/v2.0/natgateways":         map[string]interface{}{"natgateways": []interface{}{}},
+		"network.test/v2.0/internetgateways":    map[string]interface{}{"internetgateways": []interface{}{}},
+		"compute.test/servers/detail": map[string]interface{}{"servers": []map[string]string{
+			{"id": "s1", "name": "web-1"}, {"id": "s2", "name": "db-1"},
+		}},
+	}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		path := r.Host + r.URL.Path
+		switch {
+		case r.Method == http.MethodGet && list[path] != nil:
+			cloudtest.WriteJSON(w, http.StatusOK, list[path])
+		case r.Method == http.MethodGet && path == "network.test/v2.0/vpcs/vpc1":
+			cloudtest.WriteJSON(w, http.StatusOK, map[string]interface{}{"vpc": map[string]string{"id": "vpc1", "name": "ci"}})
+		case r.Method == http.MethodGet && path == "network.test/v2.0/ports/p-db":
+			owner := "s2"
+			if f.detached {
+				owner = ""
+			}
+			cloudtest.WriteJSON(w, http.StatusOK, map[string]interface{}{"port": map[string]string{"id": "p-db", "device_id": owner}})
+		case r.Method == http.MethodGet && path == "compute.test/servers/s1" && f.deleted[path]:
+			cloudtest.WriteJSON(w, http.StatusNotFound, map[string]interface{}{"itemNotFound": map[string]string{"message": "gone"}})
+		case r.Method == http.MethodPut && path == "network.test/v2.0/floatingips/fip1":
+			cloudtest.WriteJSON(w, http.StatusOK, map[string]interface{}{"floatingip": map[string]string{"id": "fip1"}})
+		case r.Method == http.MethodDelete && path == "compute.test/servers/s2/os-interface/p-db":
+			f.detached = true
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodDelete:
+			f.deleted[strings.Replace(path, "network.test/v2.0/vpcsubnets/", "network.test/v2.0/subnets/", 1)] = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, path)
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}
+	srv.Mux.HandleFunc("network.test/", handler)
+	srv.Mux.HandleFunc("compute.test/", handler)
+}
+
+func TestPlanAndExecuteVPC(t *testing.T) {
+	srv := cloudtest.New(t)
+	fake := &fakeVPC{deleted: map[string]bool{}}
+	fake.serve(t, srv)
+	c, err := nhncloud.New(&nhncloud.Config{
+		Region:              "kr1",
+		Credentials:         credentials.NewStatic("access-key", "secret-key"),
+		IdentityCredentials: credentials.NewStaticIdentity("user", "pass", "tenant"),
+		HTTPClient:          srv.Client(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	plan, err := PlanVPC(ctx, c, "vpc1", Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var steps []string
+	for _, s := range plan.Steps {
+		steps = append(steps, s.String())
+	}
+	want := []string{
+		"disassociate network.floating-ip fip1 (133.186.0.1)",
+		"delete compute.server s1 (web-1)",
+		"detach network.port p-db from server s2",
+		"delete network.port p-web",
+		"delete network.subnet sub1",
+		"delete network.vpc vpc1 (ci)",
+	}
+	if !reflect.DeepEqual(steps, want) {
+		t.Fatalf("steps =\n%s\nwant\n%s", strings.Join(steps, "\n"), strings.Join(want, "\n"))
+	}
+
+	if _, err := plan.Execute(ctx, c, ExecuteOptions{Interval: time.Millisecond}); err != nil {
+		t.Fatal(err)
+	}
+	var changes []string
+	for _, req := range srv.Requests() {
+		if !strings.HasPrefix(req, "GET ") && !strings.HasPrefix(req, "POST ") {
+			changes = append(changes, req)
+		}
+	}
+	want = []string{
+		"PUT network.test/v2.0/floatingips/fip1",
+		"DELETE compute.test/servers/s1",
+		"DELETE compute.test/servers/s2/os-interface/p-db",
+		"DELETE network.test/v2.0/ports/p-db",
+		"DELETE network.test/v2.0/ports/p-web",
+		"DELETE network.test/v2.0/vpcsubnets/sub1",
+		"DELETE network.test/v2.0/vpcs/vpc1",
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("changes =\n%s\nwant\n%s", strings.Join(changes, "\n"), strings.Join(want, "\n"))
+	}
+	if fake.deleted["compute.test/servers/s2"] {
+		t.Error("deleted db-1, which is still in vpc2")
+	}
+}
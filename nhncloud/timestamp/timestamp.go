@@ -0,0 +1,191 @@
+// Package timestamp provides the time type used for every timestamp field
+// in the SDK's response types.
+//
+// NHN Cloud APIs encode instants in several ways: RFC 3339 with or without
+// fractional seconds, local time without a zone ("2006-01-02T15:04:05",
+// which the services mean as KST), and Unix epoch seconds as a number or a
+// string. Time decodes all of them, so callers can compare and sort
+// timestamps from any service without parsing them:
+//
+//	sort.Slice(clusters, func(i, j int) bool {
+//	    return clusters[i].CreatedAt.Before(clusters[j].CreatedAt)
+//	})
+package timestamp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KST is the zone NHN Cloud APIs use for timestamps written without one.
+// It is a fixed +09:00 offset, so decoding does not depend on the host's
+// time zone database.
+var KST = time.FixedZone("KST", 9*60*60)
+
+// Layouts with an explicit zone, tried in order.
+var zonedLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z0700",
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	time.RFC1123,
+	time.RFC1123Z,
+}
+
+// Layouts without a zone, interpreted in the caller's location.
+var localLayouts = []string{
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// epochMillisThreshold separates epoch seconds from epoch milliseconds:
+// 1e12 seconds is over 30,000 years away, while 1e12 milliseconds is 2001.
+const epochMillisThreshold = 1e12
+
+// Time is a time.Time that decodes from any format NHN Cloud APIs emit and
+// encodes as RFC 3339. JSON null and "" decode to the zero Time, which
+// encodes as null.
+type Time struct {
+	time.Time
+}
+
+// New returns t as a Time.
+func New(t time.Time) Time { return Time{Time: t} }
+
+// Parse parses s in any supported format. Timestamps without a zone are
+// taken as KST.
+func Parse(s string) (Time, error) {
+	return ParseInLocation(s, KST)
+}
+
+// ParseInLocation is like Parse but interprets timestamps without a zone in
+// loc. Use it for APIs that write UTC without a zone, such as OpenStack
+// Swift listings.
+func ParseInLocation(s string, loc *time.Location) (Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Time{}, nil
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return fromEpoch(f), nil
+	}
+	for _, layout := range zonedLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return Time{Time: t}, nil
+		}
+	}
+	for _, layout := range localLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return Time{Time: t}, nil
+		}
+	}
+	return Time{}, fmt.Errorf("timestamp: unrecognized time %q", s)
+}
+
+// fromEpoch converts Unix seconds, or milliseconds for values too large to
+// be seconds, to a Time.
+func fromEpoch(f float64) Time {
+	if math.Abs(f) >= epochMillisThreshold {
+		f /= 1000
+	}
+	sec, frac := math.Modf(f)
+	return Time{Time: time.Unix(int64(sec), int64(math.Round(frac*1e9)))}
+}
+
+// MustParse is like Parse but panics on error. It is meant for tests and
+// constants.
+func MustParse(s string) Time {
+	t, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Before reports whether t is before u.
+func (t Time) Before(u Time) bool { return t.Time.Before(u.Time) }
+
+// After reports whether t is after u.
+func (t Time) After(u Time) bool { return t.Time.After(u.Time) }
+
+// Equal reports whether t and u are the same instant.
+func (t Time) Equal(u Time) bool { return t.Time.Equal(u.Time) }
+
+// Compare returns -1, 0 or +1 as t is before, equal to or after u, for use
+// with slices.SortFunc.
+func (t Time) Compare(u Time) int {
+	switch {
+	case t.Time.Before(u.Time):
+		return -1
+	case t.Time.After(u.Time):
+		return 1
+	}
+	return 0
+}
+
+// String formats t as RFC 3339, or "" for the zero Time.
+func (t Time) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// MarshalJSON encodes t as an RFC 3339 string, or null for the zero Time.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Format(time.RFC3339Nano))
+}
+
+// UnmarshalJSON decodes a string in any format Parse accepts, a number of
+// epoch seconds, or null.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*t = Time{}
+		return nil
+	}
+	if len(data) > 0 && data[0] != '"' {
+		f, err := strconv.ParseFloat(string(data), 64)
+		if err != nil {
+			return fmt.Errorf("timestamp: unrecognized time %s", data)
+		}
+		*t = fromEpoch(f)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalText encodes t as RFC 3339, or empty for the zero Time.
+func (t Time) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText decodes any format Parse accepts, for XML and form values.
+func (t *Time) UnmarshalText(data []byte) error {
+	parsed, err := Parse(string(data))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
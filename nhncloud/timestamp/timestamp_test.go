@@ -0,0 +1,89 @@
+package timestamp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestUnmarshalJSON(t *testing.T) {
+	want := time.Date(2024, 5, 13, 0, 41, 27, 0, time.UTC)
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"rfc3339 utc", `"2024-05-13T00:41:27Z"`},
+		{"rfc3339 offset", `"2024-05-13T09:41:27+09:00"`},
+		{"rfc3339 compact offset", `"2024-05-13T09:41:27+0900"`},
+		{"kst without zone", `"2024-05-13T09:41:27"`},
+		{"kst with space", `"2024-05-13 09:41:27"`},
+		{"epoch seconds", `1715560887`},
+		{"epoch seconds string", `"1715560887"`},
+		{"epoch milliseconds", `1715560887000`},
+		{"http date", `"Mon, 13 May 2024 00:41:27 GMT"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Time
+			if err := json.Unmarshal([]byte(tt.in), &got); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Time.Equal(want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestZero(t *testing.T) {
+	for _, in := range []string{`null`, `""`} {
+		var got Time
+		if err := json.Unmarshal([]byte(in), &got); err != nil {
+			t.Fatalf("%s: %v", in, err)
+		}
+		if !got.IsZero() {
+			t.Errorf("%s: got %v, want zero", in, got)
+		}
+	}
+	out, err := json.Marshal(struct{ At Time }{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"At":null}` {
+		t.Errorf("got %s", out)
+	}
+}
+
+func TestFractionalEpoch(t *testing.T) {
+	got, err := Parse("1715560887.25")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Unix(1715560887, 250000000); !got.Time.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestParseInLocation(t *testing.T) {
+	got, err := ParseInLocation("2024-05-13T00:41:27.513970", time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 5, 13, 0, 41, 27, 513970000, time.UTC); !got.Time.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	var got Time
+	if err := json.Unmarshal([]byte(`"yesterday"`), &got); err == nil {
+		t.Errorf("want error, got %v", got)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	a, b := MustParse("2024-05-13T00:00:00Z"), MustParse("2024-05-13T09:00:01+09:00")
+	if !a.Before(b) || b.Before(a) || a.Compare(b) != -1 || b.Compare(a) != 1 || a.Compare(a) != 0 {
+		t.Errorf("wrong ordering of %v and %v", a, b)
+	}
+}
@@ -0,0 +1,156 @@
+package nhncloud
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/compute"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/container/nks"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/credentials"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/errors"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/internal/cloudtest"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/network/loadbalancer"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/storage/block"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/storage/nas"
+)
+
+// sequence answers pattern with responses in order, repeating the last one.
+// An empty response answers 404.
+func sequence(srv *cloudtest.Server, pattern string, responses ...string) *int {
+	polls := new(int)
+	srv.Mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		body := responses[len(responses)-1]
+		if *polls < len(responses) {
+			body = responses[*polls]
+		}
+		*polls++
+		if body == "" {
+			cloudtest.WriteJSON(w, http.StatusNotFound, map[string]string{"message": "not found"})
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	})
+	return polls
+}
+
+func TestWaiters(t *testing.T) {
+	srv := cloudtest.New(t)
+	client, err := New(&Config{
+		Region:              "kr1",
+		Credentials:         credentials.NewStatic("access-key", "secret-key"),
+		IdentityCredentials: credentials.NewStaticIdentity("user", "pass", "tenant"),
+		HTTPClient:          srv.Client(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	const tick = time.Millisecond
+
+	isFailed := func(err error) bool {
+		var failed *errors.FailedStateError
+		return stderrors.As(err, &failed)
+	}
+	isInvalid := func(err error) bool {
+		var invalid *errors.ValidationError
+		return stderrors.As(err, &invalid)
+	}
+
+	t.Run("compute", func(t *testing.T) {
+		polls := sequence(srv, "compute.test/servers/vm-1",
+			`{"server": {"id": "vm-1", "status": "BUILD"}}`,
+			`{"server": {"id": "vm-1", "status": "ACTIVE"}}`)
+		out, err := client.Compute().WaitForServer(ctx, "vm-1", compute.ServerStatusActive, tick)
+		if err != nil || out.Server.Status != compute.ServerStatusActive || *polls != 2 {
+			t.Errorf("ACTIVE: %+v, %v after %d polls", out, err, *polls)
+		}
+
+		sequence(srv, "compute.test/servers/vm-2", `{"server": {"id": "vm-2", "status": "ACTIVE"}}`, "")
+		if out, err := client.Compute().WaitForServer(ctx, "vm-2", compute.ServerStatusDeleted, tick); err != nil || out != nil {
+			t.Errorf("DELETED: %+v, %v", out, err)
+		}
+
+		sequence(srv, "compute.test/servers/vm-3", `{"server": {"id": "vm-3", "status": "ERROR"}}`)
+		if _, err := client.Compute().WaitForServer(ctx, "vm-3", compute.ServerStatusActive, tick); !isFailed(err) {
+			t.Errorf("ERROR: err = %v", err)
+		}
+	})
+
+	t.Run("block", func(t *testing.T) {
+		sequence(srv, "volumev2.test/volumes/vol-1",
+			`{"volume": {"id": "vol-1", "status": "attaching"}}`,
+			`{"volume": {"id": "vol-1", "status": "in-use"}}`)
+		out, err := client.BlockStorage().WaitForVolume(ctx, "vol-1", block.VolumeStatusInUse, tick)
+		if err != nil || out.Volume.Status != block.VolumeStatusInUse {
+			t.Errorf("in-use: %+v, %v", out, err)
+		}
+		if _, err := client.BlockStorage().WaitForVolume(ctx, "vol-1", block.VolumeStatusAttaching, tick); !isInvalid(err) {
+			t.Errorf("attaching: err = %v", err)
+		}
+	})
+
+	t.Run("nas", func(t *testing.T) {
+		const volumes = "kr1-api-nas-infrastructure.nhncloudservice.com/v1/volumes/"
+		sequence(srv, volumes+"nas-1",
+			`{"volume": {"id": "nas-1", "status": "creating"}}`,
+			`{"volume": {"id": "nas-1", "status": "available"}}`)
+		out, err := client.NAS().WaitForVolume(ctx, "nas-1", nas.VolumeStatusAvailable, tick)
+		if err != nil || out.Volume.Status != nas.VolumeStatusAvailable {
+			t.Errorf("available: %+v, %v", out, err)
+		}
+
+		sequence(srv, volumes+"nas-2", `{"volume": {"id": "nas-2", "status": "error"}}`)
+		if _, err := client.NAS().WaitForVolume(ctx, "nas-2", nas.VolumeStatusAvailable, tick); !isFailed(err) {
+			t.Errorf("error: err = %v", err)
+		}
+
+		// available is the only status a NAS volume settles in.
+		for _, want := range []nas.VolumeStatus{nas.VolumeStatusError, nas.VolumeStatusDeleting, "deleted"} {
+			if _, err := client.NAS().WaitForVolume(ctx, "nas-1", want, tick); !isInvalid(err) {
+				t.Errorf("%s: err = %v", want, err)
+			}
+		}
+	})
+
+	t.Run("loadbalancer", func(t *testing.T) {
+		sequence(srv, "network.test/v2.0/lbaas/loadbalancers/lb-1",
+			`{"loadbalancer": {"id": "lb-1", "provisioning_status": "PENDING_DELETE"}}`, "")
+		if out, err := client.LoadBalancer().WaitForLoadBalancer(ctx, "lb-1", loadbalancer.ProvisioningStatusDeleted, tick); err != nil || out != nil {
+			t.Errorf("DELETED: %+v, %v", out, err)
+		}
+
+		sequence(srv, "network.test/v2.0/lbaas/loadbalancers/lb-2",
+			`{"loadbalancer": {"id": "lb-2", "provisioning_status": "PENDING_CREATE"}}`,
+			`{"loadbalancer": {"id": "lb-2", "provisioning_status": "ERROR"}}`)
+		if out, err := client.LoadBalancer().WaitForLoadBalancer(ctx, "lb-2", loadbalancer.ProvisioningStatusActive, tick); !isFailed(err) || out == nil {
+			t.Errorf("ERROR: %+v, %v", out, err)
+		}
+	})
+
+	t.Run("nks", func(t *testing.T) {
+		sequence(srv, "container-infra.test/clusters/k-1",
+			`{"uuid": "k-1", "status": "CREATE_IN_PROGRESS"}`,
+			`{"uuid": "k-1", "status": "CREATE_FAILED", "status_reason": "quota exceeded"}`)
+		_, err := client.NKS().WaitForCluster(ctx, "k-1", nks.StatusCreateComplete, tick)
+		var failed *errors.FailedStateError
+		if !stderrors.As(err, &failed) || failed.Reason != "quota exceeded" {
+			t.Errorf("CREATE_FAILED: err = %v", err)
+		}
+
+		sequence(srv, "container-infra.test/clusters/k-1/nodegroups/ng-1",
+			`{"uuid": "ng-1", "status": "UPDATE_IN_PROGRESS"}`,
+			`{"uuid": "ng-1", "status": "UPDATE_COMPLETE"}`)
+		out, err := client.NKS().WaitForNodeGroup(ctx, "k-1", "ng-1", nks.StatusUpdateComplete, tick)
+		if err != nil || out.Status != nks.StatusUpdateComplete {
+			t.Errorf("UPDATE_COMPLETE: %+v, %v", out, err)
+		}
+		if _, err := client.NKS().WaitForNodeGroup(ctx, "k-1", "ng-1", nks.StatusUpdateInProgress, tick); !isInvalid(err) {
+			t.Errorf("UPDATE_IN_PROGRESS: err = %v", err)
+		}
+	})
+}
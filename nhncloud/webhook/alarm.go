@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+)
+
+// AlarmEvent is a Resource Watcher alarm delivered to a WEBHOOK target.
+// Its fields match resourcewatcher.AlarmHistory, the record the same alarm
+// leaves in the alarm history.
+type AlarmEvent struct {
+	AlarmID        string         `json:"alarmId"`
+	AlarmName      string         `json:"alarmName,omitempty"`
+	AlarmHistoryID string         `json:"alarmHistoryId,omitempty"`
+	EventID        string         `json:"eventId"`
+	EventName      string         `json:"eventName,omitempty"`
+	ProductID      string         `json:"productId,omitempty"`
+	ResourceID     string         `json:"resourceId,omitempty"`
+	ResourceName   string         `json:"resourceName,omitempty"`
+	EventDateTime  timestamp.Time `json:"eventDateTime,omitempty"`
+	// Raw is the payload as received, for fields the type does not map.
+	Raw json.RawMessage `json:"-"`
+}
+
+// AlarmHandler receives Resource Watcher alarms. Register callbacks before
+// serving; it is then safe for concurrent use.
+type AlarmHandler struct {
+	opts    Options
+	onAlarm []func(context.Context, *AlarmEvent) error
+}
+
+// NewAlarmHandler returns a handler for Resource Watcher alarms. It
+// returns ErrNoAuth unless opts authenticates requests or sets
+// AllowUnauthenticated.
+func NewAlarmHandler(opts Options) (*AlarmHandler, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	return &AlarmHandler{opts: opts}, nil
+}
+
+// OnAlarm registers fn to be called with every alarm.
+func (h *AlarmHandler) OnAlarm(fn func(context.Context, *AlarmEvent) error) {
+	h.onAlarm = append(h.onAlarm, fn)
+}
+
+// ParseAlarm decodes and validates an alarm payload.
+func ParseAlarm(body []byte) (*AlarmEvent, error) {
+	var a AlarmEvent
+	if err := json.Unmarshal(body, &a); err != nil {
+		return nil, fmt.Errorf("webhook: alarm: %w", err)
+	}
+	if a.AlarmID == "" || a.EventID == "" {
+		return nil, fmt.Errorf("webhook: alarm: alarmId and eventId are required")
+	}
+	a.Raw = append(json.RawMessage(nil), body...)
+	return &a, nil
+}
+
+func (h *AlarmHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body := h.opts.receive(w, r)
+	if body == nil {
+		return
+	}
+	a, err := ParseAlarm(body)
+	if err != nil {
+		h.opts.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if dispatch(w, r, &h.opts, h.onAlarm, a) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,229 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// Registry event types, as in RegistryEvent.Type.
+const (
+	EventPushArtifact      = "PUSH_ARTIFACT"
+	EventPullArtifact      = "PULL_ARTIFACT"
+	EventDeleteArtifact    = "DELETE_ARTIFACT"
+	EventScanningCompleted = "SCANNING_COMPLETED"
+	EventScanningFailed    = "SCANNING_FAILED"
+)
+
+// RegistryEvent is a Container Registry webhook payload, in the Harbor
+// format NCR sends.
+type RegistryEvent struct {
+	Type string `json:"type"`
+	// OccurAt is in Unix seconds; see Time.
+	OccurAt   int64             `json:"occur_at"`
+	Operator  string            `json:"operator,omitempty"`
+	EventData RegistryEventData `json:"event_data"`
+	// Raw is the payload as received, for fields the type does not map.
+	Raw json.RawMessage `json:"-"`
+}
+
+// Time returns when the event occurred.
+func (e *RegistryEvent) Time() time.Time {
+	return time.Unix(e.OccurAt, 0)
+}
+
+// RegistryEventData holds the repository and artifacts of an event.
+type RegistryEventData struct {
+	Resources  []RegistryResource `json:"resources"`
+	Repository RegistryRepository `json:"repository"`
+}
+
+// RegistryResource is an artifact an event is about.
+type RegistryResource struct {
+	Digest      string `json:"digest,omitempty"`
+	Tag         string `json:"tag,omitempty"`
+	ResourceURL string `json:"resource_url,omitempty"`
+	// ScanOverview maps report MIME types to scan reports, for scanning
+	// events.
+	ScanOverview map[string]ScanReport `json:"scan_overview,omitempty"`
+}
+
+// RegistryRepository is the repository of an event.
+type RegistryRepository struct {
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace"`
+	RepoFullName string `json:"repo_full_name"`
+	RepoType     string `json:"repo_type,omitempty"`
+	DateCreated  int64  `json:"date_created,omitempty"`
+}
+
+// ScanReport summarizes a vulnerability scan.
+type ScanReport struct {
+	ReportID   string `json:"report_id,omitempty"`
+	ScanStatus string `json:"scan_status"`
+	// Severity is the highest severity found, such as "High".
+	Severity string      `json:"severity,omitempty"`
+	Duration int64       `json:"duration,omitempty"`
+	Summary  ScanSummary `json:"summary"`
+}
+
+// ScanSummary counts the vulnerabilities found.
+type ScanSummary struct {
+	Total   int `json:"total"`
+	Fixable int `json:"fixable"`
+	// Summary counts by severity.
+	Summary map[string]int `json:"summary,omitempty"`
+}
+
+// ImagePush is one artifact of a PUSH_ARTIFACT event.
+type ImagePush struct {
+	// Repository is the full repository name, "namespace/name".
+	Repository  string
+	Tag         string
+	Digest      string
+	ResourceURL string
+	Operator    string
+	OccurredAt  time.Time
+	Event       *RegistryEvent
+}
+
+// ScanComplete is one artifact of a SCANNING_COMPLETED or SCANNING_FAILED
+// event.
+type ScanComplete struct {
+	Repository string
+	Tag        string
+	Digest     string
+	// Failed is set for SCANNING_FAILED.
+	Failed     bool
+	Report     ScanReport
+	OccurredAt time.Time
+	Event      *RegistryEvent
+}
+
+// ParseRegistryEvent decodes and validates a registry payload.
+func ParseRegistryEvent(body []byte) (*RegistryEvent, error) {
+	var e RegistryEvent
+	if err := json.Unmarshal(body, &e); err != nil {
+		return nil, fmt.Errorf("webhook: registry event: %w", err)
+	}
+	if e.Type == "" || e.EventData.Repository.RepoFullName == "" {
+		return nil, fmt.Errorf("webhook: registry event: type and repository are required")
+	}
+	e.Raw = append(json.RawMessage(nil), body...)
+	return &e, nil
+}
+
+// Pushes returns the artifacts of a push event, or nil for other types.
+func (e *RegistryEvent) Pushes() []*ImagePush {
+	if e.Type != EventPushArtifact {
+		return nil
+	}
+	var out []*ImagePush
+	for _, r := range e.EventData.Resources {
+		out = append(out, &ImagePush{
+			Repository:  e.EventData.Repository.RepoFullName,
+			Tag:         r.Tag,
+			Digest:      r.Digest,
+			ResourceURL: r.ResourceURL,
+			Operator:    e.Operator,
+			OccurredAt:  e.Time(),
+			Event:       e,
+		})
+	}
+	return out
+}
+
+// Scans returns the artifacts of a scanning event, or nil for other types.
+// Report is the artifact's scan report; if there are several, the one
+// with the first MIME type in sort order.
+func (e *RegistryEvent) Scans() []*ScanComplete {
+	if e.Type != EventScanningCompleted && e.Type != EventScanningFailed {
+		return nil
+	}
+	var out []*ScanComplete
+	for _, r := range e.EventData.Resources {
+		s := &ScanComplete{
+			Repository: e.EventData.Repository.RepoFullName,
+			Tag:        r.Tag,
+			Digest:     r.Digest,
+			Failed:     e.Type == EventScanningFailed,
+			OccurredAt: e.Time(),
+			Event:      e,
+		}
+		types := make([]string, 0, len(r.ScanOverview))
+		for t := range r.ScanOverview {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+		if len(types) > 0 {
+			s.Report = r.ScanOverview[types[0]]
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+// RegistryHandler receives Container Registry webhooks. Register callbacks
+// before serving; it is then safe for concurrent use.
+type RegistryHandler struct {
+	opts    Options
+	onEvent []func(context.Context, *RegistryEvent) error
+	onPush  []func(context.Context, *ImagePush) error
+	onScan  []func(context.Context, *ScanComplete) error
+}
+
+// NewRegistryHandler returns a handler for Container Registry webhooks. It
+// returns ErrNoAuth unless opts authenticates requests or sets
+// AllowUnauthenticated.
+func NewRegistryHandler(opts Options) (*RegistryHandler, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	return &RegistryHandler{opts: opts}, nil
+}
+
+// OnEvent registers fn to be called with every event, of any type, before
+// the typed callbacks.
+func (h *RegistryHandler) OnEvent(fn func(context.Context, *RegistryEvent) error) {
+	h.onEvent = append(h.onEvent, fn)
+}
+
+// OnPush registers fn to be called with each pushed artifact.
+func (h *RegistryHandler) OnPush(fn func(context.Context, *ImagePush) error) {
+	h.onPush = append(h.onPush, fn)
+}
+
+// OnScanComplete registers fn to be called with each scanned artifact,
+// whether the scan succeeded or failed.
+func (h *RegistryHandler) OnScanComplete(fn func(context.Context, *ScanComplete) error) {
+	h.onScan = append(h.onScan, fn)
+}
+
+func (h *RegistryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body := h.opts.receive(w, r)
+	if body == nil {
+		return
+	}
+	e, err := ParseRegistryEvent(body)
+	if err != nil {
+		h.opts.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if !dispatch(w, r, &h.opts, h.onEvent, e) {
+		return
+	}
+	for _, p := range e.Pushes() {
+		if !dispatch(w, r, &h.opts, h.onPush, p) {
+			return
+		}
+	}
+	for _, s := range e.Scans() {
+		if !dispatch(w, r, &h.opts, h.onScan, s) {
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
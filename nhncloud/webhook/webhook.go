@@ -0,0 +1,180 @@
+// Package webhook receives the webhooks NHN Cloud services send: Resource
+// Watcher alarms (resourcewatcher.CreateEventAlarm with a WEBHOOK target)
+// and Container Registry events (ncr.CreateWebhook). Each handler is an
+// http.Handler that authenticates the request, decodes the payload into a
+// typed event and calls the callbacks registered for it.
+//
+//	alarms, err := webhook.NewAlarmHandler(webhook.Options{Token: os.Getenv("ALARM_TOKEN")})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	alarms.OnAlarm(func(ctx context.Context, a *webhook.AlarmEvent) error {
+//	    log.Printf("%s: %s on %s", a.AlarmName, a.EventName, a.ResourceName)
+//	    return nil
+//	})
+//	registry, err := webhook.NewRegistryHandler(webhook.Options{Token: os.Getenv("NCR_TOKEN")})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	registry.OnPush(func(ctx context.Context, p *webhook.ImagePush) error {
+//	    return deploy(ctx, p.Repository+":"+p.Tag)
+//	})
+//	http.Handle("/hooks/alarm", alarms)
+//	http.Handle("/hooks/ncr", registry)
+//
+// A handler must be given Options.Token, Options.Secret or both, or
+// explicitly set Options.AllowUnauthenticated. NHN Cloud services
+// authenticate webhooks with the token only: NCR sends the auth header
+// configured on the webhook and Resource Watcher can carry the token in the
+// target URL. Neither signs the body, so Secret only applies to a custom
+// relay that forwards the events and signs what it forwards.
+//
+// Handlers answer 401 to requests that fail authentication, 400 to
+// payloads they cannot decode and 500 when a callback returns an error, so
+// that the sender retries; otherwise 204. Package webhooktest builds sample
+// requests for tests.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 of the body when
+	// Options.Secret is set, as hex with an optional "sha256=" prefix. NHN
+	// Cloud services do not send it; it is for custom relays.
+	SignatureHeader = "X-Signature"
+	// TokenParam is the query parameter that can carry Options.Token for
+	// senders that cannot set headers.
+	TokenParam = "token"
+	// DefaultMaxBodyBytes limits payloads when Options.MaxBodyBytes is 0.
+	DefaultMaxBodyBytes = 1 << 20
+)
+
+// Errors passed to Options.OnError for rejected requests.
+var (
+	ErrUnauthorized = errors.New("webhook: unauthorized")
+	ErrBadSignature = errors.New("webhook: signature mismatch")
+)
+
+// ErrNoAuth is returned by the handler constructors when Options sets
+// neither Token nor Secret and does not set AllowUnauthenticated.
+var ErrNoAuth = errors.New("webhook: Options needs Token, Secret or AllowUnauthenticated")
+
+// Options configures a handler. Set Token, Secret or both, or set
+// AllowUnauthenticated.
+type Options struct {
+	// Token is a shared secret the sender presents in the Authorization
+	// header, bare or as "Bearer <token>", or in the token query
+	// parameter. NCR sends the auth header configured on its webhook;
+	// Resource Watcher targets carry it in the URL.
+	Token string
+	// Secret verifies the HMAC-SHA256 signature in SignatureHeader. Only
+	// set it behind a relay that signs the requests it forwards; NHN Cloud
+	// services do not sign webhooks, so their requests would all fail.
+	Secret string
+	// AllowUnauthenticated accepts requests without a Token or Secret. It
+	// only suits a handler reachable from a private network.
+	AllowUnauthenticated bool
+	// MaxBodyBytes limits the payload size. Zero means
+	// DefaultMaxBodyBytes.
+	MaxBodyBytes int64
+	// OnError, if set, is called with every rejected request and failed
+	// callback.
+	OnError func(r *http.Request, err error)
+}
+
+// validate checks that o authenticates requests or explicitly does not.
+func (o *Options) validate() error {
+	if o.Token == "" && o.Secret == "" && !o.AllowUnauthenticated {
+		return ErrNoAuth
+	}
+	return nil
+}
+
+// Sign returns the signature of body for SignatureHeader.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// verify checks the request's token and signature.
+func (o *Options) verify(r *http.Request, body []byte) error {
+	if o.Token != "" {
+		got := r.URL.Query().Get(TokenParam)
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			got = strings.TrimPrefix(auth, "Bearer ")
+		}
+		if subtle.ConstantTimeCompare([]byte(got), []byte(o.Token)) != 1 {
+			return ErrUnauthorized
+		}
+	}
+	if o.Secret != "" {
+		got := r.Header.Get(SignatureHeader)
+		if !strings.HasPrefix(got, "sha256=") {
+			got = "sha256=" + got
+		}
+		if !hmac.Equal([]byte(strings.ToLower(got)), []byte(Sign(o.Secret, body))) {
+			return ErrBadSignature
+		}
+	}
+	return nil
+}
+
+// receive reads and authenticates a webhook request. On failure it has
+// answered the request and returns nil.
+func (o *Options) receive(w http.ResponseWriter, r *http.Request) []byte {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		o.fail(w, r, http.StatusMethodNotAllowed, fmt.Errorf("webhook: method %s not allowed", r.Method))
+		return nil
+	}
+	limit := o.MaxBodyBytes
+	if limit <= 0 {
+		limit = DefaultMaxBodyBytes
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, limit))
+	if err != nil {
+		status := http.StatusBadRequest
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		o.fail(w, r, status, fmt.Errorf("webhook: read body: %w", err))
+		return nil
+	}
+	if err := o.verify(r, body); err != nil {
+		o.fail(w, r, http.StatusUnauthorized, err)
+		return nil
+	}
+	return body
+}
+
+func (o *Options) fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if o.OnError != nil {
+		o.OnError(r, err)
+	}
+	http.Error(w, http.StatusText(status), status)
+}
+
+// dispatch calls fns in order, stopping at the first error, and answers
+// the request.
+func dispatch[T any](w http.ResponseWriter, r *http.Request, o *Options, fns []func(context.Context, T) error, event T) bool {
+	for _, fn := range fns {
+		if err := fn(r.Context(), event); err != nil {
+			o.fail(w, r, http.StatusInternalServerError, err)
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,145 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/webhook"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/webhook/webhooktest"
+)
+
+func serve(h http.Handler, r *http.Request) int {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, r)
+	return rec.Code
+}
+
+func TestAlarmHandler(t *testing.T) {
+	opts := webhook.Options{Token: "t0ken", Secret: "s3cret"}
+	var rejected []error
+	h, err := webhook.NewAlarmHandler(webhook.Options{
+		Token: opts.Token, Secret: opts.Secret,
+		OnError: func(r *http.Request, err error) { rejected = append(rejected, err) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []*webhook.AlarmEvent
+	h.OnAlarm(func(ctx context.Context, a *webhook.AlarmEvent) error {
+		got = append(got, a)
+		return nil
+	})
+
+	sample := webhooktest.Alarm("web stopped", "Instance stopped")
+	if code := serve(h, webhooktest.NewRequest(sample, opts)); code != http.StatusNoContent {
+		t.Fatalf("status = %d", code)
+	}
+	if len(got) != 1 || got[0].ResourceName != "web-1" || !got[0].EventDateTime.Equal(sample.EventDateTime) || len(got[0].Raw) == 0 {
+		t.Fatalf("alarms = %+v", got)
+	}
+
+	// The token may come in the query instead.
+	r := webhooktest.NewRequest(sample, webhook.Options{Secret: opts.Secret})
+	r.URL.RawQuery = "token=t0ken"
+	if code := serve(h, r); code != http.StatusNoContent {
+		t.Errorf("query token: status = %d", code)
+	}
+
+	for name, tc := range map[string]struct {
+		req  *http.Request
+		code int
+		err  error
+	}{
+		"wrong token":   {webhooktest.NewRequest(sample, webhook.Options{Token: "nope", Secret: opts.Secret}), http.StatusUnauthorized, webhook.ErrUnauthorized},
+		"wrong secret":  {webhooktest.NewRequest(sample, webhook.Options{Token: opts.Token, Secret: "nope"}), http.StatusUnauthorized, webhook.ErrBadSignature},
+		"missing ids":   {webhooktest.NewRequest(&webhook.AlarmEvent{AlarmName: "x"}, opts), http.StatusBadRequest, nil},
+		"wrong method":  {httptest.NewRequest(http.MethodGet, "/", nil), http.StatusMethodNotAllowed, nil},
+		"not json body": {webhooktest.NewRequest("not an object", opts), http.StatusBadRequest, nil},
+	} {
+		rejected = nil
+		if code := serve(h, tc.req); code != tc.code {
+			t.Errorf("%s: status = %d, want %d", name, code, tc.code)
+		}
+		if len(rejected) != 1 || (tc.err != nil && !errors.Is(rejected[0], tc.err)) {
+			t.Errorf("%s: OnError got %v", name, rejected)
+		}
+	}
+	if len(got) != 2 {
+		t.Errorf("callback ran %d times, want 2", len(got))
+	}
+}
+
+func TestRegistryHandler(t *testing.T) {
+	opts := webhook.Options{Token: "t0ken", MaxBodyBytes: 4096}
+	h, err := webhook.NewRegistryHandler(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []string
+	var pushes []*webhook.ImagePush
+	var scans []*webhook.ScanComplete
+	h.OnEvent(func(ctx context.Context, e *webhook.RegistryEvent) error {
+		events = append(events, e.Type)
+		return nil
+	})
+	h.OnPush(func(ctx context.Context, p *webhook.ImagePush) error {
+		pushes = append(pushes, p)
+		if p.Tag == "broken" {
+			return errors.New("deploy failed")
+		}
+		return nil
+	})
+	h.OnScanComplete(func(ctx context.Context, s *webhook.ScanComplete) error {
+		scans = append(scans, s)
+		return nil
+	})
+
+	if code := serve(h, webhooktest.NewRequest(webhooktest.Push("team/api", "v1.2.0"), opts)); code != http.StatusNoContent {
+		t.Fatalf("push: status = %d", code)
+	}
+	if len(pushes) != 1 || pushes[0].Repository != "team/api" || pushes[0].Digest != webhooktest.SampleDigest || !pushes[0].OccurredAt.Equal(webhooktest.Now) {
+		t.Fatalf("pushes = %+v", pushes)
+	}
+
+	scan := webhooktest.ScanCompleted("team/api", "v1.2.0", map[string]int{"High": 2, "Low": 3})
+	if code := serve(h, webhooktest.NewRequest(scan, opts)); code != http.StatusNoContent {
+		t.Fatalf("scan: status = %d", code)
+	}
+	if len(scans) != 1 || scans[0].Failed || scans[0].Report.Severity != "High" || scans[0].Report.Summary.Total != 5 {
+		t.Fatalf("scans = %+v", scans)
+	}
+
+	// A failing callback answers 500 so the registry retries.
+	if code := serve(h, webhooktest.NewRequest(webhooktest.Push("team/api", "broken"), opts)); code != http.StatusInternalServerError {
+		t.Errorf("failing callback: status = %d", code)
+	}
+
+	big := webhooktest.Push("team/api", strings.Repeat("x", 5000))
+	if code := serve(h, webhooktest.NewRequest(big, opts)); code != http.StatusRequestEntityTooLarge {
+		t.Errorf("large body: status = %d", code)
+	}
+	if want := []string{webhook.EventPushArtifact, webhook.EventScanningCompleted, webhook.EventPushArtifact}; strings.Join(events, ",") != strings.Join(want, ",") {
+		t.Errorf("events = %v", events)
+	}
+}
+
+func TestOptionsRequireAuth(t *testing.T) {
+	if _, err := webhook.NewAlarmHandler(webhook.Options{}); !errors.Is(err, webhook.ErrNoAuth) {
+		t.Errorf("NewAlarmHandler without auth: err = %v", err)
+	}
+	if _, err := webhook.NewRegistryHandler(webhook.Options{MaxBodyBytes: 4096}); !errors.Is(err, webhook.ErrNoAuth) {
+		t.Errorf("NewRegistryHandler without auth: err = %v", err)
+	}
+
+	h, err := webhook.NewRegistryHandler(webhook.Options{AllowUnauthenticated: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code := serve(h, webhooktest.NewRequest(webhooktest.Push("team/api", "v1"), webhook.Options{})); code != http.StatusNoContent {
+		t.Errorf("AllowUnauthenticated: status = %d", code)
+	}
+}
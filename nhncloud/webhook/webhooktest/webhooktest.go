@@ -0,0 +1,115 @@
+// Package webhooktest builds sample webhook payloads and requests for
+// testing handlers built on package webhook.
+//
+//	h, _ := webhook.NewRegistryHandler(webhook.Options{Token: "s3cret"})
+//	h.OnPush(deploy)
+//	rec := httptest.NewRecorder()
+//	h.ServeHTTP(rec, webhooktest.NewRequest(webhooktest.Push("team/api", "v1.2.0"), webhook.Options{Token: "s3cret"}))
+package webhooktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/timestamp"
+	"github.com/haung921209/nhn-cloud-sdk-go/nhncloud/webhook"
+)
+
+// Now is the time the sample events occur at.
+var Now = time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+
+// SampleDigest is the digest of the sample artifacts.
+const SampleDigest = "sha256:3f1d0c6e8b2a4c5d9e7f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f7"
+
+// Alarm returns a sample Resource Watcher alarm for an instance.
+func Alarm(alarmName, eventName string) *webhook.AlarmEvent {
+	return &webhook.AlarmEvent{
+		AlarmID:        "4f0a6c0e-2d7b-4b4e-9d52-8a9a4d3c1e01",
+		AlarmName:      alarmName,
+		AlarmHistoryID: "b7e5c1d2-9f3a-4c6b-8e1d-2a3b4c5d6e7f",
+		EventID:        "INSTANCE_STOP",
+		EventName:      eventName,
+		ProductID:      "instance",
+		ResourceID:     "0b6e3c8a-71f2-4d4e-a0c5-4c1f5b7e9d21",
+		ResourceName:   "web-1",
+		EventDateTime:  timestamp.New(Now),
+	}
+}
+
+// Push returns a sample PUSH_ARTIFACT event for repository
+// ("namespace/name") and tag.
+func Push(repository, tag string) *webhook.RegistryEvent {
+	return registryEvent(webhook.EventPushArtifact, repository, webhook.RegistryResource{
+		Digest:      SampleDigest,
+		Tag:         tag,
+		ResourceURL: "example-registry.container.nhncloud.com/" + repository + ":" + tag,
+	})
+}
+
+// ScanCompleted returns a sample SCANNING_COMPLETED event whose report
+// counts the given vulnerabilities by severity, such as {"High": 2}.
+func ScanCompleted(repository, tag string, counts map[string]int) *webhook.RegistryEvent {
+	report := webhook.ScanReport{
+		ReportID:   "8d1f2e3c-4b5a-6978-8a9b-0c1d2e3f4a5b",
+		ScanStatus: "Success",
+		Severity:   "None",
+		Duration:   12,
+		Summary:    webhook.ScanSummary{Summary: counts},
+	}
+	for _, sev := range []string{"Critical", "High", "Medium", "Low", "Unknown"} {
+		if counts[sev] > 0 && report.Severity == "None" {
+			report.Severity = sev
+		}
+		report.Summary.Total += counts[sev]
+	}
+	return registryEvent(webhook.EventScanningCompleted, repository, webhook.RegistryResource{
+		Digest:       SampleDigest,
+		Tag:          tag,
+		ScanOverview: map[string]webhook.ScanReport{"application/vnd.security.vulnerability.report; version=1.1": report},
+	})
+}
+
+func registryEvent(typ, repository string, r webhook.RegistryResource) *webhook.RegistryEvent {
+	namespace, name := repository, repository
+	if i := strings.LastIndexByte(repository, '/'); i >= 0 {
+		namespace, name = repository[:i], repository[i+1:]
+	}
+	return &webhook.RegistryEvent{
+		Type:     typ,
+		OccurAt:  Now.Unix(),
+		Operator: "ci-bot",
+		EventData: webhook.RegistryEventData{
+			Resources: []webhook.RegistryResource{r},
+			Repository: webhook.RegistryRepository{
+				Name:         name,
+				Namespace:    namespace,
+				RepoFullName: repository,
+				RepoType:     "private",
+				DateCreated:  Now.Add(-24 * time.Hour).Unix(),
+			},
+		},
+	}
+}
+
+// NewRequest returns a POST request carrying payload as JSON,
+// authenticated for a handler configured with opts: it sets the
+// Authorization header to opts.Token and signs the body with opts.Secret.
+func NewRequest(payload interface{}, opts webhook.Options) *http.Request {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		panic("webhooktest: " + err.Error())
+	}
+	r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	if opts.Token != "" {
+		r.Header.Set("Authorization", "Bearer "+opts.Token)
+	}
+	if opts.Secret != "" {
+		r.Header.Set(webhook.SignatureHeader, webhook.Sign(opts.Secret, body))
+	}
+	return r
+}